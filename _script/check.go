@@ -33,6 +33,7 @@ type checker struct{}
 func (c *checker) checkAutogen() error {
 	filenames := []string{
 		"opt_rules.go",
+		"lint_rules.go",
 		"universal_rules.go",
 	}
 	readContents := func() ([][]byte, error) {
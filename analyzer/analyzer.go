@@ -0,0 +1,207 @@
+// Package analyzer provides perfguard as a go/analysis Analyzer.
+//
+// It can be used with the multichecker and singlechecker drivers,
+// golangci-lint and gopls; the suggested fixes are available as quick fixes.
+package analyzer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
+	"sync"
+
+	"github.com/google/pprof/profile"
+	"github.com/quasilyte/perf-heatmap/heatmap"
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/quasilyte/go-perfguard/perfguard"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+// Analyzer runs the perfguard rules and checkers.
+//
+// The lint mode is the default, it's like `perfguard lint` command.
+// The optimize mode needs a CPU profile, it's like `perfguard optimize` command.
+var Analyzer = &analysis.Analyzer{
+	Name: "perfguard",
+	Doc:  "finds the code that can be made faster or simpler",
+	Run:  run,
+}
+
+var flags struct {
+	mode             string
+	heatmapFile      string
+	heatmapThreshold float64
+	goVersion        string
+}
+
+func init() {
+	Analyzer.Flags.StringVar(&flags.mode, "mode", "lint",
+		`analysis mode: lint or optimize`)
+	Analyzer.Flags.StringVar(&flags.heatmapFile, "heatmap", "",
+		`a CPU profile that will be used to build a heatmap, required for the optimize mode`)
+	Analyzer.Flags.Float64Var(&flags.heatmapThreshold, "heatmap-threshold", 0.5,
+		`a threshold argument used to create a heatmap, see perf-heatmap docs on it`)
+	Analyzer.Flags.StringVar(&flags.goVersion, "go", "",
+		`select the Go version to target; leave as empty string for the latest`)
+}
+
+// The analysis drivers run the packages in parallel.
+// perfguard.Analyzer is not safe for a concurrent use,
+// so every package gets a worker that is not in use.
+// The heatmap is only read during the analysis, it's shared.
+var (
+	workersMu sync.Mutex
+	workers   []*worker
+
+	heatmapOnce  sync.Once
+	heatmapIndex *heatmap.Index
+	heatmapErr   error
+)
+
+type worker struct {
+	impl *perfguard.Analyzer
+
+	// warnings are collected by the Warn callback during CheckPackage.
+	warnings []lint.Warning
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	w, err := getWorker()
+	if err != nil {
+		return nil, err
+	}
+	defer putWorker(w)
+
+	target := &lint.Target{
+		Pkg:   pass.Pkg,
+		Fset:  pass.Fset,
+		Types: pass.TypesInfo,
+		Sizes: pass.TypesSizes,
+	}
+	for _, f := range pass.Files {
+		target.Files = append(target.Files, lint.SourceFile{Syntax: f})
+	}
+
+	w.warnings = w.warnings[:0]
+	if err := w.impl.CheckPackage(target); err != nil {
+		return nil, err
+	}
+	for i := range w.warnings {
+		pass.Report(newDiagnostic(pass, &w.warnings[i]))
+	}
+
+	return nil, nil
+}
+
+func newDiagnostic(pass *analysis.Pass, w *lint.Warning) analysis.Diagnostic {
+	d := analysis.Diagnostic{
+		Pos:      warningPos(pass, w),
+		Category: w.Tag,
+		Message:  w.Text,
+	}
+	if len(w.Fixes) != 0 {
+		fix := analysis.SuggestedFix{Message: w.Text}
+		for _, edit := range w.Fixes {
+			fix.TextEdits = append(fix.TextEdits, analysis.TextEdit{
+				Pos:     edit.From,
+				End:     edit.To,
+				NewText: edit.Replacement,
+			})
+		}
+		d.SuggestedFixes = []analysis.SuggestedFix{fix}
+	}
+	return d
+}
+
+// warningPos converts the warning file position to the pass file set pos.
+func warningPos(pass *analysis.Pass, w *lint.Warning) token.Pos {
+	for _, f := range pass.Files {
+		tf := pass.Fset.File(f.Pos())
+		if tf == nil || tf.Name() != w.Filename {
+			continue
+		}
+		if w.Line < 1 || w.Line > tf.LineCount() {
+			break
+		}
+		return tf.LineStart(w.Line) + token.Pos(w.Column-1)
+	}
+	return token.NoPos
+}
+
+func getWorker() (*worker, error) {
+	workersMu.Lock()
+	if len(workers) != 0 {
+		w := workers[len(workers)-1]
+		workers = workers[:len(workers)-1]
+		workersMu.Unlock()
+		return w, nil
+	}
+	workersMu.Unlock()
+	return newWorker()
+}
+
+func putWorker(w *worker) {
+	workersMu.Lock()
+	workers = append(workers, w)
+	workersMu.Unlock()
+}
+
+func newWorker() (*worker, error) {
+	config := &perfguard.Config{
+		GoVersion:          flags.goVersion,
+		LoadUniversalRules: true,
+	}
+	switch flags.mode {
+	case "lint":
+		config.LoadLintRules = true
+	case "optimize":
+		index, err := loadHeatmap()
+		if err != nil {
+			return nil, fmt.Errorf("load heatmap: %w", err)
+		}
+		config.Heatmap = index
+		config.LoadOptRules = true
+	default:
+		return nil, fmt.Errorf("unsupported mode: %q", flags.mode)
+	}
+
+	w := &worker{impl: perfguard.NewAnalyzer()}
+	config.Warn = func(warning lint.Warning) {
+		w.warnings = append(w.warnings, warning)
+	}
+	if err := w.impl.Init(config); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func loadHeatmap() (*heatmap.Index, error) {
+	heatmapOnce.Do(func() {
+		heatmapIndex, heatmapErr = createHeatmap(flags.heatmapFile, flags.heatmapThreshold)
+	})
+	return heatmapIndex, heatmapErr
+}
+
+func createHeatmap(filename string, threshold float64) (*heatmap.Index, error) {
+	if filename == "" {
+		return nil, errors.New("CPU profile is required, see -heatmap flag")
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	pprofProfile, err := profile.Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	index := heatmap.NewIndex(heatmap.IndexConfig{
+		Threshold: threshold,
+	})
+	if err := index.AddProfile(pprofProfile); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
@@ -0,0 +1,24 @@
+package lintmode
+
+import (
+	"os"
+	"strings"
+)
+
+func copyReslice(dst, src []byte) {
+	copy(dst, src[:len(dst)]) // want `copy\(dst, src\[:len\(dst\)\]\) can be replaced with copy\(dst, src\)`
+	copy(dst[:len(src)], src) // want `copy\(dst\[:len\(src\)\], src\) can be replaced with copy\(dst, src\)`
+	copy(dst, src)
+}
+
+func stringsCompare(s1, s2 string) bool {
+	return strings.Compare(s1, s2) == 0 // want `strings\.Compare\(s1, s2\) == 0 => s1 == s2`
+}
+
+func deferNilRecv(cond bool, filename string) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	}
+	defer f.Close() // want `f is assigned only in some of the preceding branches, it may be nil when f\.Close\(\) is deferred`
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+// baselineEntry is a single known issue recorded by -write-baseline.
+//
+// The issues are identified by the reported code instead of the line number:
+// the fingerprint is a hash of the reported code text with a normalized whitespace.
+// An issue that is moved up or down in the file still matches its entry,
+// while an issue with a changed code is reported again.
+type baselineEntry struct {
+	Filename    string `json:"file"`
+	Rule        string `json:"rule"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// readBaseline loads the -baseline file entries.
+// Several identical issues in one file have one entry each,
+// so the entries are counted instead of being a set.
+func (r *runner) readBaseline() error {
+	data, err := os.ReadFile(r.args.baseline)
+	if err != nil {
+		return err
+	}
+	var entries []baselineEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("%s: %w", r.args.baseline, err)
+	}
+	r.baseline = make(map[baselineEntry]int, len(entries))
+	for _, e := range entries {
+		r.baseline[e]++
+	}
+	return nil
+}
+
+// writeBaseline writes the -baseline file as a JSON array.
+// The entries are sorted to keep the file diffs small.
+func (r *runner) writeBaseline() error {
+	entries := r.baselineEntries
+	if entries == nil {
+		entries = []baselineEntry{}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		x := entries[i]
+		y := entries[j]
+		if x.Filename != y.Filename {
+			return x.Filename < y.Filename
+		}
+		if x.Rule != y.Rule {
+			return x.Rule < y.Rule
+		}
+		return x.Fingerprint < y.Fingerprint
+	})
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(entries); err != nil {
+		return err
+	}
+	return os.WriteFile(r.args.baseline, buf.Bytes(), 0o644)
+}
+
+// newBaselineEntry creates the entry for the warning.
+// The sources map caches the files text.
+func (r *runner) newBaselineEntry(w *lint.Warning, sources map[string][]byte) (baselineEntry, error) {
+	text, ok := sources[w.Filename]
+	if !ok {
+		data, err := os.ReadFile(w.Filename)
+		if err != nil {
+			return baselineEntry{}, err
+		}
+		text = data
+		sources[w.Filename] = text
+	}
+	// The filename is always relative and slash-separated,
+	// so the baseline can be shared between the machines.
+	filename, err := filepath.Rel(r.wd, w.Filename)
+	if err != nil {
+		return baselineEntry{}, err
+	}
+	code := strings.Join(strings.Fields(string(warningCode(text, w))), " ")
+	hash := sha256.Sum256([]byte(code))
+	return baselineEntry{
+		Filename:    filepath.ToSlash(filename),
+		Rule:        w.Tag,
+		Fingerprint: hex.EncodeToString(hash[:8]),
+	}, nil
+}
+
+// warningCode returns the reported code text.
+// If the warning has no end position, its entire first line is returned.
+func warningCode(text []byte, w *lint.Warning) []byte {
+	// lineStart returns the offset of the 1-based line, or -1 if there is no such line.
+	lineStart := func(line int) int {
+		offset := 0
+		for i := 1; i < line; i++ {
+			end := bytes.IndexByte(text[offset:], '\n')
+			if end == -1 {
+				return -1
+			}
+			offset += end + 1
+		}
+		return offset
+	}
+
+	start := lineStart(w.Line)
+	if start == -1 {
+		return nil
+	}
+	if w.EndLine == 0 {
+		end := bytes.IndexByte(text[start:], '\n')
+		if end == -1 {
+			return text[start:]
+		}
+		return text[start : start+end]
+	}
+	from := start + w.Column - 1
+	to := lineStart(w.EndLine)
+	if to == -1 {
+		return nil
+	}
+	to += w.EndColumn - 1
+	if from > to || to > len(text) {
+		return nil
+	}
+	return text[from:to]
+}
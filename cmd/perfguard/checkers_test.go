@@ -3,12 +3,25 @@ package main
 import (
 	"path/filepath"
 	"testing"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
 )
 
 func TestCheckers(t *testing.T) {
-	checkers := readdir(t, filepath.Join("testdata", "checkerstest"))
-	for _, name := range checkers {
+	optIn := make(map[string]bool)
+	for _, name := range checkers.Names(func(doc checkers.Doc) bool { return doc.OptIn }) {
+		optIn[name] = true
+	}
+
+	tests := readdir(t, filepath.Join("testdata", "checkerstest"))
+	for _, name := range tests {
 		key := filepath.Base(name)
-		runLintTest(t, "checkerstest", key)
+		if optIn[key] {
+			// Opt-in checkers are enabled by their test dir name.
+			// Other rules still run, the opt-in checkers are added to them.
+			runLintTest(t, "checkerstest", key, "--enable", key)
+		} else {
+			runLintTest(t, "checkerstest", key)
+		}
 	}
 }
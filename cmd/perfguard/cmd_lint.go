@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"io"
 )
 
@@ -11,14 +12,31 @@ func cmdLint(stdout, stderr io.Writer, args []string) (int, error) {
 	fs := flag.NewFlagSet("perfguard optimize", flag.ExitOnError)
 	addCommonFlags(r, fs)
 	noColor := fs.Bool("no-color", false, `disable colored output`)
+	errorRules := fs.String("error-rules", "",
+		`comma-separated list of rules that cause a non-zero exit code; if empty, all rules do; can't be combined with -ci that fails on any issue`)
+	ci := fs.Bool("ci", false,
+		`CI preset: plain text output grouped by file, any issue that is not in the -baseline fails the run, a one-line summary at the end`)
 	_ = fs.Parse(args)
 
 	r.targets = fs.Args()
 	r.loadLintRules = true
 	r.coloredOutput = !*noColor
+	r.args.errorRules = parseNameSet(*errorRules)
+	if *ci {
+		if r.args.format != "text" {
+			return 0, fmt.Errorf("-ci requires the text output format")
+		}
+		if len(r.args.errorRules) != 0 {
+			return 0, fmt.Errorf("-ci can't be combined with -error-rules, any issue fails the run")
+		}
+		// The summary line replaces the issues count printed to stderr.
+		r.args.ci = true
+		r.args.quiet = true
+		r.coloredOutput = false
+	}
 	if err := r.Run(); err != nil {
 		return 0, err
 	}
 
-	return r.stats.issuesTotal, nil
+	return r.stats.issuesFailing, nil
 }
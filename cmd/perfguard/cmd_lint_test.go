@@ -0,0 +1,1350 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLintErrorRules(t *testing.T) {
+	tests := []struct {
+		errorRules string
+		want       int
+	}{
+		{"", 3},
+		{"bytesCompare", 1},
+		{"stringsCompare", 2},
+		{"bytesCompare,stringsCompare", 3},
+		{"equalFold", 0},
+	}
+
+	for _, test := range tests {
+		args := []string{
+			"--no-color",
+			"--quiet",
+			"--error-rules", test.errorRules,
+			"./testdata/flagstest/errorRules/...",
+		}
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		numFailing, err := cmdLint(&stdout, &stderr, args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+		if numFailing != test.want {
+			t.Errorf("error-rules=%q: have %d failing issues, want %d",
+				test.errorRules, numFailing, test.want)
+		}
+		// Non-error rules are still reported.
+		if n := bytes.Count(stdout.Bytes(), []byte("\n")); n != 3 {
+			t.Errorf("error-rules=%q: have %d reported issues, want 3", test.errorRules, n)
+		}
+	}
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	args := []string{"--error-rules", "stringsCompare,noSuchRule", "./testdata/flagstest/errorRules/..."}
+	_, err := cmdLint(&stdout, &stderr, args)
+	if err == nil {
+		t.Fatal("expected an unknown rule error")
+	}
+	for _, s := range []string{"-error-rules: unknown rules: noSuchRule;", "valid names are:", "stringsCompare"} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("error doesn't contain %q: %v", s, err)
+		}
+	}
+}
+
+func TestLintDebugRule(t *testing.T) {
+	tests := []struct {
+		debugRule string
+		want      []string
+	}{
+		{
+			debugRule: "stringsCompare",
+			want: []string{
+				"debugRule.go:13: [universal_rules.go:",
+				"] matched: strings.Compare(s1, s2) == 0\n",
+				"  $a string: s1\n",
+				"  $b string: s2\n",
+			},
+		},
+		{
+			debugRule: "redundantSprint",
+			want: []string{
+				"] matched: fmt.Sprint(x)\n",
+				"  $x github.com/quasilyte/go-perfguard/cmd/perfguard/testdata/flagstest/debugRule.withStringer: x\n",
+				"] rejected by m[\"x\"].Type.Implements(`fmt.Stringer`)\n",
+				"] matched: fmt.Sprint(err)\n",
+				"  $x error: err\n",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		args := []string{
+			"--no-color",
+			"--quiet",
+			"--debug-rule", test.debugRule,
+			"./testdata/flagstest/debugRule/...",
+		}
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range test.want {
+			if !strings.Contains(stderr.String(), s) {
+				t.Errorf("debug-rule=%s: output doesn't contain %q:\n%s", test.debugRule, s, stderr.String())
+			}
+		}
+	}
+
+	// No debug output by default.
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	args := []string{"--no-color", "--quiet", "./testdata/flagstest/debugRule/..."}
+	if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("unexpected debug output:\n%s", stderr.String())
+	}
+}
+
+func TestLintFormatJSON(t *testing.T) {
+	args := []string{
+		"--quiet",
+		"--format", "json",
+		"./testdata/flagstest/formatJSON/...",
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+
+	want := []jsonWarning{
+		{
+			Filename:             "testdata/flagstest/formatJSON/formatJSON.go",
+			Line:                 8,
+			Column:               6,
+			EndLine:              8,
+			EndColumn:            34,
+			Rule:                 "stringsCompare",
+			Message:              "strings.Compare(s1, s2) == 0 => s1 == s2",
+			Mode:                 "lint",
+			Tags:                 []string{"o1", "score1"},
+			SuggestedReplacement: "s1 == s2",
+			Autofixable:          true,
+		},
+		{
+			Filename:    "testdata/flagstest/formatJSON/formatJSON.go",
+			Line:        9,
+			Column:      6,
+			EndLine:     9,
+			EndColumn:   18,
+			Rule:        "lenSignCheck",
+			Message:     "len(s1) is never negative, the condition is always true",
+			Mode:        "lint",
+			Tags:        []string{"score1"},
+			Autofixable: false,
+		},
+	}
+	var have []jsonWarning
+	for _, l := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		var w jsonWarning
+		if err := json.Unmarshal([]byte(l), &w); err != nil {
+			t.Fatalf("unmarshal %q: %v", l, err)
+		}
+		have = append(have, w)
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestLintFormatCheckstyle(t *testing.T) {
+	args := []string{
+		"--quiet",
+		"--format", "checkstyle",
+		"--error-rules", "stringsCompare",
+		"./testdata/flagstest/formatCheckstyle/...",
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+
+	want, err := os.ReadFile("./testdata/flagstest/formatCheckstyle/output.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(want), stdout.String()); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+
+	// Make sure that the output is a well-formed XML.
+	var out checkstyleOutput
+	if err := xml.Unmarshal(stdout.Bytes(), &out); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+	if len(out.Files) != 2 {
+		t.Fatalf("have %d files in the output, want 2", len(out.Files))
+	}
+	if have := out.Files[0].Errors[0].Message; have != `strings.Compare(s, "a&b") == 0 => s == "a&b"` {
+		t.Errorf("unexpected message after unmarshal: %s", have)
+	}
+}
+
+func TestLintFormatSummary(t *testing.T) {
+	args := []string{"--quiet", "--format", "summary", "./testdata/flagstest/formatSummary/..."}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	issuesCount, err := cmdLint(&stdout, &stderr, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+	if issuesCount != 6 {
+		t.Errorf("have %d issues, want 6", issuesCount)
+	}
+
+	want := strings.Join([]string{
+		filepath.FromSlash("testdata/flagstest/formatSummary/b.go") + ": 3 issues",
+		filepath.FromSlash("testdata/flagstest/formatSummary/c.go") + ": 2 issues",
+		filepath.FromSlash("testdata/flagstest/formatSummary/a.go") + ": 1 issues",
+	}, "\n") + "\n"
+	if diff := cmp.Diff(want, stdout.String()); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestLintMaxPerRule(t *testing.T) {
+	runLint := func(extraArgs ...string) string {
+		args := []string{"--no-color", "--quiet", "--max-per-rule", "2"}
+		args = append(args, extraArgs...)
+		args = append(args, "./testdata/flagstest/maxPerRule/...")
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		issuesCount, err := cmdLint(&stdout, &stderr, args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+		// The limit doesn't affect the exit status.
+		if issuesCount != 5 {
+			t.Errorf("have %d issues, want 5", issuesCount)
+		}
+		return stdout.String()
+	}
+
+	{
+		a := filepath.FromSlash("testdata/flagstest/maxPerRule/a.go")
+		want := []string{
+			a + ":6:6: len(s) >= 1 => len(s) > 0 (lenSignCheck)",
+			a + ":7:6: strings.Compare(s, \"x\") == 0 => s == \"x\" (stringsCompare)",
+			a + ":8:6: len(xs) >= 1 => len(xs) > 0 (lenSignCheck)",
+			"lenSignCheck: (+2 more)",
+		}
+		have := strings.Split(strings.TrimSpace(runLint()), "\n")
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("output mismatch (-want +have):\n%s", diff)
+		}
+	}
+
+	{
+		var have []int
+		output := runLint("--format", "json")
+		for _, l := range strings.Split(strings.TrimSpace(output), "\n") {
+			var w jsonWarning
+			if err := json.Unmarshal([]byte(l), &w); err != nil {
+				t.Fatalf("unmarshal %q: %v", l, err)
+			}
+			have = append(have, w.Line)
+		}
+		want := []int{6, 7, 8}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("json lines mismatch (-want +have):\n%s", diff)
+		}
+	}
+}
+
+func TestLintVerifyFixes(t *testing.T) {
+	args := []string{"--no-color", "--quiet", "--verify-fixes", "--format", "json", "./testdata/flagstest/verifyFixes/..."}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+
+	var have []string
+	for _, l := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		var w jsonWarning
+		if err := json.Unmarshal([]byte(l), &w); err != nil {
+			t.Fatalf("unmarshal %q: %v", l, err)
+		}
+		have = append(have, fmt.Sprintf("%d: %v", w.Line, w.Autofixable))
+	}
+	// The broken fix is discarded, but the warning is still reported.
+	want := []string{"8: true", "14: false"}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("autofixable flags mismatch (-want +have):\n%s", diff)
+	}
+
+	wantErr := "verifyFixes.go:14: strconv: discarded a suggested fix that doesn't compile: strconv.Itoa undefined"
+	if !strings.Contains(stderr.String(), wantErr) {
+		t.Errorf("stderr doesn't contain %q:\n%s", wantErr, stderr.String())
+	}
+}
+
+func TestLintShowFunc(t *testing.T) {
+	runLint := func(extraArgs ...string) string {
+		args := []string{"--no-color", "--quiet", "--show-func"}
+		args = append(args, extraArgs...)
+		args = append(args, "./testdata/flagstest/showFunc/...")
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+		return stdout.String()
+	}
+
+	{
+		want := []string{
+			"showFunc.go:7:14: strings.Compare(\"a\", \"b\") == 0 => \"a\" == \"b\" (stringsCompare)\n",
+			"showFunc.go:10:6: strings.Compare(s1, s2) == 0 => s1 == s2 (in f) (stringsCompare)\n",
+			"showFunc.go:16:6: strings.Compare(s1, s2) == 0 => s1 == s2 (in myType.method) (stringsCompare)\n",
+			"showFunc.go:21:10: strings.Compare(s1, s2) == 0 => s1 == s2 (in withFuncLit) (stringsCompare)\n",
+		}
+		output := runLint()
+		for _, s := range want {
+			if !strings.Contains(output, s) {
+				t.Errorf("output doesn't contain %q:\n%s", s, output)
+			}
+		}
+	}
+
+	{
+		var have []string
+		output := runLint("--format", "json")
+		for _, l := range strings.Split(strings.TrimSpace(output), "\n") {
+			var w jsonWarning
+			if err := json.Unmarshal([]byte(l), &w); err != nil {
+				t.Fatalf("unmarshal %q: %v", l, err)
+			}
+			have = append(have, w.Func)
+		}
+		want := []string{"", "f", "myType.method", "withFuncLit"}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("json func names mismatch (-want +have):\n%s", diff)
+		}
+	}
+}
+
+func TestLintStats(t *testing.T) {
+	args := []string{"--no-color", "--quiet", "--stats", "./testdata/flagstest/stats/..."}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	want := "Issues by impact: 3 alloc, 1 cpu, 1 readability, 1 unspecified\n" +
+		"Estimated avoidable allocations: 3\n"
+	if diff := cmp.Diff(want, stderr.String()); diff != "" {
+		t.Errorf("stats output mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestLintPackagePatterns(t *testing.T) {
+	absPath, err := filepath.Abs("./testdata/flagstest/packages/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		args    []string
+		env     string
+		want    []string
+		wantErr []string
+	}{
+		{
+			args: []string{"./testdata/flagstest/packages/..."},
+			want: []string{"a/a.go", "b/b.go", "b/c/c.go", "broken/broken.go", "syntaxerr/syntaxerr.go"},
+			wantErr: []string{
+				"load broken package",
+				"load syntaxerr package",
+			},
+		},
+		{
+			args: []string{"./testdata/flagstest/packages/b/..."},
+			want: []string{"b/b.go", "b/c/c.go"},
+		},
+		{
+			args: []string{"./testdata/flagstest/packages/a", "./testdata/flagstest/packages/b/c"},
+			want: []string{"a/a.go", "b/c/c.go"},
+		},
+		{
+			args: []string{"github.com/quasilyte/go-perfguard/cmd/perfguard/testdata/flagstest/packages/b"},
+			want: []string{"b/b.go"},
+		},
+		{
+			args: []string{absPath},
+			want: []string{"a/a.go"},
+		},
+		{
+			args: []string{"./testdata/flagstest/packages/testonly", "./testdata/flagstest/packages/tagged"},
+			want: nil,
+		},
+		{
+			args: []string{"--tags", "perfguard_tagged", "./testdata/flagstest/packages/tagged"},
+			want: []string{"tagged/tagged.go"},
+		},
+		{
+			args: []string{"./testdata/flagstest/packages/tagged"},
+			env:  "-tags=perfguard_tagged",
+			want: []string{"tagged/tagged.go"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Setenv("GOFLAGS", test.env)
+		args := append([]string{"--no-color", "--quiet"}, test.args...)
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatalf("%v: %v", test.args, err)
+		}
+		var have []string
+		for _, l := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+			if l == "" {
+				continue
+			}
+			filename := strings.TrimPrefix(l[:strings.IndexByte(l, ':')], "testdata/flagstest/packages/")
+			have = append(have, filename)
+		}
+		if diff := cmp.Diff(test.want, have); diff != "" {
+			t.Errorf("%v: analyzed files mismatch (-want +have):\n%s", test.args, diff)
+		}
+		for _, s := range test.wantErr {
+			if !strings.Contains(stderr.String(), s) {
+				t.Errorf("%v: stderr doesn't contain %q:\n%s", test.args, s, stderr.String())
+			}
+		}
+		if len(test.wantErr) == 0 && stderr.Len() != 0 {
+			t.Errorf("%v: unexpected errors:\n%s", test.args, stderr.String())
+		}
+	}
+}
+
+func TestLintIncludeVendor(t *testing.T) {
+	local := filepath.FromSlash("testdata/flagstest/vendorFiles/local.go")
+	vendored := filepath.FromSlash("testdata/flagstest/vendorFiles/vendor/dep/dep.go")
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{
+			want: []string{
+				local + `:8:9: strings.Compare(s, "x") == 0 => s == "x" (stringsCompare)`,
+			},
+		},
+		{
+			args: []string{"--include-vendor"},
+			want: []string{
+				local + `:8:9: strings.Compare(s, "x") == 0 => s == "x" (stringsCompare)`,
+				vendored + `:8:9: strings.Compare(s, "x") == 0 => s == "x" (stringsCompare)`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		args := []string{"--no-color", "--quiet"}
+		args = append(args, test.args...)
+		args = append(args, "./testdata/flagstest/vendorFiles", "./testdata/flagstest/vendorFiles/vendor/dep")
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("%v: errors:\n%s", test.args, stderr.String())
+		}
+		have := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		if diff := cmp.Diff(test.want, have); diff != "" {
+			t.Errorf("%v: output mismatch (-want +have):\n%s", test.args, diff)
+		}
+	}
+}
+
+func TestLintFormatText(t *testing.T) {
+	// The text format follows the go vet convention,
+	// so the editor error matchers and CI parsers can consume it:
+	// path/to/file.go:line:col: message (ruleName).
+	args := []string{"--no-color", "--quiet", "--format", "text", "./testdata/flagstest/formatJSON/..."}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+
+	filename := filepath.FromSlash("testdata/flagstest/formatJSON/formatJSON.go")
+	want := filename + ":8:6: strings.Compare(s1, s2) == 0 => s1 == s2 (stringsCompare)\n" +
+		filename + ":9:6: len(s1) is never negative, the condition is always true (lenSignCheck)\n"
+	if diff := cmp.Diff(want, stdout.String()); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestLintShowSuggestion(t *testing.T) {
+	runLint := func(extraArgs ...string) string {
+		args := []string{"--no-color", "--quiet"}
+		args = append(args, extraArgs...)
+		args = append(args, "./testdata/flagstest/showSuggestion/...")
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+		return stdout.String()
+	}
+
+	{
+		want := []string{
+			"showSuggestion.go:6:2: for … { … } => dst = append(dst, src...) (suggestion: for _, b := range src { dst = append(dst, b) } => dst = append(dst, src...)) (rangeToAppend)\n",
+			"showSuggestion.go:9:2: combine 2 appends into dst = append(dst, x, y) (suggestion: dst = append(dst, x); dst = append(dst, y) => dst = append(dst, x, y)) (appendCombine)\n",
+			// The message already describes the fix.
+			"showSuggestion.go:12:15: strings.Compare(s1, s2) == 0 => s1 == s2 (stringsCompare)\n",
+			// Report-only issues have nothing to suggest.
+			"showSuggestion.go:11:5: len(s1) is never negative, the condition is always true (lenSignCheck)\n",
+		}
+		output := runLint("--show-suggestion")
+		for _, s := range want {
+			if !strings.Contains(output, s) {
+				t.Errorf("output doesn't contain %q:\n%s", s, output)
+			}
+		}
+	}
+
+	{
+		output := runLint()
+		if strings.Contains(output, "(suggestion: ") {
+			t.Errorf("output contains suggestions without --show-suggestion:\n%s", output)
+		}
+	}
+}
+
+func TestLintDocsBaseURL(t *testing.T) {
+	const docsBaseURL = "https://example.com/rules.html"
+	runLint := func(format string) string {
+		args := []string{
+			"--no-color",
+			"--quiet",
+			"--format", format,
+			"--docs-base-url", docsBaseURL,
+			"./testdata/flagstest/formatJSON/...",
+		}
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("%s: errors:\n%s", format, stderr.String())
+		}
+		return stdout.String()
+	}
+
+	{
+		filename := filepath.FromSlash("testdata/flagstest/formatJSON/formatJSON.go")
+		want := []string{
+			filename + ":8:6: strings.Compare(s1, s2) == 0 => s1 == s2 (docs: " + docsBaseURL + "#stringsCompare) (stringsCompare)",
+			filename + ":9:6: len(s1) is never negative, the condition is always true (docs: " + docsBaseURL + "#lenSignCheck) (lenSignCheck)",
+		}
+		have := strings.Split(strings.TrimSpace(runLint("text")), "\n")
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("text output mismatch (-want +have):\n%s", diff)
+		}
+	}
+
+	{
+		want := []string{docsBaseURL + "#stringsCompare", docsBaseURL + "#lenSignCheck"}
+		var have []string
+		for _, l := range strings.Split(strings.TrimSpace(runLint("json")), "\n") {
+			var w jsonWarning
+			if err := json.Unmarshal([]byte(l), &w); err != nil {
+				t.Fatalf("unmarshal %q: %v", l, err)
+			}
+			have = append(have, w.Docs)
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("json output mismatch (-want +have):\n%s", diff)
+		}
+	}
+
+	{
+		out := runLint("checkstyle")
+		for _, rule := range []string{"stringsCompare", "lenSignCheck"} {
+			if !strings.Contains(out, "(docs: "+docsBaseURL+"#"+rule+")") {
+				t.Errorf("checkstyle output has no %s docs link:\n%s", rule, out)
+			}
+		}
+	}
+}
+
+func TestLintFixableOnly(t *testing.T) {
+	args := []string{"--no-color", "--fixable-only", "./testdata/flagstest/formatJSON/..."}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	issuesCount, err := cmdLint(&stdout, &stderr, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issuesCount != 1 {
+		t.Errorf("have %d issues, want 1", issuesCount)
+	}
+
+	// lenSignCheck match on the line 9 is report-only.
+	want := filepath.FromSlash("testdata/flagstest/formatJSON/formatJSON.go") +
+		":8:6: strings.Compare(s1, s2) == 0 => s1 == s2 (stringsCompare)\n"
+	if diff := cmp.Diff(want, stdout.String()); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+	if diff := cmp.Diff("Found 1 issues (1 auto-fixable)\n", stderr.String()); diff != "" {
+		t.Errorf("stats mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestLintMinComplexity(t *testing.T) {
+	filename := filepath.FromSlash("testdata/flagstest/minComplexity/minComplexity.go")
+	tests := []struct {
+		minComplexity string
+		want          []string
+	}{
+		{
+			minComplexity: "0",
+			want: []string{
+				filename + `:9:9: strings.Compare(s, "a") == 0 => s == "a" (stringsCompare)`,
+				filename + `:15:10: strings.Compare(s, "a") == 0 => s == "a" (stringsCompare)`,
+				filename + `:27:8: strings.Compare(s, "a") == 0 => s == "a" (stringsCompare)`,
+				filename + `:38:14: strings.Compare("a", "b") == 0 => "a" == "b" (stringsCompare)`,
+			},
+		},
+		{
+			minComplexity: "1",
+			want: []string{
+				filename + `:15:10: strings.Compare(s, "a") == 0 => s == "a" (stringsCompare)`,
+				filename + `:27:8: strings.Compare(s, "a") == 0 => s == "a" (stringsCompare)`,
+			},
+		},
+		{
+			minComplexity: "3",
+			want: []string{
+				filename + `:27:8: strings.Compare(s, "a") == 0 => s == "a" (stringsCompare)`,
+			},
+		},
+		{
+			minComplexity: "6",
+			want:          []string{""},
+		},
+	}
+
+	for _, test := range tests {
+		args := []string{
+			"--no-color",
+			"--quiet",
+			"--min-complexity", test.minComplexity,
+			"./testdata/flagstest/minComplexity/...",
+		}
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("min-complexity=%s: errors:\n%s", test.minComplexity, stderr.String())
+		}
+		have := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		if diff := cmp.Diff(test.want, have); diff != "" {
+			t.Errorf("min-complexity=%s: output mismatch (-want +have):\n%s", test.minComplexity, diff)
+		}
+	}
+}
+
+func TestLintFixExclude(t *testing.T) {
+	dir := filepath.Join("testdata", "flagstest", "fixExclude")
+	defer os.RemoveAll(dir)
+
+	const src = "package %s\n\nimport \"strings\"\n\nfunc %s(s string) bool {\n\treturn strings.Compare(s, \"a\") == 0\n}\n"
+	files := map[string]string{
+		filepath.Join(dir, "fixed", "fixed.go"):         "fixed",
+		filepath.Join(dir, "fixed", "fixed_gen.go"):     "fixed",
+		filepath.Join(dir, "protected", "protected.go"): "protected",
+	}
+	for filename, pkgName := range files {
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		funcName := strings.TrimSuffix(filepath.Base(filename), ".go")
+		if err := os.WriteFile(filename, []byte(fmt.Sprintf(src, pkgName, funcName)), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	args := []string{
+		"--fix",
+		"--no-color",
+		"--quiet",
+		"--fix-exclude", "*_gen.go, testdata/flagstest/fixExclude/protected",
+		"./testdata/flagstest/fixExclude/...",
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+
+	// The excluded files issues are reported, but not fixed.
+	want := []string{
+		filepath.Join(dir, "fixed", "fixed_gen.go") + `:6:9: strings.Compare(s, "a") == 0 => s == "a" (stringsCompare)`,
+		filepath.Join(dir, "protected", "protected.go") + `:6:9: strings.Compare(s, "a") == 0 => s == "a" (stringsCompare)`,
+	}
+	have := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+
+	for filename := range files {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fixed := !strings.Contains(string(data), "strings.Compare")
+		wantFixed := filepath.Base(filename) == "fixed.go"
+		if fixed != wantFixed {
+			t.Errorf("%s: have fixed=%v, want fixed=%v", filename, fixed, wantFixed)
+		}
+	}
+}
+
+func TestLintFixManifest(t *testing.T) {
+	dir := filepath.Join("testdata", "flagstest", "fixManifest")
+	defer os.RemoveAll(dir)
+
+	const src = `package fixManifest
+
+import "strings"
+
+func isA(s string) bool {
+	return strings.Compare(s, "a") == 0
+}
+
+func replaceA(s string) string {
+	return strings.Replace(s, "a", "b", -1)
+}
+`
+	filename := filepath.Join(dir, "fixManifest.go")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, []byte(src), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	manifestFilename := filepath.Join(t.TempDir(), "manifest.json")
+	args := []string{
+		"--fix",
+		"--no-color",
+		"--quiet",
+		"--fix-manifest", manifestFilename,
+		"./testdata/flagstest/fixManifest/...",
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+
+	data, err := os.ReadFile(manifestFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries []fixManifestEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		t.Fatalf("decode manifest: %v", err)
+	}
+
+	type edit struct {
+		Filename string
+		Rule     string
+		Pass     int
+		OldText  string
+		NewText  string
+	}
+	want := []edit{
+		{filename, "stringsCompare", 0, `strings.Compare(s, "a") == 0`, `s == "a"`},
+		{filename, "replaceAll", 0, `strings.Replace(s, "a", "b", -1)`, `strings.ReplaceAll(s, "a", "b")`},
+	}
+	var have []edit
+	for _, e := range entries {
+		if src[e.Start:e.End] != e.OldText {
+			t.Errorf("%s: the [%d:%d] range is %q, the old text is %q", e.Rule, e.Start, e.End, src[e.Start:e.End], e.OldText)
+		}
+		if _, err := time.Parse(time.RFC3339, e.Time); err != nil {
+			t.Errorf("%s: bad time: %v", e.Rule, err)
+		}
+		have = append(have, edit{e.Filename, e.Rule, e.Pass, e.OldText, e.NewText})
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatalf("manifest mismatch (-want +have):\n%s", diff)
+	}
+
+	// Applying the recorded edits gives the fixed file.
+	fixed := src
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		fixed = fixed[:e.Start] + e.NewText + fixed[e.End:]
+	}
+	data, err = os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(fixed, string(data)); diff != "" {
+		t.Errorf("the manifest edits don't match the fixed file (-want +have):\n%s", diff)
+	}
+
+	{
+		args := []string{"--fix-manifest", manifestFilename, "./testdata/flagstest/fixManifest/..."}
+		_, err := cmdLint(&stdout, &stderr, args)
+		if err == nil || !strings.Contains(err.Error(), "-fix-manifest requires -fix") {
+			t.Errorf("expected a -fix-manifest without -fix error, got %v", err)
+		}
+	}
+}
+
+func TestLintPatchesDir(t *testing.T) {
+	patchesDir := t.TempDir()
+	filename := filepath.FromSlash("testdata/flagstest/patchesDir/patchesDir.go")
+	original, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := []string{
+		"--no-color",
+		"--quiet",
+		"--patches-dir", patchesDir,
+		"./testdata/flagstest/patchesDir/",
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+
+	// The patched issues are not reported and the file is not modified.
+	if stdout.Len() != 0 {
+		t.Errorf("unexpected output:\n%s", stdout.String())
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(original), string(data)); diff != "" {
+		t.Errorf("%s is modified (-want +have):\n%s", filename, diff)
+	}
+
+	want := map[string]string{
+		"replaceAll.patch": `--- a/testdata/flagstest/patchesDir/patchesDir.go
++++ b/testdata/flagstest/patchesDir/patchesDir.go
+@@ -7,7 +7,7 @@
+ }
+ 
+ func replaceA(s string) string {
+-	return strings.Replace(s, "a", "b", -1)
++	return strings.ReplaceAll(s, "a", "b")
+ }
+ 
+ func isB(s string) bool {
+`,
+		"stringsCompare.patch": `--- a/testdata/flagstest/patchesDir/patchesDir.go
++++ b/testdata/flagstest/patchesDir/patchesDir.go
+@@ -3,7 +3,7 @@
+ import "strings"
+ 
+ func isA(s string) bool {
+-	return strings.Compare(s, "a") == 0
++	return s == "a"
+ }
+ 
+ func replaceA(s string) string {
+@@ -11,5 +11,5 @@
+ }
+ 
+ func isB(s string) bool {
+-	return strings.Compare(s, "b") == 0
++	return s == "b"
+ }
+`,
+	}
+	have := make(map[string]string)
+	for _, filename := range readdir(t, patchesDir) {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		have[filepath.Base(filename)] = string(data)
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("patches mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestLintGoVersion(t *testing.T) {
+	filename := filepath.FromSlash("testdata/flagstest/goVersion/goVersion.go")
+	tests := []struct {
+		goVersion string
+		want      []string
+	}{
+		{
+			goVersion: "",
+			want: []string{
+				filename + `:4:2: for ... { ... } => clear(m) (mapClear)`,
+				filename + `:7:2: m = make(map[string]int, len(m)) => clear(m) (mapClear)`,
+			},
+		},
+		{
+			goVersion: "1.21",
+			want: []string{
+				filename + `:4:2: for ... { ... } => clear(m) (mapClear)`,
+				filename + `:7:2: m = make(map[string]int, len(m)) => clear(m) (mapClear)`,
+			},
+		},
+		{
+			goVersion: "1.20",
+			want: []string{
+				filename + `:7:2: m = make(map[string]int, len(m)) => for k := range m { delete(m, k) } (mapClear)`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		args := []string{
+			"--no-color",
+			"--quiet",
+			"--go", test.goVersion,
+			"./testdata/flagstest/goVersion/...",
+		}
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("go=%q: errors:\n%s", test.goVersion, stderr.String())
+		}
+		have := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		if diff := cmp.Diff(test.want, have); diff != "" {
+			t.Errorf("go=%q: output mismatch (-want +have):\n%s", test.goVersion, diff)
+		}
+	}
+}
+
+func TestLintNewRulesOnly(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	data, err := os.ReadFile(filepath.FromSlash("testdata/flagstest/newRulesOnly/newRulesOnly.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The test package is committed to a new git repository,
+	// then the replaceB func is added to it.
+	dir := t.TempDir()
+	writeFile := func(name, text string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(text), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %v: %s", args[0], err, out)
+		}
+	}
+	writeFile("go.mod", "module example.com/newRulesOnly\n\ngo 1.17\n")
+	writeFile("newRulesOnly.go", string(data))
+	git("init", "-q")
+	git("add", ".")
+	git("commit", "-q", "-m", "initial commit")
+	writeFile("newRulesOnly.go", string(data)+`
+func replaceB(s string) string {
+	return strings.Replace(s, "b", "c", -1)
+}
+`)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	runLint := func(args ...string) string {
+		t.Helper()
+		args = append([]string{"--no-color", "--quiet"}, args...)
+		args = append(args, "./...")
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+		return stdout.String()
+	}
+
+	// The snapshot is taken before the replaceAll rule was added.
+	snapshotFilename := filepath.Join(dir, "rules.txt")
+	runLint("--rules-snapshot", snapshotFilename, "--write-rules-snapshot")
+	snapshot, err := os.ReadFile(snapshotFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(snapshot), "\nreplaceAll\n") {
+		t.Fatalf("replaceAll is missing in the rules snapshot:\n%s", snapshot)
+	}
+	snapshot = bytes.Replace(snapshot, []byte("\nreplaceAll\n"), []byte("\n"), 1)
+	if err := os.WriteFile(snapshotFilename, snapshot, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The replaceAll issue of the unchanged replaceA func is not reported.
+	want := []string{
+		`newRulesOnly.go:6:9: strings.Compare(s, "a") == 0 => s == "a" (stringsCompare)`,
+		`newRulesOnly.go:14:9: strings.Replace(s, "b", "c", -1) => strings.ReplaceAll(s, "b", "c") (replaceAll)`,
+	}
+	have := strings.Split(strings.TrimSpace(runLint("--rules-snapshot", snapshotFilename, "--new-rules-only")), "\n")
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestLintImpactFilter(t *testing.T) {
+	runLint := func(extraArgs ...string) string {
+		args := []string{"--no-color", "--quiet"}
+		args = append(args, extraArgs...)
+		args = append(args, "./testdata/flagstest/impactFilter/...")
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+		return stdout.String()
+	}
+
+	readabilityIssues := []string{
+		"(lenSignCheck)",
+		"(convRoundTrip)",
+	}
+	perfIssues := []string{
+		"(sprintfConcat)",
+		"(stringsCompare)",
+	}
+
+	{
+		output := runLint()
+		for _, s := range append(perfIssues, readabilityIssues...) {
+			if !strings.Contains(output, s) {
+				t.Errorf("output doesn't contain %q:\n%s", s, output)
+			}
+		}
+	}
+
+	for _, impact := range []string{"allocation,cpu", "alloc, cpu"} {
+		output := runLint("--impact", impact)
+		for _, s := range perfIssues {
+			if !strings.Contains(output, s) {
+				t.Errorf("impact=%s: output doesn't contain %q:\n%s", impact, s, output)
+			}
+		}
+		for _, s := range readabilityIssues {
+			if strings.Contains(output, s) {
+				t.Errorf("impact=%s: output contains %q:\n%s", impact, s, output)
+			}
+		}
+	}
+
+	{
+		output := runLint("--impact", "readability")
+		for _, s := range perfIssues {
+			if strings.Contains(output, s) {
+				t.Errorf("impact=readability: output contains %q:\n%s", s, output)
+			}
+		}
+	}
+
+	{
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		_, err := cmdLint(&stdout, &stderr, []string{"--impact", "memory", "./testdata/flagstest/impactFilter/..."})
+		if err == nil || !strings.Contains(err.Error(), `unknown impact: "memory"`) {
+			t.Errorf("expected an unknown impact error, got %v", err)
+		}
+	}
+}
+
+func TestLintEnableDisable(t *testing.T) {
+	runLint := func(extraArgs ...string) []string {
+		args := []string{"--no-color", "--quiet"}
+		args = append(args, extraArgs...)
+		args = append(args, "./testdata/flagstest/enableDisable/...")
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+		var rules []string
+		for _, l := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+			if l == "" {
+				continue
+			}
+			rules = append(rules, l[strings.LastIndexByte(l, '(')+1:len(l)-1])
+		}
+		return rules
+	}
+
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{nil, []string{"lenSignCheck", "sprintfConcat", "stringsCompare"}},
+		{[]string{"--disable", "stringsCompare"}, []string{"lenSignCheck", "sprintfConcat"}},
+		{[]string{"--disable", "stringsCompare,lenSignCheck"}, []string{"sprintfConcat"}},
+		{[]string{"--enable", "stringsCompare"}, []string{"stringsCompare"}},
+		{[]string{"--enable", "stringsCompare,sprintfConcat", "--disable", "sprintfConcat"}, []string{"stringsCompare"}},
+		// Opt-in checkers are added to the default rules.
+		{[]string{"--enable", "jsonTags"}, []string{"lenSignCheck", "sprintfConcat", "stringsCompare"}},
+		{[]string{"--enable", "jsonTags,lenSignCheck"}, []string{"lenSignCheck"}},
+	}
+	for _, test := range tests {
+		have := runLint(test.args...)
+		if diff := cmp.Diff(test.want, have); diff != "" {
+			t.Errorf("%v: rules mismatch (-want +have):\n%s", test.args, diff)
+		}
+	}
+
+	for _, flagName := range []string{"--enable", "--disable"} {
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		args := []string{flagName, "stringsCompare,noSuchRule", "./testdata/flagstest/enableDisable/..."}
+		_, err := cmdLint(&stdout, &stderr, args)
+		if err == nil {
+			t.Fatalf("%s: expected an unknown rule error", flagName)
+		}
+		for _, s := range []string{"unknown rules: noSuchRule;", "valid names are:", "stringsCompare", "jsonTags"} {
+			if !strings.Contains(err.Error(), s) {
+				t.Errorf("%s: error doesn't contain %q: %v", flagName, s, err)
+			}
+		}
+	}
+}
+
+func TestLintCI(t *testing.T) {
+	// Colors and the issues count on stderr are disabled by the preset.
+	args := []string{"--ci", "./testdata/flagstest/ci/..."}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	numFailing, err := cmdLint(&stdout, &stderr, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+	if numFailing != 3 {
+		t.Errorf("have %d failing issues, want 3", numFailing)
+	}
+
+	a := filepath.FromSlash("testdata/flagstest/ci/a.go")
+	b := filepath.FromSlash("testdata/flagstest/ci/b.go")
+	want := strings.Join([]string{
+		a + ":6:6: len(s1) >= 1 => len(s1) > 0 (lenSignCheck)",
+		a + ":7:6: strings.Compare(s1, s2) == 0 => s1 == s2 (stringsCompare)",
+		"",
+		b + ":4:6: len(s) < 1 => len(s) == 0 (lenSignCheck)",
+		"perfguard: 3 new issues across 2 files",
+	}, "\n") + "\n"
+	if diff := cmp.Diff(want, stdout.String()); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+
+	errorTests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--ci", "--format", "json"}, "-ci requires the text output format"},
+		{[]string{"--ci", "--error-rules", "lenSignCheck"}, "-ci can't be combined with -error-rules"},
+	}
+	for _, test := range errorTests {
+		args := append(test.args, "./testdata/flagstest/ci/...")
+		_, err := cmdLint(&stdout, &stderr, args)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%v: have %v error, want %q", test.args, err, test.want)
+		}
+	}
+}
+
+func TestLintBaseline(t *testing.T) {
+	dir := filepath.Join("testdata", "flagstest", "baseline")
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "baseline.go")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	baselineFilename := filepath.Join(t.TempDir(), "baseline.json")
+	runLint := func(src string, extraArgs ...string) string {
+		t.Helper()
+		if err := os.WriteFile(filename, []byte(src), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		args := []string{"--no-color", "--quiet", "--baseline", baselineFilename}
+		args = append(args, extraArgs...)
+		args = append(args, "./testdata/flagstest/baseline/...")
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if _, err := cmdLint(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+		return stdout.String()
+	}
+
+	const src1 = `package baseline
+
+import "strings"
+
+func f(s1, s2 string) {
+	_ = strings.Compare(s1, s2) == 0
+	_ = len(s1) >= 1
+}
+`
+	// All issues are reported and recorded.
+	if n := strings.Count(runLint(src1, "--write-baseline"), "\n"); n != 2 {
+		t.Fatalf("have %d issues with -write-baseline, want 2", n)
+	}
+	data, err := os.ReadFile(baselineFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries []baselineEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		t.Fatalf("decode baseline: %v", err)
+	}
+	for _, e := range entries {
+		if e.Filename != "testdata/flagstest/baseline/baseline.go" || e.Fingerprint == "" {
+			t.Errorf("bad baseline entry: %+v", e)
+		}
+	}
+
+	// Baseline hit: the recorded issues are not reported.
+	if have := runLint(src1); have != "" {
+		t.Errorf("unexpected issues:\n%s", have)
+	}
+
+	// The first issue is moved down, the second one is changed.
+	// The new issue is a copy of the recorded one: the baseline
+	// entry matches only one of them.
+	const src2 = `package baseline
+
+import "strings"
+
+func f(s1, s2 string) {
+	println("moved")
+
+	_ = strings.Compare(s1, s2) == 0
+	_ = len(s2) >= 1
+	_ = strings.Compare(s1, s2) == 0
+}
+`
+	want := strings.Join([]string{
+		filename + ":9:6: len(s2) >= 1 => len(s2) > 0 (lenSignCheck)",
+		filename + ":10:6: strings.Compare(s1, s2) == 0 => s1 == s2 (stringsCompare)",
+	}, "\n") + "\n"
+	if diff := cmp.Diff(want, runLint(src2)); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+
+	{
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		args := []string{"--write-baseline", "./testdata/flagstest/baseline/..."}
+		_, err := cmdLint(&stdout, &stderr, args)
+		if err == nil || !strings.Contains(err.Error(), "-write-baseline requires -baseline") {
+			t.Errorf("expected a -write-baseline without -baseline error, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// mergeKey is a warning fingerprint used to dedup the merged results.
+//
+// The enclosing func name and the autofixable flag are not a part of it:
+// they describe the same warning and can differ only if the shards were
+// executed with a different set of flags.
+type mergeKey struct {
+	filename string
+	line     int
+	column   int
+	rule     string
+	message  string
+}
+
+func cmdMerge(stdout, stderr io.Writer, args []string) (int, error) {
+	fs := flag.NewFlagSet("perfguard merge", flag.ExitOnError)
+	format := fs.String("format", "text",
+		`output format: text, json (one JSON object per line), checkstyle (XML) or summary (issues count per file)`)
+	quiet := fs.Bool("quiet", false,
+		`do not print extra results information and stats`)
+	_ = fs.Parse(args)
+
+	filenames := fs.Args()
+	if len(filenames) == 0 {
+		return 0, errors.New("no files to merge provided")
+	}
+	switch *format {
+	case "text", "json", "checkstyle", "summary":
+		// OK.
+	default:
+		return 0, fmt.Errorf("unsupported output format: %q", *format)
+	}
+
+	var warnings []jsonWarning
+	seen := make(map[mergeKey]struct{})
+	numDuplicates := 0
+	for _, filename := range filenames {
+		fileWarnings, err := readJSONWarnings(filename)
+		if err != nil {
+			return 0, err
+		}
+		for _, w := range fileWarnings {
+			key := mergeKey{filename: w.Filename, line: w.Line, column: w.Column, rule: w.Rule, message: w.Message}
+			if _, ok := seen[key]; ok {
+				numDuplicates++
+				continue
+			}
+			seen[key] = struct{}{}
+			warnings = append(warnings, w)
+		}
+	}
+
+	sort.SliceStable(warnings, func(i, j int) bool {
+		x := &warnings[i]
+		y := &warnings[j]
+		if x.Filename != y.Filename {
+			return x.Filename < y.Filename
+		}
+		if x.Line != y.Line {
+			return x.Line < y.Line
+		}
+		if x.Column != y.Column {
+			return x.Column < y.Column
+		}
+		if x.Rule != y.Rule {
+			return x.Rule < y.Rule
+		}
+		return x.Message < y.Message
+	})
+
+	if err := writeMergedWarnings(stdout, *format, warnings); err != nil {
+		return 0, err
+	}
+
+	if !*quiet {
+		fmt.Fprintf(stderr, "Merged %d issues from %d files (%d duplicates removed)\n",
+			len(warnings), len(filenames), numDuplicates)
+	}
+
+	return len(warnings), nil
+}
+
+// readJSONWarnings reads a file produced by the json output format.
+func readJSONWarnings(filename string) ([]jsonWarning, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var warnings []jsonWarning
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var w jsonWarning
+		if err := json.Unmarshal(line, &w); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filename, lineNum, err)
+		}
+		warnings = append(warnings, w)
+	}
+	return warnings, scanner.Err()
+}
+
+func writeMergedWarnings(w io.Writer, format string, warnings []jsonWarning) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		for _, warning := range warnings {
+			if err := enc.Encode(warning); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case "checkstyle":
+		// The json output has no severity levels,
+		// -error-rules can't be recovered from it.
+		out := newCheckstyleOutput()
+		for _, warning := range warnings {
+			out.addError(warning.Filename, checkstyleError{
+				Line:     warning.Line,
+				Column:   warning.Column,
+				Severity: "warning",
+				Message:  warning.Message,
+				Source:   warning.Rule,
+			})
+		}
+		return out.Write(w)
+
+	case "summary":
+		out := newSummaryOutput()
+		for _, warning := range warnings {
+			out.add(warning.Filename)
+		}
+		return out.Write(w)
+
+	default:
+		for _, warning := range warnings {
+			var funcString = ""
+			if warning.Func != "" {
+				funcString = " (in " + warning.Func + ")"
+			}
+			// The same file:line:col: message (ruleName) format
+			// as in the text output of the other commands.
+			_, err := fmt.Fprintf(w, "%s:%d:%d: %s%s (%s)\n",
+				warning.Filename, warning.Line, warning.Column, warning.Message, funcString, warning.Rule)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMerge(t *testing.T) {
+	runMerge := func(extraArgs ...string) (string, int) {
+		args := []string{"--quiet"}
+		args = append(args, extraArgs...)
+		args = append(args, "./testdata/mergetest/a.json", "./testdata/mergetest/b.json")
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		issuesCount, err := cmdMerge(&stdout, &stderr, args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+		return stdout.String(), issuesCount
+	}
+
+	{
+		output, issuesCount := runMerge()
+		want := []string{
+			"a.go:3:7: append to a subslice of xs overwrites the xs elements, copy the subslice before appending (subsliceAppendAlias)",
+			"a.go:10:5: strings.ToLower(x) == strings.ToLower(y) => strings.EqualFold(x, y) (equalFold)",
+			"b.go:5:6: strings.Compare(s1, s2) == 0 => s1 == s2 (stringsCompare)",
+			"c.go:7:5: len(s) >= 1 => len(s) > 0 (lenSignCheck)",
+		}
+		have := strings.Split(strings.TrimSpace(output), "\n")
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("merged output mismatch (-want +have):\n%s", diff)
+		}
+		if issuesCount != len(want) {
+			t.Errorf("issues count mismatch: want %d, have %d", len(want), issuesCount)
+		}
+	}
+
+	{
+		output, _ := runMerge("--format", "json")
+		var have []string
+		for _, l := range strings.Split(strings.TrimSpace(output), "\n") {
+			var w jsonWarning
+			if err := json.Unmarshal([]byte(l), &w); err != nil {
+				t.Fatalf("unmarshal %q: %v", l, err)
+			}
+			have = append(have, w.Rule)
+		}
+		want := []string{"subsliceAppendAlias", "equalFold", "stringsCompare", "lenSignCheck"}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("json rules mismatch (-want +have):\n%s", diff)
+		}
+	}
+
+	{
+		output, _ := runMerge("--format", "checkstyle")
+		var out checkstyleOutput
+		if err := xml.Unmarshal([]byte(output), &out); err != nil {
+			t.Fatalf("unmarshal output: %v", err)
+		}
+		var have []string
+		for _, f := range out.Files {
+			for _, e := range f.Errors {
+				have = append(have, fmt.Sprintf("%s:%d:%d: %s", f.Name, e.Line, e.Column, e.Source))
+			}
+		}
+		want := []string{"a.go:3:7: subsliceAppendAlias", "a.go:10:5: equalFold", "b.go:5:6: stringsCompare", "c.go:7:5: lenSignCheck"}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("checkstyle positions mismatch (-want +have):\n%s", diff)
+		}
+	}
+
+	{
+		output, _ := runMerge("--format", "summary")
+		want := "a.go: 2 issues\nb.go: 1 issues\nc.go: 1 issues\n"
+		if diff := cmp.Diff(want, output); diff != "" {
+			t.Errorf("summary output mismatch (-want +have):\n%s", diff)
+		}
+	}
+}
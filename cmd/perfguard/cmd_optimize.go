@@ -13,8 +13,14 @@ func cmdOptimize(stdout, stderr io.Writer, args []string) error {
 	addCommonFlags(r, fs)
 	fs.StringVar(&r.args.heatmapFile, "heatmap", "",
 		`a CPU profile that will be used to build a heatmap, needed for IsHot() filters`)
+	fs.StringVar(&r.args.heatmapFile, "profile", "",
+		`an alias for -heatmap`)
 	fs.Float64Var(&r.args.heatmapThreshold, "heatmap-threshold", 0.5,
 		`a threshold argument used to create a heatmap, see perf-heatmap docs on it`)
+	fs.BoolVar(&r.args.heatPercent, "heat-percent", false,
+		`print the share of the total profile samples time for every issue`)
+	fs.BoolVar(&r.args.annotateSource, "annotate-source", false,
+		`print the analyzed source files with a heat level gutter, only the files with profile samples are printed`)
 	noColor := fs.Bool("no-color", false, `disable colored output`)
 	_ = fs.Parse(args)
 
@@ -23,7 +29,7 @@ func cmdOptimize(stdout, stderr io.Writer, args []string) error {
 	r.coloredOutput = !*noColor
 
 	if r.args.heatmapFile == "" {
-		return errors.New("CPU profile is required, see --heatmap argument")
+		return errors.New("CPU profile is required, see --heatmap (or --profile) argument")
 	}
 
 	return r.Run()
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/pprof/profile"
+
+	"github.com/quasilyte/go-perfguard/internal/testfile"
+)
+
+func TestOptimize(t *testing.T) {
+	tests := readdir(t, filepath.Join("testdata", "optimizetest"))
+	for _, name := range tests {
+		runOptimizeTest(t, filepath.Base(name))
+	}
+}
+
+// runOptimizeTest is like runLintTest, but it runs the optimize command.
+//
+// The CPU profile is generated from the test files:
+// every line that contains a "// hot" comment gets the max heat level.
+// Other lines of the test files have no samples.
+func runOptimizeTest(t *testing.T, name string) {
+	t.Helper()
+	t.Run(name, func(t *testing.T) {
+		dir := filepath.Join("testdata", "optimizetest", name)
+		filenames := readdir(t, dir)
+
+		profileFilename := filepath.Join(t.TempDir(), "cpu.out")
+		writeTestProfile(t, profileFilename, filenames)
+
+		args := []string{
+			"--abs",
+			"--no-color",
+			"--quiet",
+			"--heatmap", profileFilename,
+			"--heatmap-threshold", "1",
+			"./testdata/optimizetest/" + name + "/...",
+		}
+
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if err := cmdOptimize(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("errors:\n%s", stderr.String())
+		}
+
+		var annotations []testfile.Annotation
+		for _, filename := range filenames {
+			data, err := os.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fileAnnotations, err := testfile.Parse(filename, data)
+			if err != nil {
+				t.Fatalf("parse test file annotations: %v", err)
+			}
+			annotations = append(annotations, fileAnnotations...)
+		}
+
+		compareTestResults(t, annotations, stdout.Bytes())
+	})
+}
+
+func writeTestProfile(t *testing.T, profileFilename string, filenames []string) {
+	t.Helper()
+
+	p := &profile.Profile{
+		SampleType: []*profile.ValueType{
+			{Type: "samples", Unit: "count"},
+			{Type: "cpu", Unit: "nanoseconds"},
+		},
+	}
+	newFunction := func(name, filename string) *profile.Function {
+		fn := &profile.Function{
+			ID:       uint64(len(p.Function) + 1),
+			Name:     name,
+			Filename: filename,
+		}
+		p.Function = append(p.Function, fn)
+		return fn
+	}
+	addSample := func(fn *profile.Function, line int, value time.Duration) {
+		loc := &profile.Location{
+			ID:   uint64(len(p.Location) + 1),
+			Line: []profile.Line{{Function: fn, Line: int64(line)}},
+		}
+		p.Location = append(p.Location, loc)
+		p.Sample = append(p.Sample, &profile.Sample{
+			Value:    []int64{1, int64(value)},
+			Location: []*profile.Location{loc},
+		})
+	}
+
+	numHotLines := 0
+	for _, filename := range filenames {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, filename, data, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(string(data), "\n")
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil {
+				continue
+			}
+			profileFunc := newFunction("example.com/"+f.Name.Name+"."+fn.Name.Name, filename)
+			from := fset.Position(fn.Pos()).Line
+			to := fset.Position(fn.End()).Line
+			for line := from; line <= to; line++ {
+				if strings.Contains(lines[line-1], "// hot") {
+					addSample(profileFunc, line, time.Second)
+					numHotLines++
+				}
+			}
+		}
+	}
+	if numHotLines == 0 {
+		t.Fatal("found no // hot lines")
+	}
+
+	// Heat levels are distributed evenly between the samples.
+	// Adding some colder samples ensures that all hot lines get the max level.
+	filler := newFunction("example.com/filler.f", "filler.go")
+	for i := 0; i < numHotLines*4; i++ {
+		addSample(filler, i+1, time.Millisecond)
+	}
+
+	var buf bytes.Buffer
+	if err := p.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(profileFilename, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOptimizeAnnotateSource(t *testing.T) {
+	dir := filepath.Join("testdata", "flagstest", "annotateSource")
+	profileFilename := filepath.Join(t.TempDir(), "cpu.out")
+	writeTestProfile(t, profileFilename, readdir(t, dir))
+
+	args := []string{
+		"--no-color",
+		"--quiet",
+		"--annotate-source",
+		"--heatmap", profileFilename,
+		"--heatmap-threshold", "1",
+		"./testdata/flagstest/annotateSource/...",
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := cmdOptimize(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+
+	// Only the // hot lines should get a heat level gutter.
+	// The annotated source goes after the package warnings.
+	filename := filepath.ToSlash(filepath.Join(dir, "annotateSource.go"))
+	want := []string{
+		filename + ":16:6: formatting xs on a hot path is expensive, remove it or guard it behind a debug flag (hotDebugFormat, 1s)",
+		"== " + filename,
+		"   1   | package annotateSource",
+		"   2   | ",
+		"   3   | import (",
+		"   4   | \t\"fmt\"",
+		"   5   | )",
+		"   6   | ",
+		"   7   | func f(xs []int) int {",
+		"   8   | \ttotal := 0",
+		"   9 5 | \tfor _, x := range xs { // hot",
+		"  10 5 | \t\ttotal += x // hot",
+		"  11   | \t}",
+		"  12   | \treturn total",
+		"  13   | }",
+		"  14   | ",
+		"  15   | func g(xs []int) {",
+		"  16 5 | \t_ = fmt.Sprint(xs) // hot",
+		"  17   | }",
+	}
+	have := strings.Split(strings.TrimSuffix(filepath.ToSlash(stdout.String()), "\n"), "\n")
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestOptimizeProfileHeatLevels(t *testing.T) {
+	// The heat levels are computed by the perf-heatmap index:
+	// the samples are aggregated by line and the lines are ranked
+	// by their samples time, the hottest lines get the max level (5).
+	// A location with inlined frames has the innermost frame first,
+	// so the samples are attributed to the inlined function line
+	// and to the line it's inlined to.
+	dir := filepath.Join("testdata", "flagstest", "profileHeat")
+	filename := filepath.Join(dir, "profileHeat.go")
+
+	p := &profile.Profile{
+		SampleType: []*profile.ValueType{
+			{Type: "samples", Unit: "count"},
+			{Type: "cpu", Unit: "nanoseconds"},
+		},
+	}
+	funcF := &profile.Function{ID: 1, Name: "example.com/profileHeat.f", Filename: filename}
+	funcAdd := &profile.Function{ID: 2, Name: "example.com/profileHeat.add", Filename: filename}
+	p.Function = []*profile.Function{funcF, funcAdd}
+	addSample := func(value time.Duration, lines ...profile.Line) {
+		loc := &profile.Location{
+			ID:   uint64(len(p.Location) + 1),
+			Line: lines,
+		}
+		p.Location = append(p.Location, loc)
+		p.Sample = append(p.Sample, &profile.Sample{
+			Value:    []int64{1, int64(value)},
+			Location: []*profile.Location{loc},
+		})
+	}
+	addSample(1*time.Second, profile.Line{Function: funcF, Line: 11})
+	addSample(2*time.Second, profile.Line{Function: funcF, Line: 12})
+	addSample(3*time.Second, profile.Line{Function: funcF, Line: 13})
+	addSample(2*time.Second, profile.Line{Function: funcF, Line: 14})
+	addSample(2*time.Second, profile.Line{Function: funcF, Line: 14})
+	addSample(5*time.Second, profile.Line{Function: funcF, Line: 9})
+	addSample(6*time.Second,
+		profile.Line{Function: funcAdd, Line: 20},
+		profile.Line{Function: funcF, Line: 10})
+
+	var buf bytes.Buffer
+	if err := p.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	profileFilename := filepath.Join(t.TempDir(), "cpu.pprof")
+	if err := os.WriteFile(profileFilename, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	args := []string{
+		"--no-color",
+		"--quiet",
+		"--annotate-source",
+		"--profile", profileFilename,
+		"--heatmap-threshold", "1",
+		"./testdata/flagstest/profileHeat/...",
+	}
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := cmdOptimize(&stdout, &stderr, args); err != nil {
+		t.Fatal(err)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("errors:\n%s", stderr.String())
+	}
+
+	// The line 14 samples are aggregated, so it's hotter than the line 13.
+	// The inlined add call samples make both lines 10 and 20 the hottest ones,
+	// the tie is resolved in favor of the line with a bigger number.
+	want := []string{
+		"== " + filepath.ToSlash(filename),
+		"   1   | package profileHeat",
+		"   2   | ",
+		"   3   | func f(xs []int) int {",
+		"   4   | \ta := 0",
+		"   5   | \tb := 0",
+		"   6   | \tc := 0",
+		"   7   | \td := 0",
+		"   8   | \te := 0",
+		"   9 3 | \tfor _, x := range xs {",
+		"  10 4 | \t\ta += add(x, 1)",
+		"  11 1 | \t\tb += x",
+		"  12 1 | \t\tc += x",
+		"  13 2 | \t\td += x",
+		"  14 3 | \t\te += x",
+		"  15   | \t}",
+		"  16   | \treturn a + b + c + d + e",
+		"  17   | }",
+		"  18   | ",
+		"  19   | func add(x, y int) int {",
+		"  20 5 | \treturn x + y",
+		"  21   | }",
+	}
+	have := strings.Split(strings.TrimSuffix(filepath.ToSlash(stdout.String()), "\n"), "\n")
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("output mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestOptimizeHeatPercent(t *testing.T) {
+	dir := filepath.Join("testdata", "flagstest", "heatPercent")
+	profileFilename := filepath.Join(t.TempDir(), "cpu.out")
+	writeTestProfile(t, profileFilename, readdir(t, dir))
+
+	// The profile has 2 hot lines (1s each) and the filler samples (8ms in total),
+	// so every hot line gets 1s/2.008s of the total time.
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{
+			format: "text",
+			want: []string{
+				"heatPercent.go:8:6: formatting xs on a hot path is expensive, remove it or guard it behind a debug flag (hotDebugFormat, 1s, 49.80%)",
+				"heatPercent.go:9:6: formatting m on a hot path is expensive, remove it or guard it behind a debug flag (hotDebugFormat, 1s, 49.80%)",
+			},
+		},
+		{
+			format: "json",
+			want: []string{
+				`{"file":"testdata/flagstest/heatPercent/heatPercent.go","line":8,"column":6,"end_line":8,"end_column":27,"rule":"hotDebugFormat","message":"formatting xs on a hot path is expensive, remove it or guard it behind a debug flag","mode":"optimize","tags":["o2","score2"],"autofixable":false,"heat_percent":49.8,"heat_level":5}`,
+				`{"file":"testdata/flagstest/heatPercent/heatPercent.go","line":9,"column":6,"end_line":9,"end_column":19,"rule":"hotDebugFormat","message":"formatting m on a hot path is expensive, remove it or guard it behind a debug flag","mode":"optimize","tags":["o2","score2"],"autofixable":false,"heat_percent":49.8,"heat_level":5}`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		args := []string{
+			"--no-color",
+			"--quiet",
+			"--heat-percent",
+			"--format", test.format,
+			"--heatmap", profileFilename,
+			"--heatmap-threshold", "1",
+			"./testdata/flagstest/heatPercent/...",
+		}
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if err := cmdOptimize(&stdout, &stderr, args); err != nil {
+			t.Fatal(err)
+		}
+		if stderr.Len() != 0 {
+			t.Fatalf("%s: errors:\n%s", test.format, stderr.String())
+		}
+		have := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		for i := range have {
+			have[i] = strings.TrimPrefix(have[i], "testdata/flagstest/heatPercent/")
+		}
+		if diff := cmp.Diff(test.want, have); diff != "" {
+			t.Errorf("%s: output mismatch (-want +have):\n%s", test.format, diff)
+		}
+	}
+}
@@ -6,13 +6,61 @@ import (
 
 func addCommonFlags(r *runner, fs *flag.FlagSet) {
 	fs.BoolVar(&r.autofix, "fix", false,
-		`apply the suggested fixes automatically, where possible`)
+		`apply the suggested fixes automatically, where possible; the fixed packages are checked again to apply the cascading fixes`)
+	fs.StringVar(&r.args.fixExclude, "fix-exclude", "",
+		`comma-separated list of file globs that -fix never modifies, their issues are reported instead`)
+	fs.StringVar(&r.args.patchesDir, "patches-dir", "",
+		`write the suggested fixes into the directory as one unified diff per rule instead of modifying the files`)
+	fs.StringVar(&r.args.fixManifest, "fix-manifest", "",
+		`write every edit applied by -fix into the JSON file: file, rule, byte range, old and new text`)
 	fs.StringVar(&r.goVersion, "go", "",
 		`select the Go version to target; leave as empty string for the latest`)
 	fs.BoolVar(&r.absFilenames, "abs", false,
 		`print absolute filenames in the output`)
 	fs.BoolVar(&r.args.quiet, "quiet", false,
 		`do not print extra results information and stats`)
+	fs.BoolVar(&r.args.stats, "stats", false,
+		`print the issues statistics grouped by the rules impact`)
+	fs.StringVar(&r.args.enable, "enable", "",
+		`comma-separated list of rules to run; opt-in checkers like jsonTags are added to the default rules, other names make only the listed rules run`)
+	fs.StringVar(&r.args.disable, "disable", "",
+		`comma-separated list of rules that never run`)
+	fs.StringVar(&r.args.impact, "impact", "",
+		`comma-separated list of rule impacts to run: alloc (or allocation), cpu, readability; if empty, all rules run`)
 	fs.BoolVar(&r.args.autogen, "autogen", false,
 		`whether to analyze autogenerated files`)
+	fs.BoolVar(&r.args.includeVendor, "include-vendor", false,
+		`report issues in vendor directories and the module cache`)
+	fs.StringVar(&r.args.buildTags, "tags", "",
+		`a comma-separated list of build tags to consider satisfied during the packages loading`)
+	fs.StringVar(&r.args.format, "format", "text",
+		`output format: text, json (one JSON object per line), checkstyle (XML) or summary (issues count per file)`)
+	fs.BoolVar(&r.args.verifyFixes, "verify-fixes", false,
+		`type-check every suggested fix and discard the ones that break the compilation`)
+	fs.BoolVar(&r.args.fixableOnly, "fixable-only", false,
+		`report only the issues that come with a suggested fix`)
+	fs.IntVar(&r.args.minComplexity, "min-complexity", 0,
+		`report only the issues inside functions with a cyclomatic complexity above N, 0 means no limit`)
+	fs.IntVar(&r.args.maxPerRule, "max-per-rule", 0,
+		`report at most N issues per rule, 0 means no limit`)
+	fs.BoolVar(&r.args.showFunc, "show-func", false,
+		`print the enclosing function name for every issue`)
+	fs.BoolVar(&r.args.showSuggestion, "show-suggestion", false,
+		`print the suggested replacement as before => after for every fixable issue in the text output`)
+	fs.StringVar(&r.args.docsBaseURL, "docs-base-url", "",
+		`add a rule documentation link to every issue, the link is formed as URL#ruleName`)
+	fs.StringVar(&r.args.rulesSnapshot, "rules-snapshot", "",
+		`a file with the rule names, one per line; see -write-rules-snapshot and -new-rules-only`)
+	fs.BoolVar(&r.args.writeRulesSnapshot, "write-rules-snapshot", false,
+		`write the enabled rule names to the -rules-snapshot file`)
+	fs.BoolVar(&r.args.newRulesOnly, "new-rules-only", false,
+		`report the issues of the rules missing in the -rules-snapshot only for the lines changed since -new-rules-since`)
+	fs.StringVar(&r.args.newRulesSince, "new-rules-since", "HEAD",
+		`a git revision to compute the changed lines for -new-rules-only`)
+	fs.StringVar(&r.args.baseline, "baseline", "",
+		`a JSON file with the known issues that are not reported; see -write-baseline`)
+	fs.BoolVar(&r.args.writeBaseline, "write-baseline", false,
+		`record all found issues to the -baseline file`)
+	fs.StringVar(&r.args.debugRule, "debug-rule", "",
+		`print the match context of the specified rule to stderr`)
 }
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/types"
+	"os"
+
+	"github.com/quasilyte/go-perfguard/internal/imports"
+	"github.com/quasilyte/go-perfguard/internal/quickfix"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+// fixChecker type-checks the package files with a suggested fix applied.
+//
+// The other package files are used as is; the imports are resolved
+// from the already loaded package dependencies. Imports added by the fix
+// are loaded with the default importer.
+type fixChecker struct {
+	target *lint.Target
+
+	fallbackImporter types.Importer
+
+	// packageOK is false if the unmodified package can't be type-checked
+	// by the fixChecker, so there is no way to verify the fixes.
+	packageOK bool
+}
+
+func newFixChecker(target *lint.Target) *fixChecker {
+	c := &fixChecker{
+		target:           target,
+		fallbackImporter: importer.Default(),
+	}
+	files := make([]*ast.File, len(target.Files))
+	for i, f := range target.Files {
+		files[i] = f.Syntax
+	}
+	c.packageOK = c.check(files) == nil
+	return c
+}
+
+func (c *fixChecker) Import(path string) (*types.Package, error) {
+	for _, imported := range c.target.Pkg.Imports() {
+		if imported.Path() == path {
+			return imported, nil
+		}
+	}
+	return c.fallbackImporter.Import(path)
+}
+
+// Verify returns an error if the warning fixes break the compilation.
+func (c *fixChecker) Verify(w *lint.Warning) error {
+	if !c.packageOK {
+		return nil
+	}
+
+	filename := c.target.Fset.Position(w.Fixes[0].From).Filename
+	edits := make([]quickfix.TextEdit, len(w.Fixes))
+	for i, fix := range w.Fixes {
+		edits[i] = quickfix.TextEdit{
+			StartOffset: c.target.Fset.Position(fix.From).Offset,
+			EndOffset:   c.target.Fset.Position(fix.To).Offset,
+			Replacement: fix.Replacement,
+		}
+	}
+	fileText, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	newText, _ := quickfix.Apply(fileText, edits)
+	newText, err = imports.Fix(imports.FixConfig{StdlibPackages: stdlibPackages}, newText)
+	if err != nil {
+		return fmt.Errorf("fix imports: %w", err)
+	}
+
+	// The patched file is added to the same file set,
+	// so the positions inside the package are consistent.
+	patched, err := parser.ParseFile(c.target.Fset, filename, newText, 0)
+	if err != nil {
+		return err
+	}
+	files := make([]*ast.File, 0, len(c.target.Files))
+	for _, f := range c.target.Files {
+		if c.target.Fset.Position(f.Syntax.Pos()).Filename == filename {
+			files = append(files, patched)
+		} else {
+			files = append(files, f.Syntax)
+		}
+	}
+	return c.check(files)
+}
+
+func (c *fixChecker) check(files []*ast.File) error {
+	var firstErr error
+	config := types.Config{
+		Importer: c,
+		Sizes:    c.target.Sizes,
+		Error: func(err error) {
+			if firstErr == nil {
+				firstErr = err
+			}
+		},
+	}
+	_, _ = config.Check(c.target.Pkg.Path(), c.target.Fset, files, nil)
+	if firstErr != nil {
+		var typeErr types.Error
+		if errors.As(firstErr, &typeErr) {
+			return errors.New(typeErr.Msg)
+		}
+	}
+	return firstErr
+}
@@ -32,6 +32,12 @@ func main() {
 			Do:          optimizeMain,
 		},
 
+		{
+			Name:        "merge",
+			Description: "merge the json outputs of several runs into one report",
+			Do:          mergeMain,
+		},
+
 		{
 			Name:        "version",
 			Description: "print perfguard version info",
@@ -86,3 +92,14 @@ func optimizeMain(args []string) {
 		log.Fatalf("perfguard optimize: error: %+v", err)
 	}
 }
+
+func mergeMain(args []string) {
+	issuesCount, err := cmdMerge(os.Stdout, os.Stderr, args)
+	if err != nil {
+		log.Fatalf("perfguard merge: error: %+v", err)
+	}
+
+	if issuesCount > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/quasilyte/go-perfguard/internal/quickfix"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+// jsonWarning is a lint.Warning representation for the json output format.
+type jsonWarning struct {
+	Filename  string `json:"file"`
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	EndLine   int    `json:"end_line"`
+	EndColumn int    `json:"end_column"`
+	Rule      string `json:"rule"`
+	Message   string `json:"message"`
+
+	// Mode is a command that reported the warning: lint or optimize.
+	Mode string `json:"mode"`
+
+	// Tags are the rule doc tags, like o1 and score2.
+	Tags []string `json:"tags"`
+
+	// SuggestedReplacement is the suggested fix replacement text,
+	// the replacements of a multi-edit fix are separated by newlines.
+	// It's empty for the report-only warnings.
+	SuggestedReplacement string `json:"suggested_replacement,omitempty"`
+
+	// Func is an enclosing function name, it's only set with -show-func option.
+	Func string `json:"func,omitempty"`
+
+	// Docs is a rule documentation link, it's only set with -docs-base-url option.
+	Docs string `json:"docs,omitempty"`
+
+	// Autofixable is true for the warnings that come with a suggested fix,
+	// it's safe to apply it without a manual review.
+	// Report-only warnings are advisory and have this field set to false.
+	Autofixable bool `json:"autofixable"`
+
+	// HeatPercent is a share of the total profile samples time
+	// that belongs to the reported code, it's only set with -heat-percent option.
+	HeatPercent float64 `json:"heat_percent,omitempty"`
+
+	// HeatLevel is a max heat level of the reported lines, from 1 to 5.
+	// It's omitted in the lint mode and for the rules without
+	// a heat level requirement.
+	HeatLevel int `json:"heat_level,omitempty"`
+}
+
+func (r *runner) reportWarningJSON(w *lint.Warning, funcName string) {
+	var heatPercent float64
+	if r.args.heatPercent {
+		// Rounded to keep the output readable.
+		heatPercent = math.Round(r.heatPercent(w)*100) / 100
+	}
+	mode := "lint"
+	if r.loadOptRules {
+		mode = "optimize"
+	}
+	tags := w.DocTags
+	if tags == nil {
+		tags = []string{}
+	}
+	replacements := make([]string, len(w.Fixes))
+	for i, fix := range w.Fixes {
+		replacements[i] = string(fix.Replacement)
+	}
+	enc := json.NewEncoder(r.stdout)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(jsonWarning{
+		Filename:             r.displayFilename(w.Filename),
+		Line:                 w.Line,
+		Column:               w.Column,
+		EndLine:              w.EndLine,
+		EndColumn:            w.EndColumn,
+		Rule:                 w.Tag,
+		Message:              w.Text,
+		Mode:                 mode,
+		Tags:                 tags,
+		SuggestedReplacement: strings.Join(replacements, "\n"),
+		Func:                 funcName,
+		Docs:                 r.ruleDocsURL(w.Tag),
+		Autofixable:          len(w.Fixes) != 0,
+		HeatPercent:          heatPercent,
+		HeatLevel:            w.HeatLevel,
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// fixManifestEntry is a single edit applied by the -fix, see -fix-manifest.
+//
+// The byte range refers to the file text of the fix pass:
+// the edits of one pass are applied together to the same text,
+// the next pass works with the updated files.
+// The imports fixing and gofmt changes are not recorded.
+type fixManifestEntry struct {
+	Filename string `json:"file"`
+	Rule     string `json:"rule"`
+	Pass     int    `json:"pass"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+	OldText  string `json:"old_text"`
+	NewText  string `json:"new_text"`
+	Time     string `json:"time"`
+}
+
+func (r *runner) addFixManifestEntry(ruleName, filename string, fileText []byte, fix quickfix.TextEdit) {
+	r.fixManifest = append(r.fixManifest, fixManifestEntry{
+		Filename: r.displayFilename(filename),
+		Rule:     ruleName,
+		Pass:     r.fixPass,
+		Start:    fix.StartOffset,
+		End:      fix.EndOffset,
+		OldText:  string(fileText[fix.StartOffset:fix.EndOffset]),
+		NewText:  string(fix.Replacement),
+		Time:     time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// writeFixManifest writes the -fix-manifest file as a JSON array.
+// The file is written even if no edits were applied.
+func (r *runner) writeFixManifest() error {
+	entries := r.fixManifest
+	if entries == nil {
+		entries = []fixManifestEntry{}
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(entries); err != nil {
+		return err
+	}
+	return os.WriteFile(r.args.fixManifest, buf.Bytes(), 0o644)
+}
+
+// checkstyleOutput is a root element of the checkstyle output format.
+//
+// Unlike other formats, checkstyle can't be streamed:
+// all warnings are collected and then printed at once.
+type checkstyleOutput struct {
+	XMLName xml.Name          `xml:"checkstyle"`
+	Version string            `xml:"version,attr"`
+	Files   []*checkstyleFile `xml:"file"`
+
+	filesByName map[string]*checkstyleFile
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+func newCheckstyleOutput() *checkstyleOutput {
+	return &checkstyleOutput{
+		Version:     "5.0",
+		filesByName: make(map[string]*checkstyleFile),
+	}
+}
+
+func (r *runner) reportWarningCheckstyle(w *lint.Warning) {
+	// There is no info-level severity in perfguard:
+	// rules from -error-rules are errors and everything else is a warning.
+	severity := "warning"
+	if r.isErrorRule(w.Tag) {
+		severity = "error"
+	}
+	// The checkstyle format has no place for the links,
+	// so it's added to the message.
+	message := w.Text
+	if docsURL := r.ruleDocsURL(w.Tag); docsURL != "" {
+		message += " (docs: " + docsURL + ")"
+	}
+	r.checkstyle.addError(r.displayFilename(w.Filename), checkstyleError{
+		Line:     w.Line,
+		Column:   w.Column,
+		Severity: severity,
+		Message:  message,
+		Source:   w.Tag,
+	})
+}
+
+func (out *checkstyleOutput) addError(filename string, e checkstyleError) {
+	f := out.filesByName[filename]
+	if f == nil {
+		f = &checkstyleFile{Name: filename}
+		out.filesByName[filename] = f
+		out.Files = append(out.Files, f)
+	}
+	f.Errors = append(f.Errors, e)
+}
+
+func (out *checkstyleOutput) Write(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// summaryOutput counts the warnings per file for the summary output format.
+type summaryOutput struct {
+	counts map[string]int
+}
+
+func newSummaryOutput() *summaryOutput {
+	return &summaryOutput{counts: make(map[string]int)}
+}
+
+func (r *runner) reportWarningSummary(w *lint.Warning) {
+	r.summary.add(r.displayFilename(w.Filename))
+}
+
+func (out *summaryOutput) add(filename string) {
+	out.counts[filename]++
+}
+
+// Write prints one line per file, the files with more issues go first.
+func (out *summaryOutput) Write(w io.Writer) error {
+	filenames := make([]string, 0, len(out.counts))
+	for filename := range out.counts {
+		filenames = append(filenames, filename)
+	}
+	sort.Slice(filenames, func(i, j int) bool {
+		x := filenames[i]
+		y := filenames[j]
+		if out.counts[x] != out.counts[y] {
+			return out.counts[x] > out.counts[y]
+		}
+		return x < y
+	})
+	for _, filename := range filenames {
+		if _, err := fmt.Fprintf(w, "%s: %d issues\n", filename, out.counts[filename]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ciOutput groups the text output lines by file for the -ci preset.
+//
+// Like checkstyle, it can't be streamed: all lines are collected
+// and then printed at once.
+type ciOutput struct {
+	lines map[string][]ciLine
+}
+
+type ciLine struct {
+	line   int
+	column int
+	text   string
+}
+
+func newCIOutput() *ciOutput {
+	return &ciOutput{lines: make(map[string][]ciLine)}
+}
+
+func (out *ciOutput) add(filename string, line, column int, text string) {
+	out.lines[filename] = append(out.lines[filename], ciLine{
+		line:   line,
+		column: column,
+		text:   text,
+	})
+}
+
+func (out *ciOutput) numIssues() int {
+	n := 0
+	for _, lines := range out.lines {
+		n += len(lines)
+	}
+	return n
+}
+
+// Write prints the files in the lexical order, separated by a blank line.
+// The lines of every file are sorted by their position.
+func (out *ciOutput) Write(w io.Writer) error {
+	filenames := make([]string, 0, len(out.lines))
+	for filename := range out.lines {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	for i, filename := range filenames {
+		if i != 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		lines := out.lines[filename]
+		sort.SliceStable(lines, func(i, j int) bool {
+			if lines[i].line != lines[j].line {
+				return lines[i].line < lines[j].line
+			}
+			return lines[i].column < lines[j].column
+		})
+		for _, l := range lines {
+			if _, err := io.WriteString(w, l.text); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
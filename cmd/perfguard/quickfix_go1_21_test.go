@@ -0,0 +1,20 @@
+//go:build go1.21
+// +build go1.21
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestQuickFixGo1_21(t *testing.T) {
+	dir := filepath.Join("testdata", "quickfix")
+	for _, testDir := range readdir(t, dir) {
+		key := filepath.Base(testDir)
+		if ver := quickfixVersionConstraints[key]; ver != "1.21" {
+			continue
+		}
+		runQuickFixTest(t, testDir)
+	}
+}
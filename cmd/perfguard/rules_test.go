@@ -29,7 +29,7 @@ func TestRules(t *testing.T) {
 	}
 }
 
-func runLintTest(t *testing.T, dirName, name string) {
+func runLintTest(t *testing.T, dirName, name string, extraArgs ...string) {
 	t.Helper()
 	t.Run(name, func(t *testing.T) {
 		dir := filepath.Join("testdata", dirName, name)
@@ -37,8 +37,9 @@ func runLintTest(t *testing.T, dirName, name string) {
 			"--abs",
 			"--no-color",
 			"--quiet",
-			"./testdata/" + dirName + "/" + name + "/...",
 		}
+		args = append(args, extraArgs...)
+		args = append(args, "./testdata/"+dirName+"/"+name+"/...")
 
 		var stdout bytes.Buffer
 		var stderr bytes.Buffer
@@ -68,7 +69,8 @@ func runLintTest(t *testing.T, dirName, name string) {
 	})
 }
 
-var outputLineRegexp = regexp.MustCompile(`(.*?):(\d+): (\w+): (.*)`)
+// In the optimize mode, the rule name is followed by the samples time, like `(ruleName, 1s)`.
+var outputLineRegexp = regexp.MustCompile(`(.*?):(\d+):(\d+): (.*) \((\w+)(?:, .*?)?\)$`)
 
 func compareTestResults(t *testing.T, annotations []testfile.Annotation, output []byte) {
 	t.Helper()
@@ -114,7 +116,7 @@ func compareTestResults(t *testing.T, annotations []testfile.Annotation, output
 			continue
 		}
 		filename := parts[1]
-		tag := parts[2]
+		tag := parts[5]
 		lineNum := atoi(parts[2])
 		messageText := parts[4]
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"go/ast"
 	"go/format"
 	"go/token"
 	"io"
@@ -11,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -18,11 +20,13 @@ import (
 
 	"github.com/google/pprof/profile"
 	"github.com/quasilyte/perf-heatmap/heatmap"
-	"github.com/quasilyte/stdinfo"
 	"golang.org/x/tools/go/packages"
 
+	"github.com/quasilyte/go-perfguard/internal/gitdiff"
 	"github.com/quasilyte/go-perfguard/internal/imports"
 	"github.com/quasilyte/go-perfguard/internal/quickfix"
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/internal/udiff"
 	"github.com/quasilyte/go-perfguard/perfguard"
 	"github.com/quasilyte/go-perfguard/perfguard/lint"
 )
@@ -31,14 +35,110 @@ type arguments struct {
 	heatmapFile      string
 	heatmapThreshold float64
 
+	// heatPercent adds the samples time share to every issue.
+	heatPercent bool
+
+	// annotateSource prints the analyzed files source with a heat level gutter.
+	annotateSource bool
+
 	autogen bool
 
+	// includeVendor disables the vendor and module cache files filtering.
+	includeVendor bool
+
 	quiet bool
+
+	stats bool
+
+	format string
+
+	// ci is set by the lint -ci preset, see ciOutput.
+	ci bool
+
+	showFunc bool
+
+	// showSuggestion appends the suggested replacement to the text output.
+	showSuggestion bool
+
+	// docsBaseURL is used to build the rule documentation links.
+	docsBaseURL string
+
+	// buildTags are passed to the go tool as -tags argument.
+	buildTags string
+
+	// errorRules is a set of rule names that should fail the run.
+	// An empty set means that any rule is treated as an error.
+	errorRules map[string]struct{}
+
+	// enable and disable are comma-separated lists of the rule names,
+	// see perfguard.Config EnableRules and DisableRules.
+	enable  string
+	disable string
+
+	// impact is a comma-separated list of the rule impacts to run.
+	impact string
+
+	// impacts is a parsed impact set; nil means that all rules run.
+	impacts map[string]struct{}
+
+	// verifyFixes enables the type checking of the suggested fixes.
+	verifyFixes bool
+
+	// fixableOnly drops the issues without a suggested fix.
+	fixableOnly bool
+
+	// fixExclude is a comma-separated list of globs for the files
+	// that should never be modified by the -fix.
+	fixExclude string
+
+	// patchesDir is a directory to write the suggested fixes to,
+	// one unified diff per rule, instead of modifying the files.
+	patchesDir string
+
+	// fixManifest is a JSON file to record the edits applied by the -fix.
+	fixManifest string
+
+	// minComplexity drops the issues from the functions with
+	// a cyclomatic complexity that is less or equal to it.
+	// Zero means no limit.
+	minComplexity int
+
+	// maxPerRule limits the number of reported issues per rule.
+	// Zero means no limit.
+	maxPerRule int
+
+	// rulesSnapshot is a file that lists the rule names, one per line.
+	// It's written with writeRulesSnapshot and read with newRulesOnly.
+	rulesSnapshot string
+
+	writeRulesSnapshot bool
+
+	// newRulesOnly limits the issues of the rules that are missing
+	// in the rulesSnapshot to the lines changed since newRulesSince.
+	newRulesOnly  bool
+	newRulesSince string
+
+	// baseline is a JSON file with the known issues, they're not reported.
+	// It's written with writeBaseline instead.
+	baseline string
+
+	writeBaseline bool
+
+	debugRule string
 }
 
 type statistics struct {
 	issuesTotal   int
 	issuesFixable int
+	issuesFailing int
+
+	// issuesByImpact counts the issues per rule impact kind.
+	// Rules without an impact are counted under the "" key.
+	issuesByImpact map[string]int
+
+	// issuesReportedPerRule counts the issues that were reported,
+	// it's used to implement -max-per-rule limit.
+	issuesReportedPerRule map[string]int
 
 	numSamples    int
 	minSampleTime time.Duration
@@ -65,13 +165,48 @@ type runner struct {
 	stats statistics
 
 	heatmap          *heatmap.Index
+	heatmapTotalTime time.Duration
 	heatmapPackages  map[string]struct{}
 	heatmapFiles     map[string]struct{}
+
+	// lineHeat maps the file lines to their max global heat levels.
+	// It's only collected for the -annotate-source.
+	lineHeat map[heatmapFileKey]map[int]int
+
 	numFilesSkipped  int
 	numFilesAnalyzed int
 
 	wd string
 
+	// fixExcludeGlobs are parsed -fix-exclude patterns.
+	fixExcludeGlobs []string
+
+	// snapshotRules are the -rules-snapshot rule names.
+	// It's only collected for the -new-rules-only.
+	snapshotRules map[string]struct{}
+
+	// changedLines are the lines changed since -new-rules-since.
+	changedLines gitdiff.Changes
+
+	// baseline counts the -baseline file entries.
+	baseline map[baselineEntry]int
+
+	// baselineEntries are the reported issues.
+	// It's only collected for the -write-baseline.
+	baselineEntries []baselineEntry
+
+	// patches maps the rule name to its per-file diffs.
+	// It's only collected for the -patches-dir.
+	patches map[string]map[string][]byte
+
+	// fixManifest lists the applied edits in the order of application.
+	// It's only collected for the -fix-manifest.
+	fixManifest []fixManifestEntry
+
+	// modCacheDir is a GOMODCACHE path, it's used to filter out
+	// the module cache files warnings.
+	modCacheDir string
+
 	coloredOutput bool
 	absFilenames  bool
 
@@ -85,6 +220,15 @@ type runner struct {
 
 	pkgWarnings []lint.Warning
 
+	// checkstyle collects the warnings for the checkstyle output format.
+	checkstyle *checkstyleOutput
+
+	// summary collects the warnings for the summary output format.
+	summary *summaryOutput
+
+	// ci collects the text output lines for the -ci preset.
+	ci *ciOutput
+
 	// We try to avoid reporting more errors than necessary.
 	// There is a hard limit on how many errors we'll print.
 	// There is also a filter that will exclude any repeated
@@ -94,8 +238,19 @@ type runner struct {
 	extraErrors int
 
 	numLoadCalls int
+
+	// fixPass is a number of the current package check with -fix,
+	// the first check is 0.
+	fixPass int
+
+	// needRecheck is set when the -fix modified the package files
+	// and the package should be checked again.
+	needRecheck bool
 }
 
+// maxFixPasses is a max number of times a package is checked with -fix.
+const maxFixPasses = 5
+
 func newRunner(stdout, stderr io.Writer) *runner {
 	debugEnabled := os.Getenv("PERFGUARD_DEBUG") == "1"
 	return &runner{
@@ -104,6 +259,11 @@ func newRunner(stdout, stderr io.Writer) *runner {
 		debugEnabled: debugEnabled,
 
 		errorSet: make(map[string]struct{}),
+
+		stats: statistics{
+			issuesByImpact:        make(map[string]int),
+			issuesReportedPerRule: make(map[string]int),
+		},
 	}
 }
 
@@ -156,6 +316,63 @@ func (r *runner) Run() error {
 		return fmt.Errorf("no analysis targets provided")
 	}
 
+	switch r.args.format {
+	case "text", "":
+		if r.args.ci {
+			r.ci = newCIOutput()
+		}
+	case "json":
+		r.coloredOutput = false
+	case "checkstyle":
+		r.coloredOutput = false
+		r.checkstyle = newCheckstyleOutput()
+	case "summary":
+		r.coloredOutput = false
+		r.summary = newSummaryOutput()
+	default:
+		return fmt.Errorf("unsupported output format: %q", r.args.format)
+	}
+
+	for _, pattern := range strings.Split(r.args.fixExclude, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("fix-exclude: %q: %w", pattern, err)
+		}
+		r.fixExcludeGlobs = append(r.fixExcludeGlobs, filepath.FromSlash(pattern))
+	}
+
+	if r.args.impact != "" {
+		impacts, err := parseImpacts(r.args.impact)
+		if err != nil {
+			return fmt.Errorf("impact: %w", err)
+		}
+		r.args.impacts = impacts
+	}
+
+	if r.args.patchesDir != "" {
+		// The fixes are computed in the same way,
+		// they're written to the patches instead of the files.
+		r.autofix = true
+		r.patches = make(map[string]map[string][]byte)
+	}
+
+	if r.args.fixManifest != "" && (!r.autofix || r.patches != nil) {
+		return fmt.Errorf("-fix-manifest requires -fix without -patches-dir")
+	}
+
+	if r.args.writeBaseline {
+		if r.args.baseline == "" {
+			return fmt.Errorf("-write-baseline requires -baseline")
+		}
+		if r.autofix {
+			// The fixed issues would be recorded as the known ones.
+			return fmt.Errorf("-write-baseline can't be combined with -fix")
+		}
+	}
+
 	ctx := context.Background()
 	startTime := time.Now()
 
@@ -164,6 +381,7 @@ func (r *runner) Run() error {
 		return err
 	}
 	r.wd = wd
+	r.modCacheDir = goModCacheDir()
 
 	if r.args.heatmapFile != "" {
 		heatmapIndex, err := r.createHeatmap()
@@ -188,6 +406,26 @@ func (r *runner) Run() error {
 		r.analyzer = analyzer
 	}
 
+	if err := r.checkErrorRules(); err != nil {
+		return err
+	}
+
+	if r.args.writeRulesSnapshot {
+		if err := r.writeRulesSnapshot(); err != nil {
+			return fmt.Errorf("write rules snapshot: %w", err)
+		}
+	}
+	if r.args.newRulesOnly {
+		if err := r.initNewRulesFilter(); err != nil {
+			return fmt.Errorf("new-rules-only: %w", err)
+		}
+	}
+	if r.args.baseline != "" && !r.args.writeBaseline {
+		if err := r.readBaseline(); err != nil {
+			return fmt.Errorf("read baseline: %w", err)
+		}
+	}
+
 	if r.heatmap != nil {
 		filtered := targetPackages[:0]
 		numSkipped := 0
@@ -252,40 +490,71 @@ func (r *runner) Run() error {
 				r.printDebugf("analyzing %s package (%d/%d)", targetPkgPath, numProcessed+i+1, len(targetPackages))
 			}
 
-			target.Files = target.Files[:0]
-			for _, f := range pkg.Syntax {
-				if r.heatmapFiles != nil {
-					filename := fileSet.Position(f.Pos()).Filename
-					if _, ok := r.heatmapFiles[filepath.Base(filename)]; !ok {
-						r.numFilesSkipped++
-						continue
-					}
-				}
-				isAutogen := isAutogenFile(f)
-				if isAutogen {
-					r.stats.numAutogenFiles++
-					if !r.args.autogen {
-						r.numFilesSkipped++
-						continue
-					}
-				}
-				r.numFilesAnalyzed++
-				target.Files = append(target.Files, lint.SourceFile{
-					Syntax: f,
-				})
-			}
-			target.Fset = fileSet
-			target.Sizes = pkg.TypesSizes
-			target.Types = pkg.TypesInfo
-			target.Pkg = pkg.Types
-
+			r.initTarget(target, fileSet, pkg)
 			if err := r.analyzePackage(target); err != nil {
 				return fmt.Errorf("checking %s: %w", pkg.PkgPath, err)
 			}
+
+			// Some fixes produce the code that can be fixed further,
+			// like nested calls that are simplified one by one.
+			// The fixed package is checked again until there is
+			// nothing left to fix, see maxFixPasses.
+			for r.fixPass = 1; r.needRecheck; r.fixPass++ {
+				r.printDebugf("re-checking fixed %s package (pass %d)", targetPkgPath, r.fixPass+1)
+				reloaded, err := r.loadPackages(ctx, fileSet, batchTargets[i:i+1])
+				if err != nil {
+					return err
+				}
+				pkg = reloaded[0]
+				r.initTarget(target, fileSet, pkg)
+				if err := r.analyzePackage(target); err != nil {
+					return fmt.Errorf("checking %s: %w", pkg.PkgPath, err)
+				}
+			}
+			r.fixPass = 0
 		}
 		numProcessed += batchSize
 	}
 
+	if r.checkstyle != nil {
+		if err := r.checkstyle.Write(r.stdout); err != nil {
+			return fmt.Errorf("write checkstyle output: %w", err)
+		}
+	}
+	if r.summary != nil {
+		if err := r.summary.Write(r.stdout); err != nil {
+			return fmt.Errorf("write summary output: %w", err)
+		}
+	}
+	if r.ci != nil {
+		if err := r.ci.Write(r.stdout); err != nil {
+			return fmt.Errorf("write ci output: %w", err)
+		}
+	}
+	if r.args.maxPerRule > 0 && (r.args.format == "text" || r.args.format == "") {
+		r.printCappedRules()
+	}
+	if r.ci != nil {
+		// The summary is always the last stdout line.
+		fmt.Fprintf(r.stdout, "perfguard: %d new issues across %d files\n",
+			r.ci.numIssues(), len(r.ci.lines))
+	}
+	if r.patches != nil {
+		if err := r.writePatches(); err != nil {
+			return fmt.Errorf("write patches: %w", err)
+		}
+	}
+	if r.args.fixManifest != "" {
+		if err := r.writeFixManifest(); err != nil {
+			return fmt.Errorf("write fix manifest: %w", err)
+		}
+	}
+	if r.args.writeBaseline {
+		if err := r.writeBaseline(); err != nil {
+			return fmt.Errorf("write baseline: %w", err)
+		}
+	}
+
 	timeElapsed := time.Since(startTime)
 
 	if !r.args.quiet {
@@ -293,13 +562,20 @@ func (r *runner) Run() error {
 			fmt.Fprintf(r.stderr, "Affected samples time: %s\n", r.stats.affectedSampleTime)
 		}
 		suffix := "auto-fixable"
-		if r.autofix {
+		switch {
+		case r.patches != nil:
+			suffix = "patched"
+		case r.autofix:
 			suffix = "fixed"
 		}
 		fmt.Fprintf(r.stderr, "Found %d issues (%d %s)\n",
 			r.stats.issuesTotal, r.stats.issuesFixable, suffix)
 	}
 
+	if r.args.stats {
+		r.printImpactStats()
+	}
+
 	r.printDebugf("batch size: %d", batchMaxSize)
 
 	if r.heatmap != nil {
@@ -328,8 +604,75 @@ func (r *runner) Run() error {
 	return nil
 }
 
+// printCappedRules prints the number of issues hidden by -max-per-rule.
+// The machine-readable formats don't get these notes.
+func (r *runner) printCappedRules() {
+	ruleNames := make([]string, 0, len(r.stats.issuesReportedPerRule))
+	for ruleName, n := range r.stats.issuesReportedPerRule {
+		if n > r.args.maxPerRule {
+			ruleNames = append(ruleNames, ruleName)
+		}
+	}
+	sort.Strings(ruleNames)
+	for _, ruleName := range ruleNames {
+		fmt.Fprintf(r.stdout, "%s: (+%d more)\n",
+			ruleName, r.stats.issuesReportedPerRule[ruleName]-r.args.maxPerRule)
+	}
+}
+
+func (r *runner) printImpactStats() {
+	byImpact := r.stats.issuesByImpact
+	fmt.Fprintf(r.stderr, "Issues by impact: %d alloc, %d cpu, %d readability, %d unspecified\n",
+		byImpact["alloc"], byImpact["cpu"], byImpact["readability"], byImpact[""])
+	// Every alloc-impact issue removes at least one allocation
+	// per execution of the affected code.
+	fmt.Fprintf(r.stderr, "Estimated avoidable allocations: %d\n", byImpact["alloc"])
+}
+
+// initTarget fills the target with the loaded package data.
+func (r *runner) initTarget(target *lint.Target, fileSet *token.FileSet, pkg *packages.Package) {
+	// The files of a re-checked package are already counted.
+	countFiles := r.fixPass == 0
+
+	target.Files = target.Files[:0]
+	for _, f := range pkg.Syntax {
+		if r.heatmapFiles != nil {
+			filename := fileSet.Position(f.Pos()).Filename
+			if _, ok := r.heatmapFiles[filepath.Base(filename)]; !ok {
+				if countFiles {
+					r.numFilesSkipped++
+				}
+				continue
+			}
+		}
+		isAutogen := isAutogenFile(f)
+		if isAutogen {
+			if countFiles {
+				r.stats.numAutogenFiles++
+			}
+			if !r.args.autogen {
+				if countFiles {
+					r.numFilesSkipped++
+				}
+				continue
+			}
+		}
+		if countFiles {
+			r.numFilesAnalyzed++
+		}
+		target.Files = append(target.Files, lint.SourceFile{
+			Syntax: f,
+		})
+	}
+	target.Fset = fileSet
+	target.Sizes = pkg.TypesSizes
+	target.Types = pkg.TypesInfo
+	target.Pkg = pkg.Types
+}
+
 func (r *runner) analyzePackage(target *lint.Target) error {
 	r.pkgWarnings = r.pkgWarnings[:0]
+	r.needRecheck = false
 	start := time.Now()
 	err := r.analyzer.CheckPackage(target)
 	elapsed := time.Since(start)
@@ -342,6 +685,37 @@ func (r *runner) analyzePackage(target *lint.Target) error {
 			return err
 		}
 	}
+	if r.args.annotateSource && !r.needRecheck {
+		return r.printAnnotatedSource(target)
+	}
+	return nil
+}
+
+// printAnnotatedSource prints the target files that have any profile samples.
+// Every line is prefixed by its number and a max global heat level;
+// the cold lines have an empty heat gutter.
+func (r *runner) printAnnotatedSource(target *lint.Target) error {
+	for _, f := range target.Files {
+		filename := target.Fset.Position(f.Syntax.Pos()).Filename
+		key := heatmapFileKey{pkgName: target.Pkg.Name(), filename: filepath.Base(filename)}
+		levels := r.lineHeat[key]
+		if len(levels) == 0 {
+			continue
+		}
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(r.stdout, "== %s\n", r.displayFilename(filename))
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		for i, line := range lines {
+			gutter := " "
+			if level := levels[i+1]; level != 0 {
+				gutter = strconv.Itoa(level)
+			}
+			fmt.Fprintf(r.stdout, "%4d %s | %s\n", i+1, gutter, line)
+		}
+	}
 	return nil
 }
 
@@ -354,9 +728,16 @@ func (r *runner) createAnalyzer() (*perfguard.Analyzer, error) {
 
 		Warn: r.appendWarning,
 
+		DebugRule:  r.args.debugRule,
+		DebugPrint: r.debugPrint,
+
 		LoadUniversalRules: true,
 		LoadOptRules:       r.loadOptRules,
 		LoadLintRules:      r.loadLintRules,
+
+		EnableRules:  parseNameSet(r.args.enable),
+		DisableRules: parseNameSet(r.args.disable),
+		Impacts:      r.args.impacts,
 	}
 	if err := a.Init(initConfig); err != nil {
 		return nil, err
@@ -364,33 +745,317 @@ func (r *runner) createAnalyzer() (*perfguard.Analyzer, error) {
 	return a, nil
 }
 
+func (r *runner) debugPrint(s string) {
+	fmt.Fprintln(r.stderr, s)
+}
+
 func (r *runner) appendWarning(w lint.Warning) {
+	if !r.args.includeVendor && isThirdPartyFile(w.Filename, r.wd, r.modCacheDir) {
+		return
+	}
 	r.pkgWarnings = append(r.pkgWarnings, w)
 }
 
-func (r *runner) reportWarning(w *lint.Warning) {
-	filename := w.Filename
-	line := strconv.Itoa(w.Line)
-	ruleName := w.Tag
-	message := w.Text
-	if !r.absFilenames {
-		rel, err := filepath.Rel(r.wd, filename)
-		if err != nil {
-			panic(err)
+// displayFilename returns a filename in the form it should be printed.
+func (r *runner) displayFilename(filename string) string {
+	if r.absFilenames {
+		return filename
+	}
+	rel, err := filepath.Rel(r.wd, filename)
+	if err != nil {
+		panic(err)
+	}
+	return rel
+}
+
+// writeRulesSnapshot writes the enabled rule names to the -rules-snapshot file.
+func (r *runner) writeRulesSnapshot() error {
+	if r.args.rulesSnapshot == "" {
+		return fmt.Errorf("-rules-snapshot file is not specified")
+	}
+	var buf bytes.Buffer
+	for _, name := range r.analyzer.RuleNames() {
+		buf.WriteString(name)
+		buf.WriteByte('\n')
+	}
+	return os.WriteFile(r.args.rulesSnapshot, buf.Bytes(), 0o644)
+}
+
+// initNewRulesFilter reads the -rules-snapshot file and
+// collects the lines changed since -new-rules-since revision.
+func (r *runner) initNewRulesFilter() error {
+	if r.args.rulesSnapshot == "" {
+		return fmt.Errorf("-rules-snapshot file is not specified")
+	}
+	data, err := os.ReadFile(r.args.rulesSnapshot)
+	if err != nil {
+		return err
+	}
+	r.snapshotRules = make(map[string]struct{})
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			r.snapshotRules[line] = struct{}{}
 		}
+	}
+
+	changedLines, err := gitdiff.ChangedLines(r.wd, r.args.newRulesSince)
+	if err != nil {
+		return err
+	}
+	r.changedLines = changedLines
+	return nil
+}
+
+// isGrandfathered reports whether the warning should be suppressed by -new-rules-only.
+// The rules from the snapshot are reported as usual, while the newer
+// rules are only reported for the lines that were changed.
+func (r *runner) isGrandfathered(w *lint.Warning) bool {
+	if _, ok := r.snapshotRules[w.Tag]; ok {
+		return false
+	}
+	return !r.changedLines.IsChanged(w.Filename, w.Line)
+}
+
+// isFixExcluded reports whether the file matches any of the -fix-exclude globs.
+// The globs are matched against the working directory relative filename.
+func (r *runner) isFixExcluded(filename string) bool {
+	if len(r.fixExcludeGlobs) == 0 {
+		return false
+	}
+	if rel, err := filepath.Rel(r.wd, filename); err == nil {
 		filename = rel
 	}
+	for _, pattern := range r.fixExcludeGlobs {
+		if matchesFileGlob(pattern, filename) {
+			return true
+		}
+	}
+	return false
+}
+
+// enclosingFuncName returns the name of a function declaration
+// that contains the warning position.
+// Methods are named in Type.Method form.
+// Function literals are not named, their enclosing function is used instead.
+func (r *runner) enclosingFuncName(target *lint.Target, w *lint.Warning) string {
+	fn := r.enclosingFuncDecl(target, w)
+	if fn == nil {
+		return ""
+	}
+	typeName, funcName := resolve.SplitFuncName(fn)
+	if typeName != "" {
+		return typeName + "." + funcName
+	}
+	return funcName
+}
+
+// enclosingFuncDecl returns a function declaration that contains the warning position.
+// It returns nil for the warnings outside of any function.
+func (r *runner) enclosingFuncDecl(target *lint.Target, w *lint.Warning) *ast.FuncDecl {
+	for _, f := range target.Files {
+		tf := target.Fset.File(f.Syntax.Pos())
+		if tf == nil || tf.Name() != w.Filename {
+			continue
+		}
+		if w.Line < 1 || w.Line > tf.LineCount() {
+			return nil
+		}
+		pos := tf.LineStart(w.Line) + token.Pos(w.Column-1)
+		for _, decl := range f.Syntax.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if ok && pos >= fn.Pos() && pos < fn.End() {
+				return fn
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// isComplexEnough reports whether the warning passes the -min-complexity filter.
+func (r *runner) isComplexEnough(target *lint.Target, w *lint.Warning, cache map[*ast.FuncDecl]int) bool {
+	fn := r.enclosingFuncDecl(target, w)
+	if fn == nil {
+		return false
+	}
+	complexity, ok := cache[fn]
+	if !ok {
+		complexity = cyclomaticComplexity(fn)
+		cache[fn] = complexity
+	}
+	return complexity > r.args.minComplexity
+}
+
+func (r *runner) reportWarning(w *lint.Warning, funcName, suggestion string) {
+	if r.args.maxPerRule > 0 {
+		r.stats.issuesReportedPerRule[w.Tag]++
+		if r.stats.issuesReportedPerRule[w.Tag] > r.args.maxPerRule {
+			return
+		}
+	}
+
+	switch r.args.format {
+	case "json":
+		r.reportWarningJSON(w, funcName)
+		return
+	case "checkstyle":
+		r.reportWarningCheckstyle(w)
+		return
+	case "summary":
+		r.reportWarningSummary(w)
+		return
+	}
+
+	// The line format follows the go vet convention:
+	// file:line:col: message (ruleName).
+	// The optional details are a part of the message,
+	// the samples time is printed after the rule name.
+	filename := r.displayFilename(w.Filename)
+	line := strconv.Itoa(w.Line)
+	column := strconv.Itoa(w.Column)
+	ruleName := w.Tag
+	message := w.Text
 	if r.coloredOutput {
 		filename = "\033[35m" + filename + "\033[0m"
 		line = "\033[32m" + line + "\033[0m"
+		column = "\033[32m" + column + "\033[0m"
 		ruleName = "\033[93m" + ruleName + "\033[0m"
 		message = strings.Replace(message, " => ", " \033[35;1m=>\033[0m ", 1)
 	}
 	var timeString = ""
 	if r.heatmap != nil && w.SamplesTime != 0 {
-		timeString = " (" + w.SamplesTime.String() + ")"
+		if r.args.heatPercent {
+			timeString = fmt.Sprintf(", %s, %.2f%%", w.SamplesTime, r.heatPercent(w))
+		} else {
+			timeString = ", " + w.SamplesTime.String()
+		}
+	}
+	var suggestionString = ""
+	if suggestion != "" {
+		suggestionString = " (suggestion: " + suggestion + ")"
+	}
+	var funcString = ""
+	if funcName != "" {
+		funcString = " (in " + funcName + ")"
+	}
+	var docsString = ""
+	if docsURL := r.ruleDocsURL(w.Tag); docsURL != "" {
+		docsString = " (docs: " + docsURL + ")"
+	}
+	text := fmt.Sprintf("%s:%s:%s: %s%s%s%s (%s%s)\n", filename, line, column, message, suggestionString, funcString, docsString, ruleName, timeString)
+	if r.ci != nil {
+		r.ci.add(filename, w.Line, w.Column, text)
+		return
+	}
+	if _, err := io.WriteString(r.stdout, text); err != nil {
+		panic(err)
 	}
-	fmt.Fprintf(r.stdout, "%s:%s: %s%s: %s\n", filename, line, ruleName, timeString, message)
+}
+
+// suggestionText returns the warning fixes in a `before => after` form.
+// It's empty if the warning message already describes the only fix this way.
+// The sources map caches the files text.
+func (r *runner) suggestionText(fset *token.FileSet, w *lint.Warning, sources map[string][]byte) (string, error) {
+	parts := make([]string, 0, len(w.Fixes))
+	for _, fix := range w.Fixes {
+		pos := fset.Position(fix.From)
+		endPos := fset.Position(fix.To)
+		text, ok := sources[pos.Filename]
+		if !ok {
+			data, err := os.ReadFile(pos.Filename)
+			if err != nil {
+				return "", err
+			}
+			text = data
+			sources[pos.Filename] = text
+		}
+		if pos.Offset > endPos.Offset || endPos.Offset > len(text) {
+			continue
+		}
+		before := inlineText(text[pos.Offset:endPos.Offset])
+		after := inlineText(fix.Replacement)
+		parts = append(parts, before+" => "+after)
+	}
+	suggestion := strings.Join(parts, "; ")
+	if suggestion == w.Text {
+		return "", nil
+	}
+	return suggestion, nil
+}
+
+// inlineText joins the text lines into a single line, the indentation is removed.
+// The lines are separated by "; " unless they're inside a bracketed list,
+// so the statements are kept apart as if they were written on one line.
+func inlineText(text []byte) string {
+	var buf strings.Builder
+	prev := ""
+	for _, line := range strings.Split(string(text), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if prev != "" {
+			switch {
+			case strings.HasSuffix(prev, "{"), strings.HasSuffix(prev, "("), strings.HasSuffix(prev, ","):
+				buf.WriteString(" ")
+			case strings.HasPrefix(line, "}"), strings.HasPrefix(line, ")"):
+				buf.WriteString(" ")
+			default:
+				buf.WriteString("; ")
+			}
+		}
+		buf.WriteString(line)
+		prev = line
+	}
+	return buf.String()
+}
+
+// ruleDocsURL returns a rule documentation link.
+// It's empty unless -docs-base-url is set.
+func (r *runner) ruleDocsURL(ruleName string) string {
+	if r.args.docsBaseURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(r.args.docsBaseURL, "#") + "#" + ruleName
+}
+
+// heatPercent returns the warning samples time share of the total profile time.
+func (r *runner) heatPercent(w *lint.Warning) float64 {
+	if r.heatmapTotalTime == 0 {
+		return 0
+	}
+	return 100 * float64(w.SamplesTime) / float64(r.heatmapTotalTime)
+}
+
+// checkErrorRules makes sure that -error-rules only lists the enabled rules:
+// a misspelled name would silently make the run pass.
+func (r *runner) checkErrorRules() error {
+	ruleNames := r.analyzer.RuleNames()
+	enabled := make(map[string]struct{}, len(ruleNames))
+	for _, name := range ruleNames {
+		enabled[name] = struct{}{}
+	}
+	var unknown []string
+	for name := range r.args.errorRules {
+		if _, ok := enabled[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	return fmt.Errorf("-error-rules: unknown rules: %s; valid names are: %s",
+		strings.Join(unknown, ", "), strings.Join(ruleNames, ", "))
+}
+
+func (r *runner) isErrorRule(ruleName string) bool {
+	if len(r.args.errorRules) == 0 {
+		return true
+	}
+	_, ok := r.args.errorRules[ruleName]
+	return ok
 }
 
 func (r *runner) handleWarnings(target *lint.Target) error {
@@ -398,24 +1063,133 @@ func (r *runner) handleWarnings(target *lint.Target) error {
 	// We can infer which rules may affect the imports set.
 
 	type warningWithFix struct {
-		w   *lint.Warning
-		fix quickfix.TextEdit
+		w          *lint.Warning
+		funcName   string
+		suggestion string
+		fix        quickfix.TextEdit
+	}
+
+	type warningToReport struct {
+		w          *lint.Warning
+		funcName   string
+		suggestion string
+		fixable    bool
+	}
+
+	if r.args.maxPerRule > 0 {
+		// The first N issues of every rule are reported,
+		// so the order should not depend on the analysis order.
+		sort.SliceStable(r.pkgWarnings, func(i, j int) bool {
+			x := &r.pkgWarnings[i]
+			y := &r.pkgWarnings[j]
+			if x.Filename != y.Filename {
+				return x.Filename < y.Filename
+			}
+			if x.Line != y.Line {
+				return x.Line < y.Line
+			}
+			return x.Column < y.Column
+		})
+	}
+
+	var fixes *fixChecker
+	var complexityCache map[*ast.FuncDecl]int
+	if r.args.minComplexity > 0 {
+		complexityCache = make(map[*ast.FuncDecl]int)
 	}
 
+	countIssue := func(w *lint.Warning, fixable bool) {
+		r.stats.affectedSampleTime += w.SamplesTime
+		r.stats.issuesTotal++
+		if fixable {
+			r.stats.issuesFixable++
+		}
+		if r.isErrorRule(w.Tag) {
+			r.stats.issuesFailing++
+		}
+		r.stats.issuesByImpact[w.Impact]++
+	}
+
+	// The issues are reported after the fixes are applied:
+	// if the package is going to be checked again, they're found again
+	// and reported during the last check.
+	var toReport []warningToReport
+	var sources map[string][]byte
+	if r.args.showSuggestion || r.args.baseline != "" {
+		sources = make(map[string][]byte)
+	}
+	// baselineUsed counts the matched baseline entries,
+	// every entry suppresses only one issue.
+	var baselineUsed map[baselineEntry]int
+	if r.baseline != nil {
+		baselineUsed = make(map[baselineEntry]int)
+	}
 	needFmt := make(map[string]struct{})
 	fixablePerFile := make(map[string][]warningWithFix)
 	for i := range r.pkgWarnings {
 		w := &r.pkgWarnings[i]
 
-		r.stats.affectedSampleTime += w.SamplesTime
+		if r.args.verifyFixes && len(w.Fixes) != 0 {
+			if fixes == nil {
+				fixes = newFixChecker(target)
+			}
+			if err := fixes.Verify(w); err != nil {
+				key := fmt.Sprintf("%s:%d:%s", w.Filename, w.Line, w.Tag)
+				r.pushErrorf(key, "%s:%d: %s: discarded a suggested fix that doesn't compile: %v",
+					r.displayFilename(w.Filename), w.Line, w.Tag, err)
+				w.Fixes = nil
+			}
+		}
 
-		r.stats.issuesTotal++
-		if len(w.Fixes) != 0 {
-			r.stats.issuesFixable++
+		if r.args.fixableOnly && len(w.Fixes) == 0 {
+			continue
+		}
+		if r.args.minComplexity > 0 && !r.isComplexEnough(target, w, complexityCache) {
+			continue
+		}
+		if r.snapshotRules != nil && r.isGrandfathered(w) {
+			continue
+		}
+		if r.args.baseline != "" {
+			entry, err := r.newBaselineEntry(w, sources)
+			if err != nil {
+				return err
+			}
+			if r.args.writeBaseline {
+				r.baselineEntries = append(r.baselineEntries, entry)
+			} else if baselineUsed[entry] < r.baseline[entry] {
+				baselineUsed[entry]++
+				continue
+			}
+		}
+
+		// The excluded files are never modified, so their
+		// issues are reported as if they had no fixes.
+		fixExcluded := r.autofix && len(w.Fixes) != 0 && r.isFixExcluded(w.Filename)
+
+		funcName := ""
+		if r.args.showFunc {
+			funcName = r.enclosingFuncName(target, w)
 		}
 
-		if !r.autofix || len(w.Fixes) == 0 {
-			r.reportWarning(w)
+		// The suggestion is computed before any fix is applied,
+		// the fixes positions refer to the original files text.
+		suggestion := ""
+		if r.args.showSuggestion && len(w.Fixes) != 0 {
+			s, err := r.suggestionText(target.Fset, w, sources)
+			if err != nil {
+				return err
+			}
+			suggestion = s
+		}
+
+		if !r.autofix || len(w.Fixes) == 0 || fixExcluded {
+			toReport = append(toReport, warningToReport{
+				w:          w,
+				funcName:   funcName,
+				suggestion: suggestion,
+				fixable:    len(w.Fixes) != 0 && !fixExcluded,
+			})
 			continue
 		}
 		for i := range w.Fixes {
@@ -432,45 +1206,162 @@ func (r *runner) handleWarnings(target *lint.Target) error {
 				EndOffset:   to,
 				Replacement: w.Fixes[i].Replacement,
 			}
-			fixablePerFile[filename] = append(fixablePerFile[filename], warningWithFix{w: w, fix: fix})
+			fixablePerFile[filename] = append(fixablePerFile[filename], warningWithFix{w: w, funcName: funcName, suggestion: suggestion, fix: fix})
 		}
 	}
 
 	importsConfig := imports.FixConfig{
-		StdlibPackages: stdinfo.PathByName,
+		StdlibPackages: stdlibPackages,
 	}
 
-	for filename, pairs := range fixablePerFile {
-		quickfix.Sort(pairs, func(i int) quickfix.TextEdit {
-			return pairs[i].fix
-		})
+	// The overlapping fixes are not applied, their issues
+	// are reported unless the package is checked again.
+	var overlapped []warningWithFix
+	overlappedSet := make(map[*lint.Warning]struct{})
+	fixFile := func(filename string, fileText []byte, pairs []warningWithFix) ([]byte, error) {
 		edits := make([]quickfix.TextEdit, len(pairs))
 		for i, p := range pairs {
 			edits[i] = p.fix
 		}
-		fileText, err := os.ReadFile(filename)
-		if err != nil {
-			return err
-		}
 		afterQuickFixes, overlapping := quickfix.Apply(fileText, edits)
 		for _, pairIndex := range overlapping {
-			r.reportWarning(pairs[pairIndex].w)
+			p := pairs[pairIndex]
+			if _, ok := overlappedSet[p.w]; !ok {
+				overlappedSet[p.w] = struct{}{}
+				overlapped = append(overlapped, p)
+			}
 		}
 		newText, err := imports.Fix(importsConfig, afterQuickFixes)
 		if err != nil {
-			return fmt.Errorf("fix imports: %w", err)
+			return nil, fmt.Errorf("fix imports: %w", err)
 		}
 		if _, ok := needFmt[filename]; ok {
 			newText, err = format.Source(newText)
 			if err != nil {
-				return fmt.Errorf("gofmt: %w", err)
+				return nil, fmt.Errorf("gofmt: %w", err)
 			}
 		}
-		if err := os.WriteFile(filename, newText, 0o600); err != nil {
+		return newText, nil
+	}
+
+	filesChanged := false
+	fixedSet := make(map[*lint.Warning]struct{})
+	for filename, pairs := range fixablePerFile {
+		quickfix.Sort(pairs, func(i int) quickfix.TextEdit {
+			return pairs[i].fix
+		})
+		fileText, err := os.ReadFile(filename)
+		if err != nil {
 			return err
 		}
+
+		if r.patches == nil {
+			newText, err := fixFile(filename, fileText, pairs)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(newText, fileText) {
+				filesChanged = true
+			}
+			if err := os.WriteFile(filename, newText, 0o600); err != nil {
+				return err
+			}
+			if r.args.fixManifest != "" {
+				for _, p := range pairs {
+					if _, ok := overlappedSet[p.w]; ok {
+						continue
+					}
+					r.addFixManifestEntry(p.w.Tag, filename, fileText, p.fix)
+				}
+			}
+		} else {
+			// Every rule patch is computed against the original file text,
+			// so they can be applied independently.
+			pairsPerRule := make(map[string][]warningWithFix)
+			for _, p := range pairs {
+				pairsPerRule[p.w.Tag] = append(pairsPerRule[p.w.Tag], p)
+			}
+			for ruleName, rulePairs := range pairsPerRule {
+				newText, err := fixFile(filename, fileText, rulePairs)
+				if err != nil {
+					return err
+				}
+				r.addPatch(ruleName, filename, fileText, newText)
+			}
+		}
+
+		for _, p := range pairs {
+			if _, ok := overlappedSet[p.w]; ok {
+				continue
+			}
+			if _, ok := fixedSet[p.w]; !ok {
+				fixedSet[p.w] = struct{}{}
+				countIssue(p.w, true)
+			}
+		}
+	}
+
+	// The patches are computed against the original files and
+	// the profile samples refer to the original lines,
+	// so only the plain -fix runs are checked again.
+	if filesChanged && r.patches == nil && r.heatmap == nil && r.fixPass+1 < maxFixPasses {
+		r.needRecheck = true
+		return nil
+	}
+
+	for _, x := range toReport {
+		countIssue(x.w, x.fixable)
+		r.reportWarning(x.w, x.funcName, x.suggestion)
+	}
+	for _, p := range overlapped {
+		countIssue(p.w, false)
+		r.reportWarning(p.w, p.funcName, p.suggestion)
+	}
+
+	return nil
+}
+
+// addPatch records the unified diff of the rule fixes for the file.
+// The file paths are relative to the working directory.
+func (r *runner) addPatch(ruleName, filename string, oldText, newText []byte) {
+	rel, err := filepath.Rel(r.wd, filename)
+	if err != nil {
+		panic(err)
+	}
+	rel = filepath.ToSlash(rel)
+	diff := udiff.Unified("a/"+rel, "b/"+rel, oldText, newText)
+	if diff == nil {
+		return
+	}
+	filePatches := r.patches[ruleName]
+	if filePatches == nil {
+		filePatches = make(map[string][]byte)
+		r.patches[ruleName] = filePatches
 	}
+	filePatches[rel] = diff
+}
 
+// writePatches writes every rule patch into a <rule>.patch file
+// inside the -patches-dir directory.
+func (r *runner) writePatches() error {
+	if err := os.MkdirAll(r.args.patchesDir, 0o755); err != nil {
+		return err
+	}
+	for ruleName, filePatches := range r.patches {
+		filenames := make([]string, 0, len(filePatches))
+		for filename := range filePatches {
+			filenames = append(filenames, filename)
+		}
+		sort.Strings(filenames)
+		var buf bytes.Buffer
+		for _, filename := range filenames {
+			buf.Write(filePatches[filename])
+		}
+		patchFilename := filepath.Join(r.args.patchesDir, ruleName+".patch")
+		if err := os.WriteFile(patchFilename, buf.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -488,6 +1379,8 @@ func (r *runner) loadPackages(ctx context.Context, fset *token.FileSet, targets
 		Tests:   false,
 		Fset:    fset,
 		Context: ctx,
+
+		BuildFlags: r.buildFlags(),
 	}
 	start := time.Now()
 	loaded, err := packages.Load(config, targets...)
@@ -514,6 +1407,15 @@ func (r *runner) loadPackages(ctx context.Context, fset *token.FileSet, targets
 	return loaded, nil
 }
 
+// buildFlags returns the go tool flags for packages loading.
+// GOFLAGS env var is respected by the go tool itself.
+func (r *runner) buildFlags() []string {
+	if r.args.buildTags == "" {
+		return nil
+	}
+	return []string{"-tags=" + r.args.buildTags}
+}
+
 type packageRef struct {
 	id   string
 	name string
@@ -531,6 +1433,8 @@ func (r *runner) findPackages(ctx context.Context, fset *token.FileSet, targets
 		Tests:   false,
 		Fset:    fset,
 		Context: ctx,
+
+		BuildFlags: r.buildFlags(),
 	}
 	start := time.Now()
 	pkgs, err := packages.Load(config, targets...)
@@ -611,6 +1515,9 @@ func (r *runner) findPackages(ctx context.Context, fset *token.FileSet, targets
 func (r *runner) inspectHeatmap() {
 	r.heatmapPackages = make(map[string]struct{})
 	r.heatmapFiles = make(map[string]struct{})
+	if r.args.annotateSource {
+		r.lineHeat = make(map[heatmapFileKey]map[int]int)
+	}
 	var totalDuration time.Duration
 	r.stats.minSampleTime = time.Duration(math.MaxInt64)
 	r.heatmap.Inspect(func(l heatmap.LineStats) {
@@ -625,9 +1532,27 @@ func (r *runner) inspectHeatmap() {
 		}
 		r.heatmapPackages[l.Func.PkgName] = struct{}{}
 		r.heatmapFiles[filepath.Base(l.Func.Filename)] = struct{}{}
+		if r.lineHeat != nil {
+			key := heatmapFileKey{pkgName: l.Func.PkgName, filename: filepath.Base(l.Func.Filename)}
+			levels := r.lineHeat[key]
+			if levels == nil {
+				levels = make(map[int]int)
+				r.lineHeat[key] = levels
+			}
+			if levels[l.LineNum] < l.GlobalHeatLevel {
+				levels[l.LineNum] = l.GlobalHeatLevel
+			}
+		}
 	})
 }
 
+// heatmapFileKey identifies a source file in the heatmap.
+// Like in heatmap.Key, the filename is a base part of the file path.
+type heatmapFileKey struct {
+	pkgName  string
+	filename string
+}
+
 func (r *runner) createHeatmap() (*heatmap.Index, error) {
 	data, err := os.ReadFile(r.args.heatmapFile)
 	if err != nil {
@@ -643,5 +1568,11 @@ func (r *runner) createHeatmap() (*heatmap.Index, error) {
 	if err := index.AddProfile(pprofProfile); err != nil {
 		return nil, err
 	}
+	// The index doesn't keep the profile totals, so it's computed here.
+	// AddProfile makes sure that it's a CPU profile with the time as a second value.
+	r.heatmapTotalTime = 0
+	for _, s := range pprofProfile.Sample {
+		r.heatmapTotalTime += time.Duration(s.Value[1])
+	}
 	return index, nil
 }
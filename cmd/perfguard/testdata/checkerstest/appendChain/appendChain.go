@@ -0,0 +1,49 @@
+package checkerstest
+
+const prefix = "x"
+
+func Warn(cond bool) {
+	xs := []int{} // want `xs can be initialized with []int{1, 2, 3} instead of 3 appends`
+	xs = append(xs, 1)
+	xs = append(xs, 2)
+	xs = append(xs, 3)
+	println(len(xs))
+
+	var names []string // want `names can be initialized with []string{"a", prefix + "b", "c"} instead of 2 appends`
+	names = append(names, "a")
+	names = append(names, prefix+"b", "c")
+	println(len(names))
+
+	if cond {
+		bs := make([]byte, 0) // want `bs can be initialized with []byte{'a', 'b'} instead of 2 appends`
+		bs = append(bs, 'a')
+		bs = append(bs, 'b')
+		println(len(bs))
+	}
+}
+
+func Ignore(x int) {
+	xs := []int{}
+	xs = append(xs, 1)
+	println(len(xs))
+
+	ys := []int{}
+	ys = append(ys, 1) // want `combine 2 appends into ys = append(ys, 1, x)`
+	ys = append(ys, x)
+	println(len(ys))
+
+	zs := []int{}
+	zs = append(zs, 1)
+	println(len(zs))
+	zs = append(zs, 2)
+
+	ws := make([]int, 0, 10)
+	ws = append(ws, 1) // want `combine 2 appends into ws = append(ws, 1, 2)`
+	ws = append(ws, 2)
+	println(len(ws))
+
+	vs := []int{0}
+	vs = append(vs, 1) // want `combine 2 appends into vs = append(vs, 1, 2)`
+	vs = append(vs, 2)
+	println(len(vs))
+}
@@ -0,0 +1,105 @@
+package checkerstest
+
+type object struct {
+	items []int
+}
+
+func Warn(s []int, a, b, c int, o *object, f func() int) []int {
+	{
+		s = append(s, a) // want `combine 2 appends into s = append(s, a, b)`
+		s = append(s, b)
+	}
+
+	{
+		s = append(s, a) // want `combine 3 appends into s = append(s, a, b, c)`
+		s = append(s, b)
+		s = append(s, c)
+	}
+
+	{
+		s = append(s, a, b) // want `combine 2 appends into s = append(s, a, b, c)`
+		s = append(s, c)
+	}
+
+	{
+		o.items = append(o.items, a+1) // want `combine 2 appends into o.items = append(o.items, a + 1, b * 2)`
+		o.items = append(o.items, b*2)
+	}
+
+	{
+		s = append(s, len(s), f()) // want `combine 2 appends into s = append(s, len(s), f(), a)`
+		s = append(s, a)
+	}
+
+	{
+		s = append(s, a) // want `combine 2 appends into s = append(s, a, b)`
+		s = append(s, b)
+		s = append(s, len(s))
+	}
+
+	{
+		xs := make([]int, 0, 4)
+		xs = append(xs, 1) // want `combine 2 appends into xs = append(xs, 1, 2)`
+		xs = append(xs, 2)
+		println(len(xs))
+	}
+
+	return s
+}
+
+func Ignore(s, s2 []int, a, b int, f func() int, objects []*object) []int {
+	{
+		s = append(s, a)
+		_ = b
+		s = append(s, b)
+	}
+
+	{
+		s = append(s, a)
+		s2 = append(s2, b)
+	}
+
+	{
+		s = append(s, a)
+		s = append(s, len(s))
+	}
+
+	{
+		s = append(s, a)
+		s = append(s, s[0])
+	}
+
+	{
+		s = append(s, a)
+		s = append(s, f())
+	}
+
+	{
+		s = append(s, a)
+		s = append(s, s2...)
+	}
+
+	{
+		s = append(s, s2...)
+		s = append(s, a)
+	}
+
+	{
+		s2 = append(s, a)
+		s2 = append(s, b)
+	}
+
+	{
+		objects[f()].items = append(objects[f()].items, a)
+		objects[f()].items = append(objects[f()].items, b)
+	}
+
+	{
+		var xs []int // want `xs can be initialized with []int{1, 2} instead of 2 appends`
+		xs = append(xs, 1)
+		xs = append(xs, 2)
+		println(len(xs))
+	}
+
+	return s
+}
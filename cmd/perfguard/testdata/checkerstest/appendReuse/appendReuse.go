@@ -0,0 +1,129 @@
+package checkerstest
+
+import (
+	"io"
+	"strconv"
+)
+
+func Warn1(w io.Writer, ids []int) {
+	buf := make([]byte, 0, 16)
+	for _, id := range ids {
+		buf = make([]byte, 0, cap(buf)) // want `use buf = buf[:0] to reuse the backing array instead of allocating a new one on every iteration; make sure the previous buf contents are not retained elsewhere`
+		buf = strconv.AppendInt(buf, int64(id), 10)
+		buf = append(buf, '\n')
+		w.Write(buf)
+	}
+}
+
+func Warn2(rows [][]string) int {
+	n := 0
+	words := make([]string, 0, 8)
+	for _, row := range rows {
+		for i := 0; i < len(row); i++ {
+			words = make([]string, 0, cap(words)) // want `use words = words[:0] to reuse the backing array`
+			words = append(words, row[i:]...)
+			n += len(words)
+		}
+	}
+	return n
+}
+
+func Warn3(rows [][]byte) []byte {
+	var all []byte
+	var buf []byte
+	for _, row := range rows {
+		buf = make([]byte, 0, cap(buf)) // want `use buf = buf[:0] to reuse the backing array`
+		buf = append(buf, row...)
+		all = append(all, buf...)
+	}
+	return all
+}
+
+func Ignore1(rows [][]byte) [][]byte {
+	// Stored to the result.
+	result := make([][]byte, 0, len(rows))
+	var buf []byte
+	for _, row := range rows {
+		buf = make([]byte, 0, cap(buf))
+		buf = append(buf, row...)
+		result = append(result, buf)
+	}
+	return result
+}
+
+type record struct {
+	data []byte
+}
+
+func Ignore2(rows [][]byte) []record {
+	// Stored in a composite literal.
+	records := make([]record, 0, len(rows))
+	var buf []byte
+	for _, row := range rows {
+		buf = make([]byte, 0, cap(buf))
+		buf = append(buf, row...)
+		records = append(records, record{data: buf})
+	}
+	return records
+}
+
+func Ignore3(ch chan<- []byte, rows [][]byte) {
+	// Sent to a channel.
+	var buf []byte
+	for _, row := range rows {
+		buf = make([]byte, 0, cap(buf))
+		buf = append(buf, row...)
+		ch <- buf[:len(row)]
+	}
+}
+
+func Ignore4(m map[int][]byte, rows [][]byte) {
+	// Stored in a map.
+	var buf []byte
+	for i, row := range rows {
+		buf = make([]byte, 0, cap(buf))
+		buf = append(buf, row...)
+		m[i] = buf
+	}
+}
+
+func Ignore5(rows [][]byte) {
+	// Declared inside the loop.
+	for _, row := range rows {
+		var buf []byte
+		buf = make([]byte, 0, cap(buf))
+		buf = append(buf, row...)
+		_ = buf
+	}
+}
+
+func Ignore6(rows [][]byte, n int) {
+	// Not a cap of the same slice.
+	var buf, other []byte
+	for _, row := range rows {
+		buf = make([]byte, 0, cap(other))
+		buf = make([]byte, 0, n)
+		buf = append(buf, row...)
+	}
+	_ = buf
+}
+
+func Ignore7(rows [][]byte) {
+	// Captured by the goroutine.
+	var buf []byte
+	for _, row := range rows {
+		buf = make([]byte, 0, cap(buf))
+		buf = append(buf, row...)
+		go consume(buf)
+	}
+}
+
+func Ignore8(rows [][]byte) {
+	// Not inside a loop.
+	var buf []byte
+	buf = make([]byte, 0, cap(buf))
+	buf = append(buf, rows[0]...)
+	consume(buf)
+}
+
+func consume(b []byte) {}
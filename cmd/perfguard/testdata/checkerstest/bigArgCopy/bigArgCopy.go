@@ -12,8 +12,9 @@ func (example) f(y [1000]byte) {}
 
 func Warn() {
 	var o bigObject
-	f(o)                              // want `expensive x arg copy`
-	f(bigObject(anotherBigObject(o))) // want `expensive x arg copy`
+	var o2 anotherBigObject
+	f(o)                               // want `expensive x arg copy`
+	f(bigObject(anotherBigObject(o2))) // want `expensive x arg copy`
 
 	o.byValue() // want `expensive o receiver copy (10000 bytes)`
 	o.byPointer()
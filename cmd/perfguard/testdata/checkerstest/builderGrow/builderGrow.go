@@ -0,0 +1,96 @@
+package checkerstest
+
+import (
+	"bytes"
+	"strings"
+)
+
+func WarnBuilder(parts []string) string {
+	var b strings.Builder
+	for _, p := range parts { // want `b is written in a loop over parts, consider calling b.Grow before the loop`
+		b.WriteString(p)
+		b.WriteByte(',')
+	}
+	for _, p := range parts {
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+func WarnBuffer(m map[string]int, s string) []byte {
+	buf := &bytes.Buffer{}
+	for k := range m { // want `buf is written in a loop over m, consider calling buf.Grow before the loop`
+		if k != "" {
+			buf.WriteString(k)
+		}
+	}
+	buf2 := new(bytes.Buffer)
+	for _, ch := range s { // want `buf2 is written in a loop over s, consider calling buf2.Grow before the loop`
+		buf2.WriteRune(ch)
+	}
+	buf.Write(buf2.Bytes())
+	return buf.Bytes()
+}
+
+func WarnNested(rows [][]string) {
+	for _, row := range rows {
+		b := strings.Builder{}
+		for _, col := range row { // want `b is written in a loop over row, consider calling b.Grow before the loop`
+			b.WriteString(col)
+		}
+		println(b.String())
+	}
+}
+
+func IgnoreGrown(parts []string) string {
+	var b strings.Builder
+	b.Grow(len(parts) * 8)
+	for _, p := range parts {
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+func IgnoreGrownInLoop(parts []string) string {
+	var b strings.Builder
+	for _, p := range parts {
+		b.Grow(len(p))
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+func IgnoreUnsized(ch chan string, n int) string {
+	var b strings.Builder
+	for s := range ch {
+		b.WriteString(s)
+	}
+	for i := 0; i < n; i++ {
+		b.WriteByte('x')
+	}
+	return b.String()
+}
+
+func IgnoreParam(b *strings.Builder, parts []string) {
+	for _, p := range parts {
+		b.WriteString(p)
+	}
+}
+
+func IgnoreFuncLit(parts []string) string {
+	var b strings.Builder
+	for _, p := range parts {
+		func() {
+			b.WriteString(p)
+		}()
+	}
+	return b.String()
+}
+
+func IgnoreNotWritten(parts []string) string {
+	var b strings.Builder
+	for range parts {
+		println(b.Len())
+	}
+	return b.String()
+}
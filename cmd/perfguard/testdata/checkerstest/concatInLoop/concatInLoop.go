@@ -0,0 +1,68 @@
+package checkerstest
+
+import "strconv"
+
+type path string
+
+func Warn1(names []string) string {
+	s := ""
+	for _, name := range names {
+		s += name + "," // want `s is built with a string concat inside a loop: declare a strings.Builder before the loop, replace the concat with its WriteString calls and assign its String() to s after the loop`
+	}
+	return s
+}
+
+func Warn2(names []string) string {
+	var s string
+	for i := 0; i < len(names); i++ {
+		s = s + names[i] + ";" // want `s is built with a string concat inside a loop`
+	}
+	return s
+}
+
+func Warn3(parts [][]string) path {
+	var result path
+	for _, part := range parts {
+		for _, p := range part {
+			result += "/" + path(p) // want `result is built with a string concat inside a loop`
+		}
+	}
+	return result
+}
+
+func Ignore1(a, b string) string {
+	// Not inside a loop.
+	s := a
+	s += "," + b
+	s = s + "."
+	return s
+}
+
+func Ignore2(names []string) []string {
+	// Declared inside the loop.
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		s := "<"
+		s += name + ">"
+		result = append(result, s)
+	}
+	return result
+}
+
+func Ignore3(ids []int) string {
+	// Reported by itoaConcat.
+	s := ""
+	for _, id := range ids {
+		s = s + strconv.Itoa(id) // want `s is built with strconv.Itoa concat inside a loop`
+	}
+	return s
+}
+
+func Ignore4(names []string) string {
+	// Not a concat to the accumulator.
+	s := ""
+	for _, name := range names {
+		s = name + s
+	}
+	return s
+}
@@ -0,0 +1,64 @@
+package checkerstest
+
+const bigValue = 1 << 20
+
+type myByte byte
+
+func Warn() {
+	x := 300
+	_ = byte(x) // want `byte(x) truncates the x value 300, it doesn't fit into byte`
+
+	var y = 200
+	_ = int8(y) // want `int8(y) truncates the y value 200, it doesn't fit into int8`
+
+	var z int64 = -1
+	_ = uint32(z) // want `uint32(z) truncates the z value -1, it doesn't fit into uint32`
+
+	big := bigValue + 1
+	_ = uint16(big) // want `uint16(big) truncates the big value 1048577, it doesn't fit into uint16`
+	_ = myByte(big) // want `myByte(big) truncates the big value 1048577, it doesn't fit into myByte`
+	_ = int32(big)
+}
+
+func Ignore(cond bool) {
+	x := 255
+	_ = byte(x)
+
+	y := -128
+	_ = int8(y)
+
+	reassigned := 300
+	if cond {
+		reassigned = 10
+	}
+	_ = byte(reassigned)
+
+	incremented := 300
+	incremented--
+	_ = byte(incremented)
+
+	addrTaken := 300
+	modify(&addrTaken)
+	_ = byte(addrTaken)
+
+	inClosure := 300
+	func() {
+		inClosure = 0
+	}()
+	_ = byte(inClosure)
+
+	f := 300.5
+	_ = byte(f)
+
+	n := int(cond2int(cond)) + 300
+	_ = byte(n)
+}
+
+func modify(x *int) { *x = 0 }
+
+func cond2int(cond bool) int {
+	if cond {
+		return 1
+	}
+	return 0
+}
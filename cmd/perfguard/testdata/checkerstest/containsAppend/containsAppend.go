@@ -0,0 +1,65 @@
+package checkerstest
+
+import (
+	"github.com/quasilyte/go-perfguard/cmd/perfguard/testdata/stubs/slices"
+)
+
+type collector struct {
+	seen []string
+}
+
+func Warn(xs []string, c *collector) []string {
+	var seen []string
+	for _, x := range xs {
+		if !slices.Contains(seen, x) { // want `seen is used as a set, Contains+append in a loop is O(n^2); consider a map[T]struct{} for the deduplication`
+			seen = append(seen, x)
+		}
+	}
+
+	for i := 0; i < len(xs); i++ {
+		if !slices.Contains(c.seen, xs[i]) { // want `c.seen is used as a set, Contains+append in a loop is O(n^2); consider a map[T]struct{} for the deduplication`
+			println(i)
+			c.seen = append(c.seen, xs[i])
+		}
+	}
+
+	return seen
+}
+
+func Ignore(xs []string, other []string) []string {
+	var seen []string
+
+	// Not in a loop.
+	if !slices.Contains(seen, "x") {
+		seen = append(seen, "x")
+	}
+
+	for _, x := range xs {
+		// Appending to a different slice.
+		if !slices.Contains(other, x) {
+			seen = append(seen, x)
+		}
+
+		// Not a negated Contains.
+		if slices.Contains(seen, x) {
+			seen = append(seen, x)
+		}
+
+		// Has an else branch.
+		if !slices.Contains(seen, x) {
+			seen = append(seen, x)
+		} else {
+			println(x)
+		}
+
+		func() {
+			// Function literals are checked separately.
+			var local []string
+			if !slices.Contains(local, x) {
+				local = append(local, x)
+			}
+		}()
+	}
+
+	return seen
+}
@@ -0,0 +1,37 @@
+package checkerstest
+
+import (
+	"time"
+)
+
+type Celsius float64
+
+type Fahrenheit float64
+
+type ID int
+
+type Name string
+
+type point struct{ x, y float64 }
+
+type vector struct{ x, y float64 }
+
+func Warn(c Celsius, id ID, names []Name, d time.Duration, p point) {
+	_ = Celsius(Fahrenheit(c))  // want `Celsius(Fahrenheit(c)) => c, the conversion to Fahrenheit and back is a no-op`
+	_ = Celsius(float64(c))     // want `Celsius(float64(c)) => c, the conversion to float64 and back is a no-op`
+	_ = ID(int(id))             // want `ID(int(id)) => id, the conversion to int and back is a no-op`
+	_ = Name(string(names[0]))  // want `Name(string(names[0])) => names[0], the conversion to string and back is a no-op`
+	_ = time.Duration(int64(d)) // want `time.Duration(int64(d)) => d, the conversion to int64 and back is a no-op`
+	_ = point(vector(p))        // want `point(vector(p)) => p, the conversion to vector and back is a no-op`
+}
+
+func Ignore(c Celsius, f Fahrenheit, id ID, x float64, i int) {
+	_ = Fahrenheit(c)
+	_ = Celsius(Fahrenheit(f))
+	_ = ID(int32(id))
+	_ = ID(float64(id))
+	_ = int(float64(i))
+	_ = Celsius(Fahrenheit(x))
+	_ = Celsius(Fahrenheit(1.5))
+	_ = []byte(string([]byte("x")))
+}
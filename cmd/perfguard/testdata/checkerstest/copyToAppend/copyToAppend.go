@@ -0,0 +1,88 @@
+package checkerstest
+
+type object struct {
+	items []int
+}
+
+func Warn(dst, src []int, b, data []byte, o *object, lists [][]int) ([]int, []byte) {
+	{
+		tmp := make([]int, len(src)) // want `tmp is a redundant copy of src, use dst = append(dst, src...)`
+		copy(tmp, src)
+		dst = append(dst, tmp...)
+	}
+
+	{
+		tmp := make([]byte, len(data)) // want `tmp is a redundant copy of data, use b = append(b, data...)`
+		copy(tmp, data)
+		b = append(b, tmp...)
+	}
+
+	{
+		tmp := make([]int, len(o.items)) // want `tmp is a redundant copy of o.items, use dst = append(dst, o.items...)`
+		copy(tmp, o.items)
+		dst = append(dst, tmp...)
+		println(len(dst))
+	}
+
+	{
+		tmp := make([]int, len(src)) // want `tmp is a redundant copy of src, use o.items = append(o.items, src...)`
+		copy(tmp, src)
+		o.items = append(o.items, tmp...)
+	}
+
+	{
+		tmp := make([]int, len(src)) // want `tmp is a redundant copy of src, use lists[0] = append(lists[0], src...)`
+		copy(tmp, src)
+		lists[0] = append(lists[0], tmp...)
+	}
+
+	return dst, b
+}
+
+func Ignore(dst, src, src2 []int, n int, f func() []int) []int {
+	{
+		// tmp is used after the append.
+		tmp := make([]int, len(src))
+		copy(tmp, src)
+		dst = append(dst, tmp...)
+		tmp[0] = 1
+	}
+
+	{
+		// Sizes differ.
+		tmp := make([]int, n)
+		copy(tmp, src)
+		dst = append(dst, tmp...)
+	}
+
+	{
+		// Sizes differ.
+		tmp := make([]int, len(src2))
+		copy(tmp, src)
+		dst = append(dst, tmp...)
+	}
+
+	{
+		// The statements are not consecutive.
+		tmp := make([]int, len(src))
+		copy(tmp, src)
+		src[0] = 1
+		dst = append(dst, tmp...)
+	}
+
+	{
+		// src has side effects.
+		tmp := make([]int, len(f()))
+		copy(tmp, f())
+		dst = append(dst, tmp...)
+	}
+
+	{
+		// Different destinations.
+		tmp := make([]int, len(src))
+		copy(tmp, src)
+		dst = append(src2, tmp...)
+	}
+
+	return dst
+}
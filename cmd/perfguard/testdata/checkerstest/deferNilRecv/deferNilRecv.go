@@ -0,0 +1,160 @@
+package checkerstest
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+func Warn1(filename string, cond bool) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	}
+	defer f.Close() // want `f is assigned only in some of the preceding branches, it may be nil when f.Close() is deferred`
+}
+
+func Warn2(filename string, mode int) {
+	var r io.ReadCloser
+	switch mode {
+	case 0:
+		r, _ = os.Open(filename)
+	case 1:
+		r = io.NopCloser(strings.NewReader(filename))
+	}
+	defer r.Close() // want `r is assigned only in some of the preceding branches, it may be nil when r.Close() is deferred`
+}
+
+func Warn3(filenames []string) {
+	var f *os.File
+	for _, filename := range filenames {
+		f, _ = os.Open(filename)
+	}
+	defer f.Close() // want `f is assigned only in some of the preceding branches, it may be nil when f.Close() is deferred`
+}
+
+func Warn4(filename string, cond bool) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	} else if filename != "" {
+		f, _ = os.Create(filename)
+	}
+	println(filename)
+	defer f.Close() // want `f is assigned only in some of the preceding branches, it may be nil when f.Close() is deferred`
+}
+
+func Ignore1(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return nil
+}
+
+func Ignore2(filename string, cond bool) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	} else {
+		f, _ = os.Create(filename)
+	}
+	defer f.Close()
+}
+
+func Ignore3(filename string, cond bool) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	}
+	if f == nil {
+		return
+	}
+	defer f.Close()
+}
+
+func Ignore4(filename string, cond bool) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	}
+	if f != nil {
+		defer f.Close()
+	}
+}
+
+func Ignore5(filename string, cond bool) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	} else {
+		panic("no file")
+	}
+	defer f.Close()
+}
+
+func Ignore6(filename string, mode int) {
+	var r io.ReadCloser
+	switch mode {
+	case 0:
+		r, _ = os.Open(filename)
+	default:
+		return
+	}
+	defer r.Close()
+}
+
+func Ignore7(filename string, cond bool) {
+	var f *os.File
+	open := func() {
+		f, _ = os.Open(filename)
+	}
+	if cond {
+		f, _ = os.Open(filename)
+	}
+	open()
+	defer f.Close()
+}
+
+func Ignore8(filename string, cond bool) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	}
+	f = os.Stdin
+	defer f.Close()
+}
+
+func Ignore9(filename string, cond bool) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	}
+	defer closeFile(f)
+}
+
+func Ignore10(filename string, cond bool) {
+	var f *os.File
+	if cond {
+		f, _ = os.Open(filename)
+	}
+	openFile(&f, filename)
+	defer f.Close()
+}
+
+func Ignore11(filename string) {
+	var f *os.File
+	f, _ = os.Open(filename)
+	defer f.Close()
+}
+
+func closeFile(f *os.File) {
+	if f != nil {
+		f.Close()
+	}
+}
+
+func openFile(dst **os.File, filename string) {
+	*dst, _ = os.Open(filename)
+}
@@ -0,0 +1,52 @@
+package checkerstest
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+type closer struct{}
+
+func (closer) Close() error { return nil }
+
+func mayFail() error { return nil }
+
+func Warn(w io.Writer, c closer) {
+	_ = mayFail()                  // want `the error returned by mayFail is discarded`
+	_ = c.Close()                  // want `the error returned by c.Close is discarded`
+	_ = os.Remove("x")             // want `the error returned by os.Remove is discarded`
+	_, _ = w.Write(nil)            // want `the error returned by w.Write is discarded`
+	_, _ = fmt.Fprintf(w, "%d", 1) // want `the error returned by fmt.Fprintf is discarded`
+	_, _ = io.WriteString(w, "x")  // want `the error returned by io.WriteString is discarded`
+
+	func() {
+		_ = mayFail() // want `the error returned by mayFail is discarded`
+	}()
+}
+
+func Ignore(w io.Writer) {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("x")
+	_ = buf.WriteByte('x')
+	_, _ = fmt.Fprintf(&buf, "%d", 1)
+
+	var sb strings.Builder
+	_, _ = sb.WriteString("x")
+	_, _ = sb.WriteRune('ы')
+	_, _ = fmt.Fprintln(&sb, 1)
+
+	bw := bufio.NewWriter(w)
+	_, _ = bw.WriteString("x")
+	_ = bw.Flush() // want `the error returned by bw.Flush is discarded`
+
+	mayFail()
+	err := mayFail()
+	println(err)
+	_, err = w.Write(nil)
+	_ = err
+	_ = len("x")
+}
@@ -0,0 +1,24 @@
+package checkerstest
+
+import (
+	"fmt"
+)
+
+const readPrefix = "read config: %w"
+
+func Warn(err error, path string) {
+	_ = fmt.Errorf("doing X: %w", fmt.Errorf("doing X: %w", err))               // want `the error is wrapped twice with the same "doing X: " context`
+	_ = fmt.Errorf("read %s: %w", path, fmt.Errorf("read %s: %w", path, err))   // want `the error is wrapped twice with the same "read %s: " context`
+	_ = fmt.Errorf("doing X: %w", fmt.Errorf("doing X: %w (%v)", err, path))    // want `the error is wrapped twice with the same "doing X: " context`
+	_ = fmt.Errorf(readPrefix, fmt.Errorf("read config: %w", err))              // want `the error is wrapped twice with the same "read config: " context`
+	_ = fmt.Errorf("%5d%% done: %w", 10, fmt.Errorf("%5d%% done: %w", 10, err)) // want `the error is wrapped twice with the same "%5d%% done: " context`
+}
+
+func Ignore(err error, path, other string) {
+	_ = fmt.Errorf("doing X: %w", fmt.Errorf("doing Y: %w", err))
+	_ = fmt.Errorf("read %s: %w", path, fmt.Errorf("read %s: %w", other, err))
+	_ = fmt.Errorf("doing X: %v", fmt.Errorf("doing X: %v", err))
+	_ = fmt.Errorf("%w", fmt.Errorf("%w", err))
+	_ = fmt.Errorf("doing X: %[1]w", fmt.Errorf("doing X: %[1]w", err))
+	_ = fmt.Errorf("doing X: %w", err)
+}
@@ -0,0 +1,103 @@
+package checkerstest
+
+import (
+	"context"
+	"time"
+)
+
+type result struct {
+	ch chan int
+}
+
+func compute() int { return 0 }
+
+func Warn(ctx context.Context, r *result) {
+	ch := make(chan int)
+	go func() {
+		ch <- compute() // want `possible goroutine leak: the send to ch blocks forever if there is no receiver, use a select with a ctx.Done() case or a buffered channel`
+	}()
+
+	go func() {
+		for i := 0; i < 10; i++ {
+			r.ch <- i // want `possible goroutine leak: the send to r.ch blocks forever if there is no receiver, use a select with a ctx.Done() case or a buffered channel`
+		}
+	}()
+
+	unbuffered := make(chan int, 0)
+	go func() {
+		unbuffered <- 1 // want `possible goroutine leak: the send to unbuffered blocks forever if there is no receiver, use a select with a ctx.Done() case or a buffered channel`
+	}()
+
+	// A select without an escape is the same bare send.
+	other := make(chan int)
+	go func() {
+		select {
+		case ch <- 1: // want `possible goroutine leak: the send to ch blocks forever if there is no receiver, use a select with a ctx.Done() case or a buffered channel`
+		case other <- 2: // want `possible goroutine leak: the send to other blocks forever if there is no receiver, use a select with a ctx.Done() case or a buffered channel`
+		}
+	}()
+
+	select {
+	case <-ch:
+	case <-ctx.Done():
+	}
+}
+
+func Ignore(ctx context.Context, done chan struct{}) {
+	ch := make(chan int)
+
+	// A ctx.Done() escape.
+	go func() {
+		select {
+		case ch <- compute():
+		case <-ctx.Done():
+		}
+	}()
+
+	// A done channel escape.
+	go func() {
+		select {
+		case ch <- compute():
+		case <-done:
+		}
+	}()
+
+	// A default clause.
+	go func() {
+		select {
+		case ch <- compute():
+		default:
+		}
+	}()
+
+	// A timeout.
+	go func() {
+		select {
+		case ch <- compute():
+		case <-time.After(time.Second):
+		}
+	}()
+
+	// A buffered channel.
+	buffered := make(chan int, 1)
+	go func() {
+		buffered <- compute()
+	}()
+
+	// Not a goroutine.
+	func() {
+		ch <- compute()
+	}()
+
+	// A nested function literal is not executed by the goroutine.
+	go func() {
+		f := func() { ch <- 1 }
+		_ = f
+	}()
+
+	// A named function call.
+	go compute()
+
+	<-ch
+	<-buffered
+}
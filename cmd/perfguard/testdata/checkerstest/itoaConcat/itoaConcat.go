@@ -0,0 +1,109 @@
+package checkerstest
+
+import (
+	"strconv"
+	"strings"
+)
+
+type csv string
+
+func Warn1(ids []int) string {
+	s := ""
+	for _, id := range ids {
+		s += strconv.Itoa(id) + "," // want `s is built with strconv.Itoa concat inside a loop, use a []byte buffer with strconv.AppendInt or a strings.Builder`
+	}
+	return s
+}
+
+func Warn2(ids []int64) string {
+	var s string
+	for i := 0; i < len(ids); i++ {
+		s = s + strconv.FormatInt(ids[i], 16) + ";" // want `s is built with strconv.FormatInt concat inside a loop`
+	}
+	return s
+}
+
+func Warn3(rows [][]uint64) csv {
+	var result csv
+	for _, row := range rows {
+		for _, x := range row {
+			result += csv(strconv.FormatUint(x, 10)) // want `result is built with strconv.FormatUint concat inside a loop`
+		}
+		result += "\n" // want `result is built with a string concat inside a loop`
+	}
+	return result
+}
+
+func Warn4(ids []int) string {
+	s := "ids:"
+	for _, id := range ids {
+		if id == 0 {
+			continue
+		}
+		s += " " + strconv.Itoa(id) // want `s is built with strconv.Itoa concat inside a loop`
+	}
+	return s
+}
+
+func Ignore1(ids []int) string {
+	s := ""
+	for _, id := range ids {
+		s += strconv.Quote(strconv.QuoteRune(rune(id))) // want `s is built with a string concat inside a loop`
+	}
+	return s
+}
+
+func Ignore2(ids []int) string {
+	s := strconv.Itoa(ids[0])
+	s += "," + strconv.Itoa(ids[1])
+	return s
+}
+
+func Ignore3(ids []int) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s := ""
+		s += strconv.Itoa(id) + ","
+		result = append(result, s)
+	}
+	return result
+}
+
+func Ignore4(ids []int) string {
+	var sb strings.Builder
+	sb.Grow(len(ids) * 4)
+	for _, id := range ids {
+		sb.WriteString(strconv.Itoa(id))
+		sb.WriteByte(',')
+	}
+	return sb.String()
+}
+
+func Ignore5(ids []int) string {
+	buf := make([]byte, 0, len(ids)*4)
+	for _, id := range ids {
+		buf = strconv.AppendInt(buf, int64(id), 10)
+		buf = append(buf, ',')
+	}
+	return string(buf)
+}
+
+func Ignore6(ids []int) string {
+	s := ""
+	for _, id := range ids {
+		s = strconv.Itoa(id) + "," + s
+	}
+	return s
+}
+
+func Ignore7(ids []int) []func() string {
+	funcs := make([]func() string, 0, len(ids))
+	for _, id := range ids {
+		funcs = append(funcs, func() string {
+			var s string
+			s += strconv.Itoa(id)
+			return s
+		})
+	}
+	return funcs
+}
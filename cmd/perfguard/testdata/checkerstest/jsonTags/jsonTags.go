@@ -0,0 +1,49 @@
+package checkerstest
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type user struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Email    string
+	Password string `json:"-"`
+	LastSeen time.Time
+	internal int
+}
+
+type untagged struct {
+	A int
+	B string
+}
+
+type embedded struct {
+	untagged
+	C int `json:"c"`
+}
+
+type custom struct {
+	A int
+}
+
+func (custom) MarshalJSON() ([]byte, error) { return []byte(`{}`), nil }
+
+type recursive []recursive
+
+func Warn(u *user, users []user, m map[string]untagged) {
+	_, _ = json.Marshal(u)                     // want `user fields without json tags use the Go field names as keys: Email, LastSeen`
+	_, _ = json.MarshalIndent(users, "", "  ") // want `user fields without json tags use the Go field names as keys: Email, LastSeen`
+	_, _ = json.Marshal(m)                     // want `untagged fields without json tags use the Go field names as keys: A, B`
+	_, _ = json.Marshal(struct{ X int }{X: 1}) // want `struct{X int} fields without json tags use the Go field names as keys: X`
+}
+
+func Ignore(e embedded, c custom, r recursive, s string) {
+	_, _ = json.Marshal(e)
+	_, _ = json.Marshal(c)
+	_, _ = json.Marshal(&c)
+	_, _ = json.Marshal(r)
+	_, _ = json.Marshal(s)
+	_, _ = json.Marshal(map[string]int{"a": 1})
+}
@@ -0,0 +1,84 @@
+package checkerstest
+
+import (
+	"fmt"
+	"io"
+)
+
+var global interface{}
+
+func Warn(x interface{}, rw io.ReadWriter, items []string) {
+	for _, item := range items {
+		if s, ok := x.(fmt.Stringer); ok { // want `x is not modified inside the loop, move the x.(fmt.Stringer) assertion out of it`
+			println(s.String(), item)
+		}
+		if _, ok := rw.(io.Writer); ok { // want `rw type already implements io.Writer, the rw.(io.Writer) assertion only fails if rw is nil`
+			println(item)
+		}
+	}
+
+	for i := 0; i < len(items); i++ {
+		for j := 0; j < i; j++ {
+			println(x.(string)) // want `x is not modified inside the loop, move the x.(string) assertion out of it`
+		}
+	}
+
+	var y interface{}
+	for _, item := range items {
+		y = item
+		for i := 0; i < 10; i++ {
+			if _, ok := y.(string); ok { // want `y is not modified inside the loop, move the y.(string) assertion out of it`
+				println(i)
+			}
+		}
+	}
+}
+
+func Ignore(x interface{}, items []interface{}) {
+	for _, item := range items {
+		if _, ok := item.(string); ok {
+			println(item)
+		}
+		var local interface{} = item
+		println(local.(string))
+	}
+
+	for _, item := range items {
+		if _, ok := x.(string); ok {
+			println(item)
+		}
+		x = item
+	}
+
+	for range items {
+		println(global.(string))
+	}
+
+	for range items {
+		func() {
+			println(x.(string))
+		}()
+	}
+
+	for range items {
+		switch x.(type) { // want `use if _, ok := x.(string); ok { … } instead of a single-case type switch`
+		case string:
+		}
+	}
+
+	var z interface{}
+	p := &z
+	*p = "x"
+	for range items {
+		println(z.(string))
+	}
+}
+
+func IgnoreClosureAssign(x interface{}, items []interface{}) {
+	for _, item := range items {
+		println(x.(string))
+		func() {
+			x = item
+		}()
+	}
+}
@@ -0,0 +1,75 @@
+package checkerstest
+
+import (
+	"context"
+	"time"
+)
+
+func Warn(parent context.Context) {
+	{
+		ctx, cancel := context.WithCancel(parent) // want `the cancel function returned by context.WithCancel is never called`
+		_ = cancel
+		sink(ctx)
+	}
+
+	{
+		ctx, cancel := context.WithTimeout(parent, time.Second) // want `the cancel function returned by context.WithTimeout is never called`
+		_ = cancel
+		sink(ctx)
+	}
+
+	{
+		var ctx context.Context
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(parent, time.Now()) // want `the cancel function returned by context.WithDeadline is never called`
+		_ = cancel
+		sink(ctx)
+	}
+
+	{
+		ctx, _ := context.WithCancel(parent) // want `the cancel function returned by context.WithCancel should be called, not discarded`
+		sink(ctx)
+	}
+
+	_ = func() {
+		ctx, cancel := context.WithCancel(parent) // want `the cancel function returned by context.WithCancel is never called`
+		sink(ctx)
+		_ = cancel
+	}
+}
+
+func Ignore(parent context.Context) context.CancelFunc {
+	{
+		ctx, cancel := context.WithCancel(parent)
+		defer cancel()
+		sink(ctx)
+	}
+
+	{
+		ctx, cancel := context.WithTimeout(parent, time.Second)
+		sink(ctx)
+		cancel()
+	}
+
+	{
+		ctx, cancel := context.WithCancel(parent)
+		go func() {
+			sink(ctx)
+			cancel()
+		}()
+	}
+
+	{
+		ctx, cancel := context.WithCancel(parent)
+		sink(ctx)
+		storeCancel(cancel)
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	sink(ctx)
+	return cancel
+}
+
+func sink(ctx context.Context) {}
+
+func storeCancel(cancel context.CancelFunc) {}
@@ -0,0 +1,61 @@
+package checkerstest
+
+type pair struct {
+	x, y int
+}
+
+type node struct {
+	next *node
+}
+
+func Warn(xs []int, i, j int, p *pair, a, b string) {
+	tmp := xs[i] // want `use xs[i], xs[j] = xs[j], xs[i] to swap the values`
+	xs[i] = xs[j]
+	xs[j] = tmp
+
+	t := a // want `use a, b = b, a to swap the values`
+	a = b
+	b = t
+	println(a, b)
+
+	{
+		tmp := p.x // want `use p.x, p.y = p.y, p.x to swap the values`
+		p.x = p.y
+		p.y = tmp
+	}
+}
+
+func Ignore(xs []int, i, j int, a, b, c string) {
+	tmp := xs[i]
+	xs[i] = xs[j]
+	xs[j] = tmp
+	println(tmp)
+
+	t := a
+	a = b
+	c = t
+	println(a, b, c)
+
+	t2 := a
+	b = a
+	a = t2
+	println(a, b)
+}
+
+func IgnoreOverlap(p *node, xs []int, i int) {
+	// p.next depends on p.
+	tmp := p
+	p = p.next
+	p.next = tmp
+
+	// xs[i] depends on i.
+	t := i
+	i = xs[i]
+	xs[i] = t
+}
+
+func IgnoreSideEffects(xs []int, next func() int) {
+	tmp := xs[next()]
+	xs[next()] = xs[0]
+	xs[0] = tmp
+}
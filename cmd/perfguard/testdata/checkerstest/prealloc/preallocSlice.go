@@ -65,6 +65,19 @@ func Warn6(xs []map[int]struct{}) []int {
 	return ys
 }
 
+func Warn7(rows [][]int) [][]int {
+	// Nested loops: an inner slice is declared inside the outer loop.
+	result := make([][]int, 0, len(rows))
+	for _, row := range rows {
+		ys := []int{} // want `[]int{} => make([]int, 0, len(row))`
+		for _, x := range row {
+			ys = append(ys, x*2)
+		}
+		result = append(result, ys)
+	}
+	return result
+}
+
 func Ignore1(xs []int) []int {
 	var ys []int
 	if len(xs) == 0 {
@@ -150,4 +163,26 @@ func Ignore8(xs []int) []int {
 	return ys
 }
 
+func Ignore9(xs map[string]int) []string {
+	// Range over map with a conditional append.
+	ys := []string{}
+	for k, v := range xs {
+		if v != 0 {
+			ys = append(ys, k)
+		}
+	}
+	return ys
+}
+
+func Ignore10(rows [][]int) []int {
+	// Nested loops: len(rows) is not the number of appends.
+	ys := []int{}
+	for _, row := range rows {
+		for _, x := range row {
+			ys = append(ys, x*2)
+		}
+	}
+	return ys
+}
+
 func getInts() []int { return []int{42} }
@@ -0,0 +1,95 @@
+package checkerstest
+
+import (
+	"errors"
+	"fmt"
+)
+
+type parseResult struct {
+	value int
+}
+
+type stopWalk struct{}
+
+type walkError struct{}
+
+func (walkError) Error() string { return "walk error" }
+
+type ptrError struct{}
+
+func (*ptrError) Error() string { return "ptr error" }
+
+func parse() (result int) {
+	defer func() {
+		if r := recover(); r != nil {
+			if res, ok := r.(parseResult); ok { // want `r is recovered to extract a parseResult payload, panic/recover is slow for a control flow; return the value explicitly`
+				result = res.value
+			}
+		}
+	}()
+	panic(parseResult{value: 10})
+}
+
+func walk() (found bool) {
+	defer func() {
+		switch recover().(type) { // want `recover() is recovered to extract a *stopWalk payload, panic/recover is slow for a control flow; return the value explicitly`
+		case nil:
+		case *stopWalk:
+			found = true
+		default:
+			panic("unexpected")
+		}
+	}()
+	panic(&stopWalk{})
+}
+
+func walk2() (n int) {
+	defer func() {
+		var r interface{}
+		r = recover()
+		switch v := r.(type) { // want `r is recovered to extract a int payload, panic/recover is slow for a control flow; return the value explicitly`
+		case error:
+			panic(v)
+		case int:
+			n = v
+		}
+	}()
+	panic(1)
+}
+
+func Ignore() (err error) {
+	defer func() {
+		r := recover()
+		switch v := r.(type) {
+		case nil:
+		case error:
+			err = v
+		case string:
+			err = errors.New(v)
+		case walkError:
+			err = v
+		case *ptrError:
+			err = v
+		case fmt.Stringer:
+			err = errors.New(v.String())
+		}
+	}()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			}
+		}
+	}()
+
+	var x interface{} = parseResult{}
+	if _, ok := x.(parseResult); ok {
+		return nil
+	}
+	switch x.(type) { // want `use if _, ok := x.(parseResult); ok { … } instead of a single-case type switch`
+	case parseResult:
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package checkerstest
+
+func Warn(xs []int, fs [4]float64, words []string) (int, float64, int, int) {
+	sum := 0
+	for _, x := range xs { // want `sum is a manual sum reduction of xs, consider a reduce helper`
+		sum += x
+	}
+
+	var prod float64 = 1
+	for i := range fs { // want `prod is a manual product reduction of fs, consider a reduce helper`
+		prod *= fs[i]
+	}
+
+	n := 0
+	for _, w := range words { // want `n is a manual count reduction of words, consider a reduce helper`
+		if len(w) > 3 {
+			n++
+		}
+	}
+
+	total := 0
+	for i := range xs { // want `total is a manual sum reduction of xs, consider a reduce helper`
+		total += xs[i]
+	}
+
+	return sum + total, prod, n, total
+}
+
+func Ignore(xs, ys []int, m map[string]int) (int, int, int, int, int, int) {
+	// Not starting from the identity value.
+	sum1 := 10
+	for _, x := range xs {
+		sum1 += x
+	}
+
+	// Not a single-statement body.
+	sum2 := 0
+	for _, x := range xs {
+		println(x)
+		sum2 += x
+	}
+
+	// Not a range element.
+	sum3 := 0
+	for i := range xs {
+		sum3 += ys[i]
+	}
+
+	// Maps are not reduced in order.
+	sum4 := 0
+	for _, v := range m {
+		sum4 += v
+	}
+
+	// The condition depends on the accumulator.
+	n := 0
+	for _, x := range xs {
+		if x > n {
+			n++
+		}
+	}
+
+	// Wrong identity: product from 0.
+	prod := 0
+	for _, x := range xs {
+		prod *= x
+	}
+
+	return sum1, sum2, sum3, sum4, n, prod
+}
@@ -0,0 +1,138 @@
+package checkerstest
+
+import (
+	"sync"
+)
+
+type counter struct {
+	mu sync.Mutex // want `mu is only locked inside the inc method and the package starts no goroutines, the mutex may be redundant`
+	n  int
+}
+
+func (c *counter) inc() {
+	c.mu.Lock()
+	c.n++
+	c.mu.Unlock()
+}
+
+func (c *counter) Add(n int) {
+	for i := 0; i < n; i++ {
+		c.inc()
+	}
+}
+
+type cache struct {
+	mu   sync.RWMutex // want `mu is only locked inside the get method and the package starts no goroutines, the mutex may be redundant`
+	data map[string]int
+}
+
+func (c *cache) get(k string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.data[k]
+}
+
+type twoMethods struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (t *twoMethods) inc() {
+	t.mu.Lock()
+	t.n++
+	t.mu.Unlock()
+}
+
+func (t *twoMethods) get() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.n
+}
+
+type exportedMethod struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (e *exportedMethod) Inc() {
+	e.mu.Lock()
+	e.n++
+	e.mu.Unlock()
+}
+
+type inClosure struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (c *inClosure) inc() {
+	f := func() {
+		c.mu.Lock()
+		c.n++
+		c.mu.Unlock()
+	}
+	f()
+}
+
+type addressTaken struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (a *addressTaken) inc() {
+	a.mu.Lock()
+	a.n++
+	a.mu.Unlock()
+}
+
+func (a *addressTaken) locker() sync.Locker {
+	return &a.mu
+}
+
+type methodValue struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (m *methodValue) inc() {
+	m.mu.Lock()
+	m.n++
+	m.mu.Unlock()
+}
+
+func (m *methodValue) callback() func() {
+	return m.inc
+}
+
+type ExportedField struct {
+	Mu sync.Mutex
+	n  int
+}
+
+func (e *ExportedField) inc() {
+	e.Mu.Lock()
+	e.n++
+	e.Mu.Unlock()
+}
+
+type embedded struct {
+	sync.Mutex
+	n int
+}
+
+func (e *embedded) inc() {
+	e.Lock()
+	e.n++
+	e.Unlock()
+}
+
+type inFunc struct {
+	mu sync.Mutex
+	n  int
+}
+
+func incFunc(x *inFunc) {
+	x.mu.Lock()
+	x.n++
+	x.mu.Unlock()
+}
@@ -0,0 +1,45 @@
+package checkerstest
+
+import (
+	"strings"
+)
+
+func Warn(s, input string, cond bool) string {
+	s = strings.ReplaceAll(s, "a", "b")  // want `s is rewritten by 2 replace calls in a row, consider a single strings.NewReplacer pass`
+	s = strings.Replace(s, "c", "d", -1) // want `strings.Replace(s, "c", "d", -1) => strings.ReplaceAll(s, "c", "d")`
+
+	escaped := strings.ReplaceAll(input, "&", "&amp;") // want `escaped is rewritten by 3 replace calls in a row, consider a single strings.NewReplacer pass`
+	escaped = strings.ReplaceAll(escaped, "<", "&lt;")
+	escaped = strings.Replace(escaped, ">", "&gt;", -1) // want `strings.Replace(escaped, ">", "&gt;", -1) => strings.ReplaceAll(escaped, ">", "&gt;")`
+
+	switch {
+	case cond:
+		s = strings.ReplaceAll(s, "x", "y") // want `s is rewritten by 2 replace calls in a row, consider a single strings.NewReplacer pass`
+		s = strings.ReplaceAll(s, "y", "z")
+	}
+
+	return s + escaped
+}
+
+func Ignore(s, s2 string) string {
+	s = strings.ReplaceAll(s, "a", "b")
+	println(s)
+	s = strings.ReplaceAll(s, "c", "d")
+
+	s = strings.Replace(s, "a", "b", 1)
+	s = strings.Replace(s, "c", "d", 1)
+
+	s = strings.ReplaceAll(s, "a", "b")
+	s2 = strings.ReplaceAll(s2, "c", "d")
+
+	s = strings.ReplaceAll(s, "a", "b")
+	s = strings.ReplaceAll(s2, "c", "d")
+
+	f := func(x string) string {
+		return strings.ReplaceAll(x, "a", "b")
+	}
+	s = strings.ReplaceAll(s, "a", "b")
+	s = f(s)
+
+	return s + s2
+}
@@ -0,0 +1,60 @@
+package checkerstest
+
+import (
+	"bufio"
+	"io"
+)
+
+func Warn(r io.Reader) error {
+	s := bufio.NewScanner(r) // want `s fails on lines longer than bufio.MaxScanTokenSize (64KB), consider a s.Buffer() call to raise the limit`
+	for s.Scan() {
+		println(s.Text())
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	lines := bufio.NewScanner(r) // want `lines fails on lines longer than bufio.MaxScanTokenSize (64KB), consider a lines.Buffer() call to raise the limit`
+	lines.Split(bufio.ScanLines)
+	for lines.Scan() {
+		println(lines.Text())
+	}
+
+	go func() {
+		s := bufio.NewScanner(r) // want `s fails on lines longer than bufio.MaxScanTokenSize (64KB), consider a s.Buffer() call to raise the limit`
+		for s.Scan() {
+		}
+	}()
+
+	return nil
+}
+
+func Ignore(r io.Reader) *bufio.Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 1024), 1024*1024)
+	for s.Scan() {
+		println(s.Text())
+	}
+
+	words := bufio.NewScanner(r)
+	words.Split(bufio.ScanWords)
+	for words.Scan() {
+		println(words.Text())
+	}
+
+	passed := bufio.NewScanner(r)
+	configure(passed)
+	for passed.Scan() {
+	}
+
+	// No Scan calls.
+	unused := bufio.NewScanner(r)
+	_ = unused.Err()
+
+	returned := bufio.NewScanner(r)
+	return returned
+}
+
+func configure(s *bufio.Scanner) {
+	s.Buffer(nil, 1024*1024)
+}
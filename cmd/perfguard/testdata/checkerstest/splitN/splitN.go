@@ -0,0 +1,76 @@
+package checkerstest
+
+import (
+	"bytes"
+	"strings"
+)
+
+func Warn(s string, b []byte) {
+	{
+		parts := strings.Split(s, ":") // want `strings.Split(s, ":") result is only indexed up to 1, use strings.SplitN(s, ":", 3)`
+		println(parts[0], parts[1])
+	}
+
+	{
+		parts := strings.Split(s, ",") // want `strings.Split(s, ",") result is only indexed up to 0, use strings.SplitN(s, ",", 2)`
+		println(parts[0])
+	}
+
+	{
+		const i = 2
+		parts := strings.Split(s, "/") // want `strings.Split(s, "/") result is only indexed up to 2, use strings.SplitN(s, "/", 4)`
+		println(parts[i], parts[0])
+		parts[1] = "x"
+	}
+
+	{
+		parts := bytes.Split(b, []byte(" ")) // want `bytes.Split(b, []byte(" ")) result is only indexed up to 1, use bytes.SplitN(b, []byte(" "), 3)`
+		f := func() []byte {
+			return parts[1]
+		}
+		println(f())
+	}
+}
+
+func Ignore(s string, i int) {
+	{
+		parts := strings.Split(s, ":")
+		println(len(parts), parts[0])
+	}
+
+	{
+		parts := strings.Split(s, ":")
+		println(parts[i])
+	}
+
+	{
+		parts := strings.Split(s, ":")
+		for _, p := range parts {
+			println(p)
+		}
+	}
+
+	{
+		parts := strings.Split(s, ":")
+		println(parts[0])
+		parts = strings.Split(s, ",")
+		println(parts[1])
+	}
+
+	{
+		parts := strings.Split(s, ":")
+		println(parts[1:])
+	}
+
+	{
+		parts := strings.Split(s, ":")
+		sinkStrings(parts)
+	}
+
+	{
+		parts := strings.SplitN(s, ":", 2)
+		println(parts[0], parts[1])
+	}
+}
+
+func sinkStrings([]string) {}
@@ -68,15 +68,15 @@ func Fixed4(b []byte, s string) string {
 }
 
 func Warn5(format string, args []interface{}, s string) string {
-	buf := bytes.Buffer{} // want `bytes.Buffer => strings.Builder`
-	buf.WriteString(fmt.Sprintf(format, args...))
+	buf := bytes.Buffer{}                         // want `bytes.Buffer => strings.Builder`
+	buf.WriteString(fmt.Sprintf(format, args...)) // want `buf.WriteString(fmt.Sprintf(format, args...)) => fmt.Fprintf(&buf, format, args...)`
 	buf.WriteString(s + s[:2])
 	return buf.String()
 }
 
 func Fixed5(format string, args []interface{}, s string) string {
 	buf := strings.Builder{}
-	buf.WriteString(fmt.Sprintf(format, args...))
+	buf.WriteString(fmt.Sprintf(format, args...)) // want `buf.WriteString(fmt.Sprintf(format, args...)) => fmt.Fprintf(&buf, format, args...)`
 	buf.WriteString(s + s[:2])
 	return buf.String()
 }
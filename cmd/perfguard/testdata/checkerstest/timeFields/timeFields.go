@@ -0,0 +1,94 @@
+package checkerstest
+
+import (
+	"time"
+)
+
+type session struct { // want `session time.Time fields (started, lastSeen) are only compared or subtracted, int64 Unix timestamps would save 16 bytes per field`
+	id       int
+	started  time.Time
+	lastSeen time.Time
+}
+
+func (s *session) touch(now time.Time) {
+	s.lastSeen = now
+}
+
+func (s *session) expired(now time.Time, ttl time.Duration) bool {
+	return now.Sub(s.lastSeen) > ttl || (s.started).IsZero()
+}
+
+func (s *session) age() time.Duration {
+	return time.Since(s.started)
+}
+
+func (s *session) newer(other *session) bool {
+	return s.started.After(other.started) && s.lastSeen != other.lastSeen
+}
+
+type formatted struct {
+	created time.Time
+	updated time.Time
+}
+
+func (f *formatted) String() string {
+	return f.created.Format(time.RFC3339) + time.Since(f.updated).String()
+}
+
+type escaping struct {
+	created time.Time
+	updated time.Time
+}
+
+func storeTime(t time.Time) {}
+
+func (e *escaping) store() {
+	storeTime(e.created)
+	_ = e.updated.Before(e.created)
+}
+
+type unused struct {
+	created time.Time
+	updated time.Time
+}
+
+func (u *unused) age() time.Duration {
+	return time.Since(u.created)
+}
+
+type tagged struct {
+	created time.Time `json:"created"`
+	updated time.Time `json:"updated"`
+}
+
+func (t *tagged) age() time.Duration {
+	return t.updated.Sub(t.created)
+}
+
+type exported struct {
+	Created time.Time
+	updated time.Time
+}
+
+func (e *exported) age() time.Duration {
+	return e.updated.Sub(e.Created)
+}
+
+type single struct {
+	created time.Time
+}
+
+func (s *single) age() time.Duration {
+	return time.Since(s.created)
+}
+
+type addressTaken struct {
+	created time.Time
+	updated time.Time
+}
+
+func (a *addressTaken) age() time.Duration {
+	p := &a.created
+	_ = p
+	return a.updated.Sub(a.created)
+}
@@ -0,0 +1,17 @@
+package annotateSource
+
+import (
+	"fmt"
+)
+
+func f(xs []int) int {
+	total := 0
+	for _, x := range xs { // hot
+		total += x // hot
+	}
+	return total
+}
+
+func g(xs []int) {
+	_ = fmt.Sprint(xs) // hot
+}
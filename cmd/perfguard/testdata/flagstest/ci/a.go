@@ -0,0 +1,8 @@
+package ci
+
+import "strings"
+
+func a(s1, s2 string) {
+	_ = len(s1) >= 1
+	_ = strings.Compare(s1, s2) == 0
+}
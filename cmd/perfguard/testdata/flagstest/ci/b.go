@@ -0,0 +1,5 @@
+package ci
+
+func b(s string) {
+	_ = len(s) < 1
+}
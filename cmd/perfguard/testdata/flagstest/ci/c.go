@@ -0,0 +1,5 @@
+package ci
+
+func c(s string) int {
+	return len(s)
+}
@@ -0,0 +1,16 @@
+package flagstest
+
+import (
+	"fmt"
+	"strings"
+)
+
+type withStringer struct{}
+
+func (withStringer) String() string { return "" }
+
+func f(s1, s2 string, x withStringer, err error) {
+	_ = strings.Compare(s1, s2) == 0
+	_ = fmt.Sprint(x)
+	_ = fmt.Sprint(err)
+}
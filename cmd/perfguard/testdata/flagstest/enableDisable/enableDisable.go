@@ -0,0 +1,13 @@
+package enableDisable
+
+import (
+	"fmt"
+	"strings"
+)
+
+func f(s1, s2 string) (string, bool) {
+	if len(s1) >= 0 {
+		return fmt.Sprintf("%s%s", s1, s2), strings.Compare(s1, s2) == 0
+	}
+	return "", false
+}
@@ -0,0 +1,12 @@
+package flagstest
+
+import (
+	"bytes"
+	"strings"
+)
+
+func f(b1, b2 []byte, s1, s2 string) {
+	_ = bytes.Compare(b1, b2) == 0
+	_ = strings.Compare(s1, s2) == 0
+	_ = strings.Compare(s1, s2) != 0
+}
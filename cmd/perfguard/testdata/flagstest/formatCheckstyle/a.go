@@ -0,0 +1,10 @@
+package flagstest
+
+import (
+	"strings"
+)
+
+func f(s string) {
+	_ = strings.Compare(s, "a&b") == 0
+	_ = len(s) < 1
+}
@@ -0,0 +1,5 @@
+package flagstest
+
+func g(s string) {
+	_ = len(s) >= 0
+}
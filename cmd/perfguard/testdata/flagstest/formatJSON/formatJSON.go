@@ -0,0 +1,10 @@
+package flagstest
+
+import (
+	"strings"
+)
+
+func f(s1, s2 string) {
+	_ = strings.Compare(s1, s2) == 0
+	_ = len(s1) >= 0
+}
@@ -0,0 +1,7 @@
+package formatSummary
+
+import "strings"
+
+func a(s1, s2 string) {
+	_ = strings.Compare(s1, s2) == 0
+}
@@ -0,0 +1,9 @@
+package formatSummary
+
+import "strings"
+
+func b(s1, s2 string) {
+	_ = strings.Compare(s1, s2) == 0
+	_ = strings.Compare(s1, s2) != 0
+	_ = len(s1) >= 1
+}
@@ -0,0 +1,6 @@
+package formatSummary
+
+func c(s string) {
+	_ = len(s) >= 1
+	_ = len(s) < 1
+}
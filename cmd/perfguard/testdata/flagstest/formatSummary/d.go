@@ -0,0 +1,5 @@
+package formatSummary
+
+func d(s string) {
+	_ = len(s) > 0
+}
@@ -0,0 +1,8 @@
+package flagstest
+
+func f(m map[string]int) {
+	for k := range m {
+		delete(m, k)
+	}
+	m = make(map[string]int, len(m))
+}
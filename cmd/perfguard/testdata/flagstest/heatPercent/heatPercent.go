@@ -0,0 +1,11 @@
+package heatPercent
+
+import (
+	"fmt"
+)
+
+func f(xs []int, m map[string]int) {
+	_ = fmt.Sprintf("%v", xs) // hot
+	_ = fmt.Sprint(m)         // hot
+	_ = fmt.Sprint(xs)
+}
@@ -0,0 +1,14 @@
+package impactFilter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+func f(s1, s2 string, d time.Duration) (string, bool, time.Duration) {
+	if len(s1) >= 0 {
+		return fmt.Sprintf("%s%s", s1, s2), strings.Compare(s1, s2) == 0, time.Duration(int64(d))
+	}
+	return "", false, d
+}
@@ -0,0 +1,9 @@
+package maxPerRule
+
+import "strings"
+
+func a(s string, xs []int) {
+	_ = len(s) >= 1
+	_ = strings.Compare(s, "x") == 0
+	_ = len(xs) >= 1
+}
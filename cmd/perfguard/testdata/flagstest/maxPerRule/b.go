@@ -0,0 +1,6 @@
+package maxPerRule
+
+func b(s string, xs []int) {
+	_ = len(s) < 1
+	_ = len(xs) < 1
+}
@@ -0,0 +1,38 @@
+package flagstest
+
+import (
+	"strings"
+)
+
+// Complexity: 1.
+func simple(s string) bool {
+	return strings.Compare(s, "a") == 0
+}
+
+// Complexity: 3.
+func branchy(s string, n int) bool {
+	if n > 0 && s != "" {
+		return strings.Compare(s, "a") == 0
+	}
+	return false
+}
+
+// Complexity: 6.
+func complex(xs []string) int {
+	n := 0
+	for _, s := range xs {
+		switch {
+		case s == "":
+			continue
+		case strings.Compare(s, "a") == 0:
+			n++
+		default:
+		}
+		if s == "b" || s == "c" {
+			n--
+		}
+	}
+	return n
+}
+
+var global = strings.Compare("a", "b") == 0
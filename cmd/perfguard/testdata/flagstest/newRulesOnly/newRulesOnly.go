@@ -0,0 +1,11 @@
+package newRulesOnly
+
+import "strings"
+
+func isA(s string) bool {
+	return strings.Compare(s, "a") == 0
+}
+
+func replaceA(s string) string {
+	return strings.Replace(s, "a", "b", -1)
+}
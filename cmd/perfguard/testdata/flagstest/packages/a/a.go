@@ -0,0 +1,7 @@
+package a
+
+import "strings"
+
+func f(s1, s2 string) bool {
+	return strings.Compare(s1, s2) == 0
+}
@@ -0,0 +1,11 @@
+package broken
+
+import "strings"
+
+func f(s1, s2 string) bool {
+	return strings.Compare(s1, s2) == 0
+}
+
+func g() int {
+	return undefinedFunc()
+}
@@ -0,0 +1,10 @@
+package syntaxerr
+
+import "strings"
+
+func f(s1, s2 string) bool {
+	return strings.Compare(s1, s2) == 0
+}
+
+func g( {
+}
@@ -0,0 +1,10 @@
+//go:build perfguard_tagged
+// +build perfguard_tagged
+
+package tagged
+
+import "strings"
+
+func f(s1, s2 string) bool {
+	return strings.Compare(s1, s2) == 0
+}
@@ -0,0 +1,10 @@
+package testonly
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestF(t *testing.T) {
+	_ = strings.Compare("a", "b") == 0
+}
@@ -0,0 +1,15 @@
+package patchesDir
+
+import "strings"
+
+func isA(s string) bool {
+	return strings.Compare(s, "a") == 0
+}
+
+func replaceA(s string) string {
+	return strings.Replace(s, "a", "b", -1)
+}
+
+func isB(s string) bool {
+	return strings.Compare(s, "b") == 0
+}
@@ -0,0 +1,21 @@
+package profileHeat
+
+func f(xs []int) int {
+	a := 0
+	b := 0
+	c := 0
+	d := 0
+	e := 0
+	for _, x := range xs {
+		a += add(x, 1)
+		b += x
+		c += x
+		d += x
+		e += x
+	}
+	return a + b + c + d + e
+}
+
+func add(x, y int) int {
+	return x + y
+}
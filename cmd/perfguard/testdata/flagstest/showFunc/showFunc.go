@@ -0,0 +1,24 @@
+package flagstest
+
+import (
+	"strings"
+)
+
+var global = strings.Compare("a", "b") == 0
+
+func f(s1, s2 string) {
+	_ = strings.Compare(s1, s2) == 0
+}
+
+type myType struct{}
+
+func (*myType) method(s1, s2 string) {
+	_ = strings.Compare(s1, s2) == 0
+}
+
+func withFuncLit(s1, s2 string) {
+	fn := func() bool {
+		return strings.Compare(s1, s2) == 0
+	}
+	_ = fn
+}
@@ -0,0 +1,15 @@
+package showSuggestion
+
+import "strings"
+
+func f(dst, src []byte, s1, s2 string, x, y byte) ([]byte, bool) {
+	for _, b := range src {
+		dst = append(dst, b)
+	}
+	dst = append(dst, x)
+	dst = append(dst, y)
+	if len(s1) >= 0 {
+		return dst, strings.Compare(s1, s2) == 0
+	}
+	return dst, false
+}
@@ -0,0 +1,26 @@
+package flagstest
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+func allocs(s1, s2 string, b []byte) {
+	_ = fmt.Sprint(s1, s2)
+	_ = strings.Split(s1, ":")[0]
+	_ = []byte(s1)[0]
+	_ = bytes.Equal([]byte(s1), b)
+}
+
+func cpu(s1, s2 string) {
+	_ = strings.Compare(s1, s2) == 0
+}
+
+func readability(s string) {
+	_ = len(s) >= 1
+}
+
+func unspecified(xs, ys []int) {
+	_ = append(xs[:1], xs...)
+}
@@ -0,0 +1,9 @@
+package vendorFiles
+
+import (
+	"strings"
+)
+
+func F(s string) bool {
+	return strings.Compare(s, "x") == 0
+}
@@ -0,0 +1,15 @@
+package verifyFixes
+
+import (
+	"fmt"
+)
+
+func good(x int) string {
+	return fmt.Sprint(x)
+}
+
+func broken(x int) string {
+	// The strconv.Itoa suggestion refers to this local var.
+	strconv := "x="
+	return strconv + fmt.Sprint(x)
+}
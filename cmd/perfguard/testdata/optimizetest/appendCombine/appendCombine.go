@@ -0,0 +1,21 @@
+package optimizetest
+
+func Warn1(s []int, a, b int) []int {
+	s = append(s, a) // hot // want `combine 2 appends into s = append(s, a, b)`
+	s = append(s, b) // hot
+	return s
+}
+
+func Warn2() []int {
+	// appendChain doesn't run in the optimize mode.
+	var xs []int
+	xs = append(xs, 1) // hot // want `combine 2 appends into xs = append(xs, 1, 2)`
+	xs = append(xs, 2) // hot
+	return xs
+}
+
+func Ignore(s []int, a, b int) []int {
+	s = append(s, a)
+	s = append(s, b)
+	return s
+}
@@ -0,0 +1,25 @@
+package optimizetest
+
+import (
+	"strings"
+)
+
+type headers map[string][]string
+
+func Warn(m map[string]int, h headers, k string) {
+	_ = m[strings.ToLower(k)] // hot // want `case-insensitive m key lookups allocate, normalize the keys once instead of on every access`
+	_ = m[strings.ToUpper(k)] // hot // want `case-insensitive m key lookups allocate, normalize the keys once instead of on every access`
+
+	if v, ok := h[strings.ToLower(k)]; ok { // hot // want `case-insensitive h key lookups allocate, normalize the keys once instead of on every access`
+		println(v)
+	}
+}
+
+func Ignore(m map[string]int, k string) {
+	_ = m[strings.ToLower(k)]
+	_ = m[strings.TrimSpace(k)] // hot
+	_ = m[k]                    // hot
+
+	idx := map[int]int{}
+	_ = idx[len(strings.ToLower(k))] // hot
+}
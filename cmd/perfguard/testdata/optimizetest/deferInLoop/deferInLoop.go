@@ -0,0 +1,76 @@
+package optimizetest
+
+import (
+	"os"
+	"sync"
+)
+
+func Warn1(mu *sync.Mutex, xs []int) int {
+	total := 0
+	for _, x := range xs {
+		mu.Lock()
+		defer mu.Unlock() // hot // want `defer in a loop is executed only when the function returns; move the cleanup out of the loop or call it at the end of each iteration`
+		total += x
+	}
+	return total
+}
+
+func Warn2(filenames []string) error {
+	for i := 0; i < len(filenames); i++ {
+		f, err := os.Open(filenames[i])
+		if err != nil {
+			return err
+		}
+		if i != 0 {
+			defer f.Close() // hot // want `defer in a loop is executed only when the function returns; move the cleanup out of the loop or call it at the end of each iteration`
+		}
+	}
+	return nil
+}
+
+func Ignore1(mu *sync.Mutex, xs []int) int {
+	// Not a hot line.
+	total := 0
+	for _, x := range xs {
+		mu.Lock()
+		defer mu.Unlock()
+		total += x
+	}
+	return total
+}
+
+func Ignore2(mu *sync.Mutex, xs []int) int {
+	// The defer runs at the end of every function literal call.
+	total := 0
+	for _, x := range xs {
+		func() {
+			mu.Lock()
+			defer mu.Unlock() // hot
+			total += x
+		}()
+	}
+	return total
+}
+
+func Ignore3(mu *sync.Mutex, xs []int) []func() {
+	// The literal is created in the loop, but it's invoked elsewhere.
+	funcs := make([]func(), 0, len(xs))
+	for range xs {
+		funcs = append(funcs, func() {
+			mu.Lock()
+			defer mu.Unlock() // hot
+		})
+	}
+	return funcs
+}
+
+func Ignore4(mu *sync.Mutex, xs []int) int {
+	// Not inside a loop.
+	mu.Lock()
+	defer mu.Unlock() // hot
+	total := 0
+	for _, x := range xs {
+		total += x // hot
+	}
+	return total
+}
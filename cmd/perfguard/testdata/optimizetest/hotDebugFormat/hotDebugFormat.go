@@ -0,0 +1,29 @@
+package optimizetest
+
+import (
+	"fmt"
+)
+
+type point struct {
+	x, y int
+}
+
+type label struct {
+	text string
+}
+
+func (l label) String() string { return l.text }
+
+func Warn(xs []int, m map[string]int, p point) {
+	_ = fmt.Sprintf("%v", xs) // hot // want `formatting xs on a hot path is expensive, remove it or guard it behind a debug flag`
+	_ = fmt.Sprintf("%+v", p) // hot // want `formatting p on a hot path is expensive, remove it or guard it behind a debug flag`
+	_ = fmt.Sprint(m)         // hot // want `formatting m on a hot path is expensive, remove it or guard it behind a debug flag`
+}
+
+func Ignore(xs []int, v interface{}, l label, p point) {
+	_ = fmt.Sprintf("%v", xs)
+	_ = fmt.Sprintf("%v", v)  // hot
+	_ = fmt.Sprintf("%d", xs) // hot
+	_ = fmt.Sprint(l)         // hot // want `fmt.Sprint(l) => l.String()`
+	_ = fmt.Sprint(v, p)      // hot
+}
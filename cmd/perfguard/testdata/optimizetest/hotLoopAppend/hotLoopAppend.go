@@ -0,0 +1,89 @@
+package optimizetest
+
+func Warn1(n int) []int {
+	var result []int
+	for i := 0; i < n; i++ {
+		result = append(result, i) // hot // want `result is grown by append in a hot loop, consider preallocating it with make([]int, 0, n)`
+	}
+	return result
+}
+
+func Warn2(ch <-chan string) []string {
+	lines := []string{}
+	for s := range ch {
+		if s != "" {
+			lines = append(lines, s) // hot // want `lines is grown by append in a hot loop, consider preallocating it with make([]string, 0, n)`
+		}
+	}
+	return lines
+}
+
+func Warn3(xs [][]byte) [][]byte {
+	var result [][]byte
+	for _, x := range xs {
+		parts := make([][]byte, 0)
+		for len(x) != 0 {
+			parts = append(parts, x[:1]) // hot // want `parts is grown by append in a hot loop, consider preallocating it with make([][]byte, 0, n)`
+			x = x[1:]
+		}
+		result = append(result, parts...)
+	}
+	return result
+}
+
+func Ignore1(n int) []int {
+	// Not a hot line.
+	var result []int
+	for i := 0; i < n; i++ {
+		result = append(result, i)
+	}
+	return result
+}
+
+func Ignore2(n int) []int {
+	// Capacity is reserved.
+	result := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		result = append(result, i) // hot
+	}
+	return result
+}
+
+func Ignore3(n int) []int {
+	// Capacity may be reserved.
+	var result []int
+	result = make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		result = append(result, i) // hot
+	}
+	return result
+}
+
+func Ignore4(xs []int) []int {
+	// A bounded loop, prealloc handles it.
+	var result []int
+	for _, x := range xs {
+		if x != 0 {
+			result = append(result, x) // hot
+		}
+	}
+	return result
+}
+
+func Ignore5(n int) []int {
+	// Outside of the loop.
+	var result []int
+	result = append(result, n) // hot
+	return result
+}
+
+func Ignore6(n int) []int {
+	// Declared inside the loop.
+	var result []int
+	for i := 0; i < n; i++ {
+		var tmp []int
+		tmp = append(tmp, i) // hot
+		result = tmp
+	}
+	return result
+}
@@ -0,0 +1,51 @@
+package optimizetest
+
+type point struct {
+	x, y int
+}
+
+func consume(v interface{}) {}
+
+func consumeAll(prefix string, vs ...interface{}) {}
+
+func consumePoint(p point) {}
+
+func Warn(xs []int, points []point, names []string) {
+	for _, x := range xs {
+		consume(x) // hot // want `x is converted to interface{} on every loop iteration, it may allocate`
+	}
+	for i := range points {
+		consumeAll("p",
+			points[i], // hot // want `points[i] is converted to interface{} on every loop iteration, it may allocate`
+			i)         // hot // want `i is converted to interface{} on every loop iteration, it may allocate`
+	}
+	for i := 0; i < len(names); i++ {
+		consumeAll(names[i])     // hot
+		consumeAll("", names[i]) // hot // want `names[i] is converted to interface{} on every loop iteration, it may allocate`
+	}
+}
+
+func Ignore(xs []int, points []point, ptrs []*point, flags []bool, vs []interface{}) {
+	// Not in a loop.
+	consume(xs[0]) // hot
+
+	for _, x := range xs {
+		// Not a hot line.
+		consume(x)
+	}
+
+	for i := range points {
+		consume(&points[i])     // hot
+		consume(ptrs[i])        // hot
+		consume(flags[i])       // hot
+		consume(vs[i])          // hot
+		consume(10)             // hot
+		consume(nil)            // hot
+		consume(struct{}{})     // hot
+		consumePoint(points[i]) // hot
+		consumeAll("", vs...)   // hot
+		func() {
+			consume(i) // hot
+		}()
+	}
+}
@@ -0,0 +1,54 @@
+package optimizetest
+
+import (
+	"regexp"
+)
+
+var reTaken = regexp.MustCompile(`taken`)
+
+var initRe *regexp.Regexp
+
+func init() {
+	initRe = regexp.MustCompile(`init`) // hot
+}
+
+func isEmail(s string) bool {
+	re := regexp.MustCompile(`^\S+@\S+$`) // hot // want `the constant regexp is compiled on every call, move to package-level var re = regexp.MustCompile(...)`
+	return re.MatchString(s)
+}
+
+func matchAll(lines []string) int {
+	n := 0
+	for _, l := range lines {
+		if regexp.MustCompile(`^\d+$`).MatchString(l) { // hot // want `the constant regexp is compiled on every call, move to package-level var reMatchAll = regexp.MustCompile(...)`
+			n++
+		}
+		wordRe, err := regexp.Compile(`\w+`) // hot // want `the constant regexp is compiled on every call, move to package-level var wordRe = regexp.MustCompile(...)`
+		if err == nil && wordRe.MatchString(l) {
+			n++
+		}
+		taken := regexp.MustCompilePOSIX(`[a-z]+`) // hot // want `the constant regexp is compiled on every call, move to package-level var reTaken2 = regexp.MustCompilePOSIX(...)`
+		_ = taken
+	}
+	return n
+}
+
+func render(lines []string) []string {
+	for i, l := range lines {
+		lines[i] = regexp.MustCompile(`\s+`).ReplaceAllString(l, " ") // hot // want `the constant regexp is compiled on every call, move to package-level var reRender = regexp.MustCompile(...)`
+	}
+	return lines
+}
+
+func result(s string) bool {
+	reDigits := regexp.MustCompile(`\d+`) // hot // want `the constant regexp is compiled on every call, move to package-level var reDigits = regexp.MustCompile(...)`
+	return reDigits.MatchString(s)
+}
+
+func Ignore(pattern, s string) bool {
+	_ = regexp.MustCompile(`cold`)
+
+	userRe := regexp.MustCompile(pattern) // hot // want `regexp compilation should be avoided on the hot paths`
+	_ = userRe
+	return regexp.MustCompile("^" + pattern).MatchString(s) // hot // want `regexp compilation should be avoided on the hot paths`
+}
@@ -0,0 +1,135 @@
+package optimizetest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+func Warn1(filename string) {
+	data, _ := os.ReadFile(filename)
+	s := string(data)  // hot // want `string(data) conversion is repeated 2 times, convert it once and re-use the result`
+	sink(string(data)) // hot
+	println(s)
+}
+
+func Warn2(b []byte) {
+	if len(b) != 0 {
+		println(string(b)) // hot
+	}
+	sink(string(b))          // hot // want `string(b) conversion is repeated 2 times`
+	println(string(b) + "!") // hot
+
+	b[0] = 'a'
+
+	println(string(b))         // hot // want `string(b) conversion is repeated 2 times`
+	println(cap(b), string(b)) // hot
+}
+
+type byteSlice []byte
+
+func Warn3(b byteSlice) {
+	if string(b) != "" {
+		println(string(b)) // hot // want `string(b) conversion is repeated 3 times`
+		println(string(b)) // hot
+		println(string(b)) // hot
+	}
+}
+
+func Ignore1(filename string) {
+	data, _ := os.ReadFile(filename)
+	println(string(data)) // hot
+	data[0] = 'a'
+	println(string(data)) // hot
+}
+
+func Ignore2(b []byte) {
+	println(string(b)) // hot
+	copy(b, "abc")
+	println(string(b)) // hot
+	b = append(b, 'a')
+	println(string(b)) // hot
+}
+
+func Ignore3(b []byte) {
+	println(string(b)) // hot
+	f := func() {
+		b[0] = 'a'
+	}
+	f()
+	println(string(b)) // hot
+}
+
+func Ignore4(b1, b2 []byte, s string) {
+	println(string(b1), string(b2))         // hot
+	println(string(s), string(s))           // hot
+	println(string(b1[1:]), string(b1[1:])) // hot
+}
+
+var globalBytes []byte
+
+func Ignore5() {
+	println(string(globalBytes)) // hot
+	println(string(globalBytes)) // hot
+}
+
+func sink(s string) {}
+
+func Ignore6(b []byte, m map[string]int) {
+	println(string(b))    // hot
+	println(m[string(b)]) // hot
+	if string(b) == "abc" {
+		println("ok")
+	}
+}
+
+func Ignore7(b []byte, cond bool) {
+	// Exclusive branches.
+	if cond {
+		println(string(b)) // hot
+	} else {
+		println(string(b)) // hot
+	}
+	switch {
+	case cond:
+		sink(string(b)) // hot
+	default:
+		sink(string(b)) // hot
+	}
+}
+
+func Ignore8(b []byte, buf *bytes.Buffer, re *regexp.Regexp) {
+	// Removed by other rules.
+	println(strings.Contains(string(b), "x")) // hot // want `strings.Contains(string(b), "x") => bytes.Contains(b, []byte("x"))`
+	println(re.MatchString(string(b)))        // hot // want `re.MatchString(string(b)) => re.Match(b)`
+	buf.WriteString(string(b))                // hot // want `buf.WriteString(string(b)) => buf.Write(b)`
+	println(fmt.Sprintf("%s!", string(b)))    // hot // want `string(b) => b`
+	println(string(b))                        // hot
+}
+
+func Ignore9(b []byte) {
+	// Not hot lines.
+	println(string(b))
+	println(string(b))
+}
+
+func fill(b []byte) int {
+	copy(b, "abc")
+	return len(b)
+}
+
+func Ignore10(b []byte, m map[int]int) {
+	// Mutations inside the non-allocating expressions.
+	s1 := string(b) // hot
+	if fill(b) != 0 {
+		println(s1)
+	}
+	s2 := string(b) // hot
+	println(m[fill(b)])
+	s3 := string(b) // hot
+	println(len(string(b[:fill(b)])))
+	s4 := string(b) // hot
+	println(s2, s3, s4)
+}
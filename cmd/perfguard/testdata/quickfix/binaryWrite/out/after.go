@@ -8,8 +8,8 @@ import (
 func main() {
 	{
 		var buf strings.Builder
-		buf.Write([]byte("hello, "))
-		buf.Write([]byte("world"))
+		buf.WriteString("hello, ")
+		buf.WriteString("world")
 		println(buf.String())
 	}
 
@@ -22,24 +22,24 @@ func main() {
 
 	{
 		buf := bytes.NewBuffer(make([]byte, 10))
-		buf.Write([]byte("hello, "))
-		buf.Write([]byte("world"))
+		buf.WriteString("hello, ")
+		buf.WriteString("world")
 		println(buf.String())
 	}
 
 	{
 		var buffers [4]bytes.Buffer
-		buffers[0].Write([]byte("hello, "))
-		buffers[0].Write([]byte("world"))
+		buffers[0].WriteString("hello, ")
+		buffers[0].WriteString("world")
 		println(buffers[0].String())
 	}
 
 	{
 		var buf strings.Builder
-		if _, err := buf.Write([]byte("hello, ")); err != nil {
+		if _, err := buf.WriteString("hello, "); err != nil {
 			panic(err)
 		}
-		if _, err := buf.Write([]byte("world")); err != nil {
+		if _, err := buf.WriteString("world"); err != nil {
 			panic(err)
 		}
 		println(buf.String())
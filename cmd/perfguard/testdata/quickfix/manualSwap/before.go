@@ -0,0 +1,34 @@
+package main
+
+type pair struct {
+	x, y int
+}
+
+type node struct {
+	value int
+	next  *node
+}
+
+func main() {
+	xs := []int{1, 2, 3}
+	tmp := xs[0]
+	xs[0] = xs[2]
+	xs[2] = tmp
+	println(xs[0], xs[1], xs[2])
+
+	p := pair{x: 10, y: 20}
+	if p.x < p.y {
+		t := p.x
+		p.x = p.y
+		p.y = t
+	}
+	println(p.x, p.y)
+
+	// Not a swap of independent values.
+	list := &node{value: 1, next: &node{value: 2, next: &node{value: 3}}}
+	head := list.next
+	tmp2 := head
+	head = head.next
+	head.next = tmp2
+	println(list.value, list.next.value, head.value, head.next.value)
+}
@@ -21,7 +21,5 @@ func main() {
 }
 
 func clearMap(o *withMap) {
-	for k := range o.m {
-		delete(o.m, k)
-	}
+	clear(o.m)
 }
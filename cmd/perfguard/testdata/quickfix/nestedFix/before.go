@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+)
+
+type point struct{ x, y int }
+
+func (p point) String() string { return fmt.Sprintf("(%d, %d)", p.x, p.y) }
+
+// Nested calls are simplified one by one,
+// the file is fixed until there is nothing left to fix.
+func main() {
+	name := "gopher"
+	p := point{1, 2}
+
+	fmt.Println(fmt.Sprint(fmt.Sprint(name)))
+	fmt.Println(fmt.Sprintf("%s", fmt.Sprint(p)))
+	fmt.Println(fmt.Sprint(fmt.Sprintf("%v", fmt.Sprint(name))))
+
+	// The strconv import is added by the fix.
+	n := 42
+	fmt.Println(fmt.Sprint(fmt.Sprint(n)))
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type point struct{ x, y int }
+
+func (p point) String() string { return fmt.Sprintf("(%d, %d)", p.x, p.y) }
+
+// Nested calls are simplified one by one,
+// the file is fixed until there is nothing left to fix.
+func main() {
+	name := "gopher"
+	p := point{1, 2}
+
+	fmt.Println(name)
+	fmt.Println(p.String())
+	fmt.Println(name)
+
+	// The strconv import is added by the fix.
+	n := 42
+	fmt.Println(strconv.Itoa(n))
+}
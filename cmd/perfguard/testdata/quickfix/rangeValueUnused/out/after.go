@@ -0,0 +1,23 @@
+package main
+
+func main() {
+	xs := []string{"a", "b", "c"}
+	for i := range xs {
+		if i == 1 {
+			continue
+		}
+		println("f1", i)
+	}
+
+	var i int
+	for i = range xs {
+		println("f2", i)
+	}
+	println(i)
+
+	n := 0
+	for range xs {
+		n++
+	}
+	println(n)
+}
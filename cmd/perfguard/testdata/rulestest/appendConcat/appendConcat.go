@@ -0,0 +1,21 @@
+package rulestest
+
+type myString string
+
+func Warn(b []byte, s, s2 string, ms myString) []byte {
+	b = append(b, "key="+s...)       // want `append(b, "key="+s...) => append(append(b, "key="...), s...)`
+	b = append(b, s+s2...)           // want `append(b, s+s2...) => append(append(b, s...), s2...)`
+	b = append(b, s+"\n"...)         // want `append(b, s+"\n"...) => append(append(b, s...), "\n"...)`
+	b = append(b, s+"="+s2...)       // want `append(b, s+"="+s2...) => append(append(append(b, s...), "="...), s2...)`
+	b = append(b, ms+myString(s)...) // want `append(b, ms+myString(s)...) => append(append(b, ms...), myString(s)...)`
+	return b
+}
+
+func Ignore(b []byte, xs []byte, s string) []byte {
+	const prefix = "a"
+	b = append(b, "a"+"b"...)
+	b = append(b, prefix+"b"...)
+	b = append(b, s...)
+	b = append(b, xs...)
+	return b
+}
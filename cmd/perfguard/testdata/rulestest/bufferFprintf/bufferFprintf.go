@@ -0,0 +1,68 @@
+package rulestest
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+func Warn(id int, name string, format string, args []interface{}) {
+	{
+		buf := &bytes.Buffer{}
+		buf.WriteString(fmt.Sprintf("id=%d", id))              // want `buf.WriteString(fmt.Sprintf("id=%d", id)) => fmt.Fprintf(buf, "id=%d", id)`
+		buf.WriteString(fmt.Sprint(id, name))                  // want `buf.WriteString(fmt.Sprint(id, name)) => fmt.Fprint(buf, id, name)`
+		buf.WriteString(fmt.Sprintln(id, name))                // want `buf.WriteString(fmt.Sprintln(id, name)) => fmt.Fprintln(buf, id, name)`
+		_, _ = buf.WriteString(fmt.Sprintf("%s=%d", name, id)) // want `buf.WriteString(fmt.Sprintf("%s=%d", name, id)) => fmt.Fprintf(buf, "%s=%d", name, id)`
+	}
+
+	{
+		sb := &strings.Builder{}
+		sb.WriteString(fmt.Sprintf("name=%q", name)) // want `sb.WriteString(fmt.Sprintf("name=%q", name)) => fmt.Fprintf(sb, "name=%q", name)`
+		sb.WriteString(fmt.Sprint(name, id))         // want `sb.WriteString(fmt.Sprint(name, id)) => fmt.Fprint(sb, name, id)`
+	}
+
+	{
+		var buf bytes.Buffer
+		buf.WriteString(fmt.Sprintf("%d:%s", id, name)) // want `buf.WriteString(fmt.Sprintf("%d:%s", id, name)) => fmt.Fprintf(&buf, "%d:%s", id, name)`
+
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprint(id, name)) // want `sb.WriteString(fmt.Sprint(id, name)) => fmt.Fprint(&sb, id, name)`
+		sb.WriteString(fmt.Sprintln(name))   // want `sb.WriteString(fmt.Sprintln(name)) => fmt.Fprintln(&sb, name)`
+
+		sb.WriteString(fmt.Sprintf(format, args...))  // want `sb.WriteString(fmt.Sprintf(format, args...)) => fmt.Fprintf(&sb, format, args...)`
+		sb.WriteString(fmt.Sprintf(format, name, id)) // want `sb.WriteString(fmt.Sprintf(format, name, id)) => fmt.Fprintf(&sb, format, name, id)`
+	}
+
+	{
+		var bufs [2]bytes.Buffer
+		bufs[0].WriteString(fmt.Sprintf("id=%d", id)) // want `bufs[0].WriteString(fmt.Sprintf("id=%d", id)) => fmt.Fprintf(&bufs[0], "id=%d", id)`
+	}
+}
+
+func Ignore(id int, name string) {
+	{
+		buf := &bytes.Buffer{}
+		fmt.Fprintf(buf, "id=%d", id)
+		buf.WriteString(name)
+	}
+
+	{
+		var w stringWriter
+		w.WriteString(fmt.Sprintf("id=%d", id))
+		w.WriteString(fmt.Sprint(id, name))
+	}
+
+	{
+		var w *stringWriter
+		w.WriteString(fmt.Sprintf("id=%d", id))
+	}
+}
+
+type stringWriter struct {
+	data []string
+}
+
+func (w *stringWriter) WriteString(s string) (int, error) {
+	w.data = append(w.data, s)
+	return len(s), nil
+}
@@ -10,4 +10,12 @@ func Warn(b1, b2 []byte) {
 func Ignore(b1, b2 []byte) {
 	_ = bytes.Equal(b1, b2)
 	_ = !bytes.Equal(b1, b2)
+
+	// The ordering comparisons can't be expressed with bytes.Equal.
+	_ = bytes.Compare(b1, b2) >= 0
+	_ = bytes.Compare(b1, b2) <= 0
+	_ = bytes.Compare(b1, b2) < 0
+	_ = bytes.Compare(b1, b2) > 0
+	_ = bytes.Compare(b1, b2) == 1
+	_ = bytes.Compare(b1, b2) == -1
 }
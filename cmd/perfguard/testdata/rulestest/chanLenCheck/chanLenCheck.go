@@ -0,0 +1,67 @@
+package rulestest
+
+func Warn(ch chan int, recvOnly <-chan int, sendOnly chan<- int) {
+	for len(ch) > 0 { // want `len(ch) can change before the receive, use a select with a default case instead`
+		<-ch
+	}
+
+	for len(recvOnly) != 0 { // want `len(recvOnly) can change before the receive, use a select with a default case instead`
+		x := <-recvOnly
+		println(x)
+	}
+
+	if len(ch) > 0 { // want `len(ch) can change before the receive, use a select with a default case instead`
+		println("draining")
+		v := <-ch
+		println(v)
+	}
+
+	var v int
+	if len(ch) != 0 { // want `len(ch) can change before the receive, use a select with a default case instead`
+		v = <-ch
+	}
+	println(v)
+
+	if len(sendOnly) < cap(sendOnly) { // want `len(sendOnly) can change before the send, use a select with a default case instead`
+		sendOnly <- 1
+	}
+}
+
+type queue []int
+
+func Ignore(ch, other chan int, q queue, xs []int) {
+	// Another channel is used.
+	for len(ch) > 0 {
+		<-other
+	}
+
+	// Not a channel.
+	if len(q) > 0 {
+		println(q[0])
+	}
+	if len(xs) != 0 {
+		println(xs[0])
+	}
+
+	// Only the length is reported.
+	println(len(ch))
+
+	// The operation is nested in another statement.
+	if len(ch) > 0 {
+		select {
+		case v := <-ch:
+			println(v)
+		default:
+		}
+	}
+
+	// A select with a default case is the right way.
+	for {
+		select {
+		case <-ch:
+			continue
+		default:
+		}
+		break
+	}
+}
@@ -0,0 +1,30 @@
+package rulestest
+
+func Warn(dst, src []byte, s string, ints [][]int) {
+	copy(dst, src[:len(dst)])             // want `copy(dst, src[:len(dst)]) can be replaced with copy(dst, src) if len(src) >= len(dst)`
+	copy(dst, src[0:len(dst)])            // want `copy(dst, src[0:len(dst)]) can be replaced with copy(dst, src) if len(src) >= len(dst)`
+	copy(dst[:len(src)], src)             // want `copy(dst[:len(src)], src) can be replaced with copy(dst, src) if len(dst) >= len(src)`
+	copy(dst[0:len(src)], src)            // want `copy(dst[0:len(src)], src) can be replaced with copy(dst, src) if len(dst) >= len(src)`
+	copy(dst, s[:len(dst)])               // want `copy(dst, s[:len(dst)]) can be replaced with copy(dst, s) if len(s) >= len(dst)`
+	copy(dst[:len(s)], s)                 // want `copy(dst[:len(s)], s) can be replaced with copy(dst, s) if len(dst) >= len(s)`
+	copy(ints[0], ints[1][:len(ints[0])]) // want `copy(ints[0], ints[1][:len(ints[0])]) can be replaced with copy(ints[0], ints[1]) if len(ints[1]) >= len(ints[0])`
+
+	n := copy(dst, src[:len(dst)]) // want `copy(dst, src[:len(dst)]) can be replaced with copy(dst, src) if len(src) >= len(dst)`
+	_ = n
+}
+
+func Ignore(dst, src []byte, n int) {
+	copy(dst, src)
+	copy(dst, src[:n])
+	copy(dst[:n], src[:n])
+	copy(dst, src[1:len(dst)])
+	copy(dst, src[:len(dst)-1])
+	copy(dst, src[:len(src)])
+	copy(dst[:len(dst)], src)
+	copy(dst[1:len(src)], src)
+	copy(dst[:len(src)], src[1:])
+
+	copy(newBytes(), src[:len(newBytes())])
+}
+
+func newBytes() []byte { return make([]byte, 10) }
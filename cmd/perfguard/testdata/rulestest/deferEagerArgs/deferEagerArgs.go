@@ -0,0 +1,37 @@
+package rulestest
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+type object struct{}
+
+func (o *object) String() string { return "object" }
+
+func Warn(o *object, s string) {
+	defer log.Printf("%v", o.String())             // want `o.String() is evaluated at the defer statement, not when log.Printf is called`
+	defer fmt.Println("done:", len(s), o.String()) // want `o.String() is evaluated at the defer statement, not when fmt.Println is called`
+	defer log.Println(strings.ToUpper(s) + "!")    // want `strings.ToUpper(s) + "!" is evaluated at the defer statement, not when log.Println is called`
+	defer trace(time.Since(time.Now()))            // want `time.Since(time.Now()) is evaluated at the defer statement, not when trace is called`
+}
+
+func Ignore(o *object, s string, buf []byte, mu *sync.Mutex) {
+	mu.Lock()
+	defer mu.Unlock()
+	defer log.Print(s)
+	defer log.Println(len(s), cap(buf))
+	defer log.Printf("%s: %v", s, o)
+	defer trace(time.Now())
+	defer run(func() { log.Print(o.String()) })
+	defer func() {
+		log.Print(o.String())
+	}()
+}
+
+func trace(x interface{}) {}
+
+func run(f func()) { f() }
@@ -0,0 +1,48 @@
+package rulestest
+
+type bigStruct struct {
+	id    int
+	name  string
+	data  [8]int64
+	count int
+}
+
+type smallStruct struct {
+	a, b int
+}
+
+func Warn(p *bigStruct, items []*bigStruct) {
+	v := *p // want `v is a copy of *p that is stored back, use p.count = 10 to modify it in place`
+	v.count = 10
+	*p = v
+
+	item := *items[0] // want `item is a copy of *items[0] that is stored back, use items[0].id = 1; items[0].name = "x" to modify it in place`
+	item.id = 1
+	item.name = "x"
+	*items[0] = item
+
+	// The later usages of the copy can use *p instead.
+	v2 := *p // want `v2 is a copy of *p that is stored back, use p.count = 10 to modify it in place`
+	v2.count = 10
+	*p = v2
+	println(v2.id)
+}
+
+func Ignore(p *bigStruct, small *smallStruct, items []*bigStruct, next func() int) {
+	v := *p
+	v.count = 10
+	println(v.id)
+	*p = v
+
+	v2 := *p
+	v2.count = v2.id + 1
+	*p = v2
+
+	v3 := *small
+	v3.a = 10
+	*small = v3
+
+	v4 := *items[next()]
+	v4.count = 10
+	*items[next()] = v4
+}
@@ -0,0 +1,22 @@
+package rulestest
+
+import "time"
+
+func Warn(d time.Duration) {
+	_ = time.Duration(5) * time.Second        // want `time.Duration(5) * time.Second => 5 * time.Second`
+	_ = time.Duration(100) * time.Millisecond // want `time.Duration(100) * time.Millisecond => 100 * time.Millisecond`
+	_ = time.Second * time.Duration(30)       // want `time.Second * time.Duration(30) => time.Second * 30`
+	_ = time.Duration(-1) * time.Hour         // want `time.Duration(-1) * time.Hour => -1 * time.Hour`
+	_ = time.Duration(2) * d                  // want `time.Duration(2) * d => 2 * d`
+}
+
+func Ignore(n int, n32 int32, d time.Duration) {
+	const typed int = 5
+	_ = time.Duration(n) * time.Second
+	_ = time.Duration(n32) * time.Hour
+	_ = time.Second * time.Duration(n)
+	_ = time.Duration(typed) * time.Second
+	_ = 5 * time.Second
+	_ = time.Duration(5) + time.Second
+	_ = time.Duration(d) * 2
+}
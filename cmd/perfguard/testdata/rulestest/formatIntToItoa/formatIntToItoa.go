@@ -0,0 +1,22 @@
+package rulestest
+
+import (
+	"strconv"
+)
+
+type myInt int
+
+func Warn(i int, xs []int) {
+	_ = strconv.FormatInt(int64(i), 10)       // want `strconv.FormatInt(int64(i), 10) => strconv.Itoa(i)`
+	_ = strconv.FormatInt(int64(xs[0]), 10)   // want `strconv.FormatInt(int64(xs[0]), 10) => strconv.Itoa(xs[0])`
+	_ = strconv.FormatInt(int64(len(xs)), 10) // want `strconv.FormatInt(int64(len(xs)), 10) => strconv.Itoa(len(xs))`
+}
+
+func Ignore(i int, i32 int32, i64 int64, mi myInt) {
+	_ = strconv.FormatInt(int64(i32), 10)
+	_ = strconv.FormatInt(i64, 10)
+	_ = strconv.FormatInt(int64(i64), 10)
+	_ = strconv.FormatInt(int64(mi), 10)
+	_ = strconv.FormatInt(int64(i), 16)
+	_ = strconv.Itoa(i)
+}
@@ -0,0 +1,21 @@
+package rulestest
+
+import "math"
+
+type myInt int
+
+func Warn(x int, y int64, z uint8, v myInt) {
+	_ = int(math.Abs(float64(x)))     // want `use an integer abs helper instead of int(math.Abs(float64(x)))`
+	_ = int64(math.Abs(float64(y)))   // want `use an integer abs helper instead of int64(math.Abs(float64(y)))`
+	_ = int(math.Abs(float64(x - 1))) // want `use an integer abs helper instead of int(math.Abs(float64(x - 1)))`
+	_ = int(math.Abs(float64(z)))     // want `use an integer abs helper instead of int(math.Abs(float64(z)))`
+	_ = myInt(math.Abs(float64(v)))   // want `use an integer abs helper instead of myInt(math.Abs(float64(v)))`
+}
+
+func Ignore(x int, f float64, f32 float32) {
+	_ = math.Abs(float64(x))
+	_ = float64(math.Abs(float64(x)))
+	_ = int(math.Abs(f))
+	_ = int(math.Abs(float64(f32)))
+	_ = int(math.Abs(float64(f)))
+}
@@ -0,0 +1,26 @@
+package rulestest
+
+func Warn(s string, xs []int, m map[int]int) {
+	_ = len(s) >= 1 // want `len(s) >= 1 => len(s) > 0`
+	_ = 1 <= len(s) // want `1 <= len(s) => len(s) > 0`
+	_ = len(xs) < 1 // want `len(xs) < 1 => len(xs) == 0`
+	_ = 1 > len(xs) // want `1 > len(xs) => len(xs) == 0`
+
+	_ = len(m) > -1 // want `len(m) is never negative, the condition is always true`
+	_ = -1 < len(m) // want `len(m) is never negative, the condition is always true`
+	_ = len(s) >= 0 // want `len(s) is never negative, the condition is always true`
+	_ = 0 <= len(s) // want `len(s) is never negative, the condition is always true`
+	_ = len(s) < 0  // want `len(s) is never negative, the condition is always false`
+	_ = 0 > len(s)  // want `len(s) is never negative, the condition is always false`
+}
+
+func Ignore(s string, xs []int) {
+	_ = len(s) > 0
+	_ = len(s) == 0
+	_ = len(s) >= 2
+	_ = len(s) < 2
+	_ = len(s) > 1
+	_ = len(xs) <= 1
+	_ = len(xs) > -2
+	_ = len(xs) != 0
+}
@@ -0,0 +1,83 @@
+package rulestest
+
+func Warn(xs []int, n int) {
+	{
+		s := make([]int, n) // want `append adds elements after the n zero values of s, use make with a 0 length and a n capacity instead`
+		s = append(s, 1)
+		println(s)
+	}
+
+	{
+		s := make([]int, 10, 20) // want `append adds elements after the 10 zero values of s, use make with a 0 length and a 10 capacity instead`
+		s = append(s, 1, 2)
+		println(s)
+	}
+
+	{
+		var s []int
+		s = make([]int, len(xs)) // want `append adds elements after the len(xs) zero values of s, use make with a 0 length and a len(xs) capacity instead`
+		s = append(s, xs...)
+		println(s)
+	}
+
+	{
+		s := make([]int, len(xs)) // want `append adds elements after the len(xs) zero values of s, use make with a 0 length and a len(xs) capacity instead`
+		for _, x := range xs {
+			s = append(s, x*2)
+		}
+		println(s)
+	}
+
+	{
+		s := make([]int, len(xs)) // want `append adds elements after the len(xs) zero values of s, use make with a 0 length and a len(xs) capacity instead`
+		for i := range xs {
+			if i%2 == 0 {
+				continue
+			}
+			s = append(s, i)
+		}
+		println(s)
+	}
+}
+
+func Ignore(xs []int, n int) {
+	{
+		s := make([]int, 0, n)
+		s = append(s, 1)
+		println(s)
+	}
+
+	{
+		s := make([]int, 0)
+		s = append(s, 1)
+		println(s)
+	}
+
+	{
+		s := make([]int, n)
+		s[0] = 1
+		s = append(s, 1)
+		println(s)
+	}
+
+	{
+		s := make([]int, len(xs))
+		for i, x := range xs {
+			s[i] = x * 2
+		}
+		println(s)
+	}
+
+	{
+		s := make([]int, n)
+		other := append(s, 1)
+		println(s, other)
+	}
+
+	{
+		s := make([]int, n)
+		s2 := make([]int, n)
+		s2 = append(s, 1)
+		println(s, s2)
+	}
+}
@@ -0,0 +1,83 @@
+package rulestest
+
+import (
+	"io"
+)
+
+type myString string
+
+func Warn(s string, ms myString, r io.Reader, n int) error {
+	{
+		b := make([]byte, len(s)) // want `b is zeroed by make and then overwritten by copy, use b := []byte(s) instead`
+		copy(b, s)
+		println(b)
+	}
+
+	{
+		b := make([]byte, len(ms)) // want `b is zeroed by make and then overwritten by copy, use b := []byte(ms) instead`
+		copy(b, ms)
+		println(b)
+	}
+
+	{
+		buf := make([]byte, n) // want `buf is zeroed by make and then overwritten by io.ReadFull, consider re-using the buffer`
+		_, err := io.ReadFull(r, buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		var err error
+		header := make([]byte, 16) // want `header is zeroed by make and then overwritten by io.ReadFull, consider re-using the buffer`
+		_, err = io.ReadFull(r, header)
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		buf := make([]byte, n) // want `buf is zeroed by make and then overwritten by io.ReadFull, consider re-using the buffer`
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Ignore(s string, src []byte, r io.Reader, n int) {
+	{
+		// The compiler doesn't zero the memory for make+copy from a slice.
+		b := make([]byte, len(src))
+		copy(b, src)
+		println(b)
+	}
+
+	{
+		// Not a full overwrite.
+		b := make([]byte, n)
+		copy(b, s)
+		println(b)
+	}
+
+	{
+		// Not a full overwrite.
+		b := make([]byte, n)
+		_, _ = r.Read(b)
+		println(b)
+	}
+
+	{
+		// Not immediately overwritten.
+		b := make([]byte, n)
+		b[0] = 1
+		_, _ = io.ReadFull(r, b)
+		println(b)
+	}
+
+	{
+		b := []byte(s)
+		println(b)
+	}
+}
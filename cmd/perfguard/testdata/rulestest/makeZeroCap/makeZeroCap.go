@@ -0,0 +1,55 @@
+package rulestest
+
+type point struct{ x, y int }
+
+func Warn1(src []int) []int {
+	dst := make([]int, 0) // want `=> dst := make([]int, 0, len(src)); dst = append(dst, src...)`
+	dst = append(dst, src...)
+	return dst
+}
+
+func Warn2(dst, src []point) []point {
+	dst = make([]point, 0) // want `=> dst = make([]point, 0, len(src)); dst = append(dst, src...)`
+	dst = append(dst, src...)
+	return dst
+}
+
+func Warn3(b []byte, s string) []byte {
+	buf := make([]byte, 0) // want `=> buf := make([]byte, 0, len(s)); buf = append(buf, s...)`
+	buf = append(buf, s...)
+	buf = append(buf, b...)
+	return buf
+}
+
+func Ignore1(x int, src []int) []int {
+	// Interleaved append.
+	dst := make([]int, 0)
+	dst = append(dst, x)
+	dst = append(dst, src...)
+	return dst
+}
+
+func Ignore2(src []int) []int {
+	// Already has a capacity hint.
+	dst := make([]int, 0, len(src))
+	dst = append(dst, src...)
+	return dst
+}
+
+func Ignore3(src [][]int) []int {
+	// Not a pure source.
+	dst := make([]int, 0)
+	dst = append(dst, next(src)...)
+	return dst
+}
+
+func Ignore4(src []int) []int {
+	// Appended to another slice.
+	dst := make([]int, 0)
+	other := append(dst, src...)
+	return other
+}
+
+func next(src [][]int) []int {
+	return src[0]
+}
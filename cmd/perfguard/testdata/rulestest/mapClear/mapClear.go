@@ -1,33 +1,89 @@
 package rulestest
 
+type withMap struct {
+	m map[int]bool
+}
+
+type point struct {
+	x, y int
+}
+
 func Warn(m map[string]int) {
 	{
-		m = make(map[string]int, len(m)) // want `m = make(map[string]int, len(m)) => for k := range m { delete(m, k) }`
+		m = make(map[string]int, len(m)) // want `m = make(map[string]int, len(m)) => clear(m)`
 	}
 
 	{
-		type withMap struct {
-			m map[int]bool
+		o := &withMap{}
+		o.m = make(map[int]bool, len(o.m)) // want `o.m = make(map[int]bool, len(o.m)) => clear(o.m)`
+	}
+
+	{
+		for k := range m { // want `for ... { ... } => clear(m)`
+			delete(m, k)
 		}
+	}
+
+	{
 		o := &withMap{}
-		o.m = make(map[int]bool, len(o.m)) // want `o.m = make(map[int]bool, len(o.m)) => for k := range o.m { delete(o.m, k) }`
+		for k := range o.m { // want `for ... { ... } => clear(o.m)`
+			delete(o.m, k)
+		}
+	}
+
+	{
+		points := map[point]string{}
+		for p := range points { // want `for ... { ... } => clear(points)`
+			delete(points, p)
+		}
+	}
+
+	{
+		var set map[interface{}]struct{}
+		for k := range set { // want `for ... { ... } => clear(set)`
+			delete(set, k)
+		}
 	}
 }
 
-func Ignore(m map[string]int) {
+func Ignore(m, other map[string]int) {
 	{
 		for k := range m {
+			println(k)
 			delete(m, k)
 		}
 	}
 
 	{
-		type withMap struct {
-			m map[int]bool
+		for k := range m {
+			delete(m, k)
+			println(k)
 		}
-		o := &withMap{}
-		for k := range o.m {
-			delete(o.m, k)
+	}
+
+	{
+		for k := range m {
+			delete(other, k)
 		}
 	}
+
+	{
+		for k := range m {
+			if k != "" {
+				delete(m, k)
+			}
+		}
+	}
+
+	{
+		for k := range getMap() {
+			delete(getMap(), k)
+		}
+	}
+
+	{
+		m = make(map[string]int, len(other))
+	}
 }
+
+func getMap() map[int]bool { return nil }
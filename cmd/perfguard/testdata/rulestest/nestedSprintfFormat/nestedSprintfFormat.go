@@ -0,0 +1,19 @@
+package rulestest
+
+import (
+	"fmt"
+	"io"
+)
+
+func Warn(w io.Writer, id, n int, name string) {
+	fmt.Fprintf(w, "id="+fmt.Sprintf("%d-%d", id, n)+" name=%s", name) // want `the format string is built with a fmt.Sprintf concat, merge the inner format into the outer one`
+	fmt.Printf(fmt.Sprintf("%q", name)+": %s\n", name)                 // want `the format string is built with a fmt.Sprintf concat, merge the inner format into the outer one`
+	_ = fmt.Sprintf("prefix "+fmt.Sprintf("%04x", id)+" %d", n)        // want `the format string is built with a fmt.Sprintf concat, merge the inner format into the outer one`
+}
+
+func Ignore(w io.Writer, id int, name, format string) {
+	fmt.Fprintf(w, "id=%s name=%s", fmt.Sprintf("%04x", id), name)
+	fmt.Printf("prefix "+format, name)
+	fmt.Printf(format, name)
+	fmt.Printf(fmt.Sprintf("%q", name))
+}
@@ -0,0 +1,71 @@
+package rulestest
+
+type container struct {
+	items []string
+}
+
+func Warn(xs []int, m map[string]int, c *container) {
+	if xs != nil { // want `the xs != nil check is redundant, a range over a nil slice or map is a no-op`
+		for _, x := range xs {
+			println(x)
+		}
+	}
+
+	if m != nil { // want `the m != nil check is redundant, a range over a nil slice or map is a no-op`
+		for k, v := range m {
+			println(k, v)
+		}
+	}
+
+	if nil != m { // want `the m != nil check is redundant, a range over a nil slice or map is a no-op`
+		for k := range m {
+			println(k)
+		}
+	}
+
+	if c.items != nil { // want `the c.items != nil check is redundant, a range over a nil slice or map is a no-op`
+		for range c.items {
+			println()
+		}
+	}
+
+	var i int
+	if xs != nil { // want `the xs != nil check is redundant, a range over a nil slice or map is a no-op`
+		for i = range xs {
+			println(i)
+		}
+	}
+}
+
+func Ignore(xs []int, ch chan int, get func() []int) {
+	// A range over a nil channel blocks forever.
+	if ch != nil {
+		for x := range ch {
+			println(x)
+		}
+	}
+
+	// The if body is not only the loop.
+	if xs != nil {
+		println("non-nil")
+		for _, x := range xs {
+			println(x)
+		}
+	}
+
+	// A different slice is ranged over.
+	if xs != nil {
+		for _, x := range get() {
+			println(x)
+		}
+	}
+
+	// There is an else branch.
+	if xs != nil {
+		for _, x := range xs {
+			println(x)
+		}
+	} else {
+		println("nil")
+	}
+}
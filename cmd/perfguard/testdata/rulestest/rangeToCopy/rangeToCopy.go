@@ -35,6 +35,16 @@ func Warn(srcBytes, dstBytes []byte) {
 			}
 		}
 	}
+
+	{
+		// The dst elements are overwritten.
+		for i := range dstBytes { // want `for … { … } can be replaced with copy(dstBytes, srcBytes) if dstBytes and srcBytes don't overlap`
+			dstBytes[i] = srcBytes[i]
+		}
+		for i := 0; i < len(dstBytes); i++ { // want `for … { … } can be replaced with copy(dstBytes, srcBytes) if dstBytes and srcBytes don't overlap`
+			dstBytes[i] = srcBytes[i]
+		}
+	}
 }
 
 func Ignore(srcBytes, dstBytes []byte) {
@@ -71,6 +81,29 @@ func Ignore(srcBytes, dstBytes []byte) {
 		}
 	}
 
+	{
+		// The indexes are transformed.
+		for i := range dstBytes {
+			dstBytes[i] = srcBytes[i+1]
+		}
+		for i := range dstBytes {
+			dstBytes[len(dstBytes)-1-i] = srcBytes[i]
+		}
+		// The values are transformed.
+		for i := range dstBytes {
+			dstBytes[i] = srcBytes[i] + 1
+		}
+		// dst is read before it's written.
+		for i := range dstBytes {
+			dstBytes[i] = dstBytes[i] ^ srcBytes[i]
+		}
+		// Different element types.
+		ints := make([]int, len(srcBytes))
+		for i := range ints {
+			ints[i] = int(srcBytes[i])
+		}
+	}
+
 	{
 		type Book struct {
 			authorID int
@@ -0,0 +1,39 @@
+package rulestest
+
+func Warn(xs []int, m map[string]int, ch chan int) {
+	for i, _ := range xs { // want `for i, _ := range xs => for i := range xs, the value is unused (a value var would copy every element)`
+		println(i)
+	}
+	for k, _ := range m { // want `for k, _ := range m => for k := range m, the value is unused (a value var would copy every element)`
+		println(k)
+	}
+
+	var i int
+	for i, _ = range xs { // want `for i, _ = range xs => for i = range xs, the value is unused (a value var would copy every element)`
+	}
+	println(i)
+
+	for _, _ = range xs { // want `use for range xs, the range vars are unused`
+	}
+	for _ = range ch { // want `use for range ch, the range vars are unused`
+	}
+}
+
+func Ignore(xs []int, m map[string]int) {
+	for i := range xs {
+		println(i)
+	}
+	for _, x := range xs {
+		println(x)
+	}
+	for k, v := range m {
+		println(k, v)
+	}
+	for range xs {
+	}
+
+	var i, v int
+	for i, v = range xs {
+	}
+	println(i, v)
+}
@@ -0,0 +1,96 @@
+package rulestest
+
+import (
+	"bytes"
+	"io"
+)
+
+func Warn(r io.Reader) error {
+	{
+		data, err := io.ReadAll(r) // want `data is read from r only to be wrapped into bytes.NewReader, consider passing r directly`
+		if err != nil {
+			return err
+		}
+		br := bytes.NewReader(data)
+		consume(br)
+	}
+
+	{
+		var br *bytes.Reader
+		data, err := io.ReadAll(r) // want `data is read from r only to be wrapped into bytes.NewReader, consider passing r directly`
+		if err != nil {
+			return err
+		}
+		br = bytes.NewReader(data)
+		consume(br)
+	}
+
+	{
+		var data []byte
+		var err error
+		data, err = io.ReadAll(r) // want `data is read from r only to be wrapped into bytes.NewReader, consider passing r directly`
+		if err != nil {
+			return err
+		}
+		br := bytes.NewReader(data)
+		consume(br)
+	}
+
+	{
+		data, _ := io.ReadAll(r) // want `data is read from r only to be wrapped into bytes.NewReader, consider passing r directly`
+		br := bytes.NewReader(data)
+		consume(br)
+	}
+
+	return nil
+}
+
+func Ignore(r io.Reader, readers []io.Reader) error {
+	{
+		// The data is transformed.
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		br := bytes.NewReader(data[1:])
+		consume(br)
+	}
+
+	{
+		// The data is used before it's re-read.
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		println(len(data))
+		br := bytes.NewReader(data)
+		consume(br)
+	}
+
+	{
+		// Another slice is wrapped.
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		br := bytes.NewReader(nil)
+		consume(br)
+		println(data)
+	}
+
+	{
+		// The reader expression is not pure.
+		data, err := io.ReadAll(nextReader(readers))
+		if err != nil {
+			return err
+		}
+		br := bytes.NewReader(data)
+		consume(br)
+	}
+
+	return nil
+}
+
+func consume(r io.Reader) {}
+
+func nextReader(readers []io.Reader) io.Reader { return readers[0] }
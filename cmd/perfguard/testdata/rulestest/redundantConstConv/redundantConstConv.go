@@ -0,0 +1,38 @@
+package rulestest
+
+import (
+	"time"
+)
+
+type level int
+
+type name string
+
+type ratio float64
+
+const typedZero int = 0
+
+func Warn(d time.Duration, l level, n name, r ratio) {
+	_ = d == time.Duration(0)   // want `d == time.Duration(0) => d == 0`
+	_ = time.Duration(10) < d   // want `time.Duration(10) < d => 10 < d`
+	_ = d >= time.Duration(1e9) // want `d >= time.Duration(1e9) => d >= 1e9`
+	_ = l != level(-1)          // want `l != level(-1) => l != -1`
+	_ = l <= level(0x10)        // want `l <= level(0x10) => l <= 0x10`
+	_ = level(3) > l            // want `level(3) > l => 3 > l`
+	_ = n == name("admin")      // want `n == name("admin") => n == "admin"`
+	_ = name(`root`) != n       // want `name(`root`) != n => `root` != n`
+	_ = int64(d) == int64(0)    // want `int64(d) == int64(0) => int64(d) == 0`
+	_ = r > ratio(0.5)          // want `r > ratio(0.5) => r > 0.5`
+}
+
+func Ignore(d time.Duration, l level, n name, i int, x interface{}) {
+	_ = d == time.Duration(i)
+	_ = d == time.Duration(typedZero)
+	_ = i == int(l)
+	_ = n == name(65)
+	_ = d == time.Second
+	_ = d == toDuration(0)
+	_ = x == level(1)
+}
+
+func toDuration(x int) time.Duration { return time.Duration(x) }
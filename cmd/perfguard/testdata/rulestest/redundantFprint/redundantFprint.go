@@ -33,9 +33,14 @@ func Warn() {
 	{
 		var w buffer
 		var b []byte
-		fmt.Fprint(w, b)        // want `fmt.Fprint(w, b) => w.Write(b)`
 		fmt.Fprintf(w, "%s", b) // want `fmt.Fprintf(w, "%s", b) => w.Write(b)`
-		fmt.Fprintf(w, "%v", b) // want `fmt.Fprintf(w, "%v", b) => w.Write(b)`
+	}
+
+	{
+		type myBytes []byte
+		var w buffer
+		var b myBytes
+		fmt.Fprintf(w, "%s", b) // want `fmt.Fprintf(w, "%s", b) => w.Write(b)`
 	}
 }
 
@@ -71,6 +76,14 @@ func Ignore() {
 		var b []byte
 		w.Write(b)
 	}
+
+	{
+		// %v prints the byte values: [104 105].
+		var w buffer
+		var b []byte
+		fmt.Fprint(w, b)
+		fmt.Fprintf(w, "%v", b)
+	}
 }
 
 type withStringer struct{}
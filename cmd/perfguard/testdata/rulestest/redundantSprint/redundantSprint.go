@@ -30,16 +30,12 @@ func Warn() {
 
 	{
 		var b []byte
-		_ = fmt.Sprint(b)        // want `fmt.Sprint(b) => string(b)`
 		_ = fmt.Sprintf("%s", b) // want `fmt.Sprintf("%s", b) => string(b)`
-		_ = fmt.Sprintf("%v", b) // want `fmt.Sprintf("%v", b) => string(b)`
 	}
 	{
 		type myBytes []byte
 		var b myBytes
-		_ = fmt.Sprint(b)        // want `fmt.Sprint(b) => string(b)`
 		_ = fmt.Sprintf("%s", b) // want `fmt.Sprintf("%s", b) => string(b)`
-		_ = fmt.Sprintf("%v", b) // want `fmt.Sprintf("%v", b) => string(b)`
 	}
 	{
 		type myString string
@@ -85,6 +81,18 @@ func Ignore() {
 		var s myString
 		_ = string(s)
 	}
+	{
+		// %v prints the byte values: [104 105].
+		var b []byte
+		_ = fmt.Sprint(b)
+		_ = fmt.Sprintf("%v", b)
+	}
+	{
+		type myBytes []byte
+		var b myBytes
+		_ = fmt.Sprint(b)
+		_ = fmt.Sprintf("%v", b)
+	}
 	{
 		var runes []rune
 		_ = fmt.Sprint(runes)
@@ -0,0 +1,32 @@
+package rulestest
+
+import (
+	"regexp"
+)
+
+type myRegexp struct{}
+
+func (re *myRegexp) FindString(s string) string { return s }
+
+func Warn(re *regexp.Regexp, s string) {
+	_ = re.FindString(s) != "" // want `re.FindString(s) != "" => re.MatchString(s)`
+	_ = "" != re.FindString(s) // want `"" != re.FindString(s) => re.MatchString(s)`
+	_ = re.FindString(s) == "" // want `re.FindString(s) == "" => !re.MatchString(s)`
+	_ = "" == re.FindString(s) // want `"" == re.FindString(s) => !re.MatchString(s)`
+
+	if re.FindString(s+"x") != "" { // want `re.FindString(s+"x") != "" => re.MatchString(s+"x")`
+		println(s)
+	}
+}
+
+func Ignore(re *regexp.Regexp, my *myRegexp, s string) {
+	_ = my.FindString(s) != ""
+	_ = re.FindString(s) != "x"
+	_ = re.FindString(s) == s
+
+	if m := re.FindString(s); m != "" {
+		println(m)
+	}
+
+	_ = re.MatchString(s)
+}
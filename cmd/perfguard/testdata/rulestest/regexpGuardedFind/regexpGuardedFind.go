@@ -0,0 +1,54 @@
+package rulestest
+
+import "regexp"
+
+type matcher struct {
+	re *regexp.Regexp
+}
+
+func Warn(re *regexp.Regexp, s string, m *matcher) {
+	if re.MatchString(s) { // want `re.MatchString(s) guard is redundant, use if x := re.FindString(s); x != "" { … }`
+		x := re.FindString(s)
+		println(x)
+	}
+
+	if m.re.MatchString(s) { // want `m.re.MatchString(s) guard is redundant, use if word := m.re.FindString(s); word != "" { … }`
+		word := m.re.FindString(s)
+		println(word, len(word))
+	}
+}
+
+func Ignore(re, re2 *regexp.Regexp, s, s2 string, get func() string) {
+	// Different regexp.
+	if re.MatchString(s) {
+		x := re2.FindString(s)
+		println(x)
+	}
+
+	// Different input.
+	if re.MatchString(s) {
+		x := re.FindString(s2)
+		println(x)
+	}
+
+	// The input is not pure.
+	if re.MatchString(get()) {
+		x := re.FindString(get())
+		println(x)
+	}
+
+	// FindString is not the first statement.
+	if re.MatchString(s) {
+		println("matched")
+		x := re.FindString(s)
+		println(x)
+	}
+
+	// There is an else branch.
+	if re.MatchString(s) {
+		x := re.FindString(s)
+		println(x)
+	} else {
+		println("no match")
+	}
+}
@@ -0,0 +1,25 @@
+package rulestest
+
+import (
+	"bytes"
+	"strings"
+)
+
+func Warn(s string, b []byte) {
+	_ = strings.Replace(s, "a", "b", -1)                     // want `strings.Replace(s, "a", "b", -1) => strings.ReplaceAll(s, "a", "b")`
+	_ = bytes.Replace(b, []byte("a"), []byte("b"), -1)       // want `bytes.Replace(b, []byte("a"), []byte("b"), -1) => bytes.ReplaceAll(b, []byte("a"), []byte("b"))`
+	_ = strings.Replace(strings.TrimSpace(s), s[:1], "", -1) // want `strings.Replace(strings.TrimSpace(s), s[:1], "", -1) => strings.ReplaceAll(strings.TrimSpace(s), s[:1], "")`
+}
+
+const all = -1
+
+func Ignore(s string, b []byte, n int) {
+	_ = strings.Replace(s, "a", "b", n)
+	_ = strings.Replace(s, "a", "b", all)
+	_ = strings.Replace(s, "a", "b", 1)
+	_ = bytes.Replace(b, []byte("a"), []byte("b"), n)
+	_ = strings.ReplaceAll(s, "a", "b")
+
+	n = -1
+	_ = strings.Replace(s, "a", "b", n)
+}
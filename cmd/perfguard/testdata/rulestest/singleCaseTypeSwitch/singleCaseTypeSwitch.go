@@ -0,0 +1,71 @@
+package rulestest
+
+import (
+	"fmt"
+)
+
+func Warn(x interface{}) {
+	switch v := x.(type) { // want `use if v, ok := x.(int); ok { … } instead of a single-case type switch`
+	case int:
+		println(v)
+	}
+
+	switch x.(type) { // want `use if _, ok := x.(error); ok { … } instead of a single-case type switch`
+	case error:
+		println("error")
+	}
+
+	switch v := x.(type) { // want `use if v, ok := x.(fmt.Stringer); ok { … } instead of a single-case type switch`
+	case fmt.Stringer:
+		println(v.String())
+	default:
+	}
+
+	switch v := x.(type) { // want `use if v, ok := x.(*int); ok { … } else { … } instead of a type switch with a single case and a default, use x in the else branch`
+	case *int:
+		println(*v)
+	default:
+		println(v)
+	}
+
+	switch x.(type) { // want `use if _, ok := x.(string); ok { … } else { … } instead of a type switch with a single case and a default`
+	case string:
+		println("string")
+	default:
+		println("other")
+	}
+}
+
+func Ignore(x interface{}, xs []interface{}) {
+	switch v := x.(type) {
+	case int:
+		println(v)
+	case string:
+		println(v)
+	}
+
+	switch v := x.(type) {
+	case int, string:
+		println(v)
+	}
+
+	switch x.(type) {
+	case nil:
+		println("nil")
+	}
+
+	for _, x := range xs {
+		switch v := x.(type) {
+		case int:
+			if v == 0 {
+				break
+			}
+			println(v)
+		}
+	}
+
+	switch v := x.(type) {
+	default:
+		println(v)
+	}
+}
@@ -1,6 +1,6 @@
 package rulestest
 
-func Warn() {
+func Warn(a, b int, s string, err error) {
 	_ = append([]byte{1}, 2)       // want `append([]byte{1}, 2) => []byte{1, 2}`
 	_ = append([]byte{1}, 2, 3)    // want `append([]byte{1}, 2, 3) => []byte{1, 2, 3}`
 	_ = append([]byte{1}, 2, 3, 4) // want `append([]byte{1}, 2, 3, 4) => []byte{1, 2, 3, 4}`
@@ -12,9 +12,27 @@ func Warn() {
 	_ = append([]byte(nil), 1)       // want `append([]byte(nil), 1) => []byte{1}`
 	_ = append([]byte(nil), 1, 2)    // want `append([]byte(nil), 1, 2) => []byte{1, 2}`
 	_ = append([]byte(nil), 1, 2, 3) // want `append([]byte(nil), 1, 2, 3) => []byte{1, 2, 3}`
+
+	_ = append([]int{}, a, b)              // want `append([]int{}, a, b) => []int{a, b}`
+	_ = append([]string(nil), s, "x")      // want `append([]string(nil), s, "x") => []string{s, "x"}`
+	_ = append([]interface{}{}, a, s)      // want `append([]interface{}{}, a, s) => []interface{}{a, s}`
+	_ = append([]error(nil), err, nil)     // want `append([]error(nil), err, nil) => []error{err, nil}`
+	_ = append([]float64{}, 1, float64(a)) // want `append([]float64{}, 1, float64(a)) => []float64{1, float64(a)}`
 }
 
-func Ignore(b []byte) {
+type myBytes []byte
+
+func Ignore(b []byte, mb myBytes) {
+	// The base is a named variable.
+	_ = append(b, 1, 2)
+	_ = append(mb, 1)
+
+	// A named slice type literal.
+	_ = append(myBytes{}, 1)
+
+	// Nothing to append.
+	_ = append([]byte(nil))
+
 	_ = append([]byte{1}, b...)
 	_ = append([]byte{1, 2}, 3)
 	_ = []byte{1, 2, 3}
@@ -0,0 +1,49 @@
+package rulestest
+
+import (
+	"sort"
+)
+
+func Warn(ints []int, strs []string, floats []float64, bytes []byte) {
+	sort.Slice(ints, func(i, j int) bool { return ints[i] < ints[j] }) // want `sort.Slice(ints, func(i, j int) bool { return ints[i] < ints[j] }) => slices.Sort(ints)`
+
+	sort.Slice(strs, func(a, b int) bool { return strs[a] < strs[b] }) // want `sort.Slice(strs, func(a, b int) bool { return strs[a] < strs[b] }) => slices.Sort(strs)`
+
+	sort.Slice(floats, func(i, j int) bool { return floats[i] < floats[j] }) // want `sort.Slice(floats, func(i, j int) bool { return floats[i] < floats[j] }) => slices.Sort(floats)`
+
+	sort.Slice(bytes, func(i, j int) bool { return bytes[i] < bytes[j] }) // want `sort.Slice(bytes, func(i, j int) bool { return bytes[i] < bytes[j] }) => slices.Sort(bytes)`
+
+	sort.Slice(ints, func(i, j int) bool { return ints[i] > ints[j] }) // want `use slices.Sort(ints) followed by slices.Reverse(ints), or slices.SortFunc with a reversed cmp.Compare`
+}
+
+type point struct {
+	key int
+}
+
+type ID int
+
+func Ignore(ints, other []int, points []point, ids []ID, xs [][]int) {
+	// Struct field comparison needs slices.SortFunc.
+	sort.Slice(points, func(i, j int) bool { return points[i].key < points[j].key })
+
+	// Reversed params order.
+	sort.Slice(ints, func(i, j int) bool { return ints[j] < ints[i] })
+
+	// Same index on both sides.
+	sort.Slice(ints, func(i, j int) bool { return ints[i] < ints[i] })
+
+	// Another slice is compared.
+	sort.Slice(ints, func(i, j int) bool { return other[i] < other[j] })
+
+	// Not a pure slice expression.
+	sort.Slice(xs[len(ints)], func(i, j int) bool { return xs[len(ints)][i] < xs[len(ints)][j] })
+
+	// Only unnamed element types are matched.
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	// Not a single comparison.
+	sort.Slice(ints, func(i, j int) bool {
+		println(i, j)
+		return ints[i] < ints[j]
+	})
+}
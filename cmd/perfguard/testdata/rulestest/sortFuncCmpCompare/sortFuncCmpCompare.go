@@ -0,0 +1,115 @@
+package rulestest
+
+import (
+	"github.com/quasilyte/go-perfguard/cmd/perfguard/testdata/stubs/slices"
+)
+
+type point struct {
+	X, Y  int
+	Name  string
+	Score float64
+}
+
+type myString string
+
+func Warn(points []point, names []myString) {
+	slices.SortFunc(points, func(a, b point) int { // want `use cmp.Compare(a.X, b.X) in the comparator`
+		if a.X < b.X {
+			return -1
+		} else if a.X > b.X {
+			return 1
+		}
+		return 0
+	})
+
+	slices.SortFunc(points, func(a, b point) int { // want `use cmp.Compare(a.Name, b.Name) in the comparator`
+		if a.Name < b.Name {
+			return -1
+		} else if a.Name > b.Name {
+			return 1
+		} else {
+			return 0
+		}
+	})
+
+	slices.SortFunc(points, func(a point, b point) int { // want `use cmp.Compare(a.Y, b.Y) in the comparator`
+		if a.Y < b.Y {
+			return -1
+		}
+		if a.Y > b.Y {
+			return 1
+		}
+		return 0
+	})
+
+	slices.SortFunc(names, func(a, b myString) int { // want `use cmp.Compare(a, b) in the comparator`
+		if a < b {
+			return -1
+		}
+		if a > b {
+			return 1
+		}
+		return 0
+	})
+
+	// Reversed (descending) order.
+
+	slices.SortFunc(points, func(a, b point) int { // want `use cmp.Compare(b.X, a.X) in the comparator`
+		if a.X > b.X {
+			return -1
+		} else if a.X < b.X {
+			return 1
+		}
+		return 0
+	})
+
+	slices.SortFunc(points, func(a, b point) int { // want `use cmp.Compare(b.Name, a.Name) in the comparator`
+		if a.Name > b.Name {
+			return -1
+		}
+		if a.Name < b.Name {
+			return 1
+		}
+		return 0
+	})
+}
+
+func Ignore(points []point) {
+	// Floats are not reported: NaN handling is different.
+	slices.SortFunc(points, func(a, b point) int {
+		if a.Score < b.Score {
+			return -1
+		} else if a.Score > b.Score {
+			return 1
+		}
+		return 0
+	})
+
+	// Compares different values.
+	slices.SortFunc(points, func(a, b point) int {
+		if a.X < b.X {
+			return -1
+		} else if a.Y > b.Y {
+			return 1
+		}
+		return 0
+	})
+
+	// Not a three-way comparison.
+	slices.SortFunc(points, func(a, b point) int {
+		if a.X < b.X {
+			return -1
+		}
+		return 1
+	})
+
+	// Wrong return values.
+	slices.SortFunc(points, func(a, b point) int {
+		if a.X < b.X {
+			return 1
+		} else if a.X > b.X {
+			return 1
+		}
+		return 0
+	})
+}
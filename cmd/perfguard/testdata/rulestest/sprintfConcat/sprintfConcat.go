@@ -8,10 +8,24 @@ import (
 func Warn(s1, s2 string, buf1, buf2 *bytes.Buffer) {
 	_ = fmt.Sprintf("%s%s", s1, s2)     // want `fmt.Sprintf("%s%s", s1, s2) => s1 + s2`
 	_ = fmt.Sprintf("%s%s", buf1, buf2) // want `fmt.Sprintf("%s%s", buf1, buf2) => buf1.String() + buf2.String(`
+
+	_ = fmt.Sprint(s1, s2)       // want `fmt.Sprint(s1, s2) => s1 + s2`
+	_ = fmt.Sprint("prefix", s2) // want `fmt.Sprint("prefix", s2) => "prefix" + s2`
 }
 
-func Ignore() {
+type myString string
+
+func Ignore(i1, i2 int, s string, buf *bytes.Buffer, ms myString) {
 	var s1, s2 string
 	_ = s1 + s2
 	_ = s1 + "_" + s2
+
+	// Non-string operands are formatted differently.
+	_ = fmt.Sprint(i1, i2)
+	_ = fmt.Sprint(s, i1)
+	_ = fmt.Sprint(i1, s)
+	_ = fmt.Sprint(buf, s)
+	_ = fmt.Sprint(s, ms)
+
+	_ = fmt.Sprint(s1, s2, s)
 }
@@ -0,0 +1,46 @@
+package rulestest
+
+type myString string
+
+func Warn(s string, i int, ms myString) {
+	_ = []byte(s)[0]          // want `_ = []byte(s)[0] => _ = s[0]`
+	_ = []byte(s)[i]          // want `_ = []byte(s)[i] => _ = s[i]`
+	_ = []byte(s)[len(s)-1]   // want `_ = []byte(s)[len(s)-1] => _ = s[len(s)-1]`
+	_ = []byte("abc")[1]      // want `_ = []byte("abc")[1] => _ = "abc"[1]`
+	_ = []byte(ms)[i]         // want `_ = []byte(ms)[i] => _ = ms[i]`
+	_ = []byte(s + "x")[i]    // want `_ = []byte(s + "x")[i] => _ = (s + "x")[i]`
+	println([]byte(s)[i] + 1) // want `[]byte(s)[i] => s[i]`
+	b := []byte(s)[i]         // want `b := []byte(s)[i] => b := s[i]`
+	_ = b
+	if []byte(s)[0] == '/' { // want `[]byte(s)[0] => s[0]`
+		return
+	}
+}
+
+func Ignore(s string, b []byte, i int) {
+	_ = []byte(s)[1:]
+	_ = []byte(s)[i:]
+	_ = []byte(b)[0]
+	_ = b[0]
+
+	{
+		// The conversion result is used beyond a single index.
+		bs := []byte(s)
+		_ = bs[0]
+		bs[1] = 'a'
+	}
+
+	{
+		// Not a string conversion.
+		runes := []rune(s)
+		_ = runes[0]
+	}
+
+	// Requires an addressable operand.
+	_ = &[]byte(s)[i]
+	[]byte(s)[i]++
+
+	// A string element can't be assigned.
+	[]byte(s)[i] = 'x'
+	[]byte(s)[i] += 'x'
+}
@@ -0,0 +1,31 @@
+package rulestest
+
+import (
+	"strings"
+)
+
+const comma = ","
+
+func Warn(s string) {
+	_ = len(strings.Split(s, ",")) - 1    // want `len(strings.Split(s, ",")) - 1 => strings.Count(s, ",")`
+	_ = len(strings.Split(s, ", ")) - 1   // want `len(strings.Split(s, ", ")) - 1 => strings.Count(s, ", ")`
+	_ = len(strings.Split(s, "\r\n")) - 1 // want `len(strings.Split(s, "\r\n")) - 1 => strings.Count(s, "\r\n")`
+	_ = len(strings.Split(s, comma)) - 1  // want `len(strings.Split(s, comma)) - 1 => strings.Count(s, comma)`
+
+	numSeps := len(strings.Split(s, "::")) - 1 // want `len(strings.Split(s, "::")) - 1 => strings.Count(s, "::")`
+	_ = numSeps
+}
+
+func Ignore(s, sep string) {
+	_ = strings.Count(s, ",")
+	_ = len(strings.Split(s, sep)) - 1
+	_ = len(strings.Split(s, "")) - 1
+	_ = len(strings.Split(s, ``))
+	_ = len(strings.Split(s, ","))
+	_ = len(strings.Split(s, ",")) - 2
+	_ = len(strings.SplitN(s, ",", 2)) - 1
+
+	parts := strings.Split(s, ",")
+	_ = len(parts) - 1
+	_ = parts
+}
@@ -17,6 +17,14 @@ func Warn(s, delim string, b, bDelim []byte) {
 	_ = sOut
 }
 
+func WarnExpr(s, delim string) string {
+	println(strings.Split(s, delim)[0])     // want `strings.Split allocates a slice of all fields, use before, _, _ := strings.Cut(s, delim) to get the first one`
+	if strings.Split(s, ":")[0] == "http" { // want `use before, _, _ := strings.Cut(s, ":") to get the first one`
+		return ""
+	}
+	return strings.Split(s, delim)[0] // want `use before, _, _ := strings.Cut(s, delim) to get the first one`
+}
+
 func Ignore(s, delim string, b, bDelim []byte) {
 	var sOut string
 	var bOut []byte
@@ -35,3 +43,13 @@ func Ignore(s, delim string, b, bDelim []byte) {
 	_ = sOut
 	_ = bOut
 }
+
+func IgnoreMultiUse(s, delim string) (string, string) {
+	// The fields slice is used more than once.
+	parts := strings.Split(s, delim)
+	return parts[0], parts[len(parts)-1]
+}
+
+func IgnoreOtherIndex(s, delim string) string {
+	return strings.Split(s, delim)[1]
+}
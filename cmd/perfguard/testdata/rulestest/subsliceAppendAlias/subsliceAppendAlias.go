@@ -0,0 +1,45 @@
+package rulestest
+
+func Warn(src []int, k int) {
+	_ = append(src[:k], src...)  // want `append to a subslice of src overwrites the src elements, copy the subslice before appending`
+	_ = append(src[1:k], src...) // want `append to a subslice of src overwrites the src elements, copy the subslice before appending`
+
+	{
+		dst := src[:k] // want `dst is a subslice of src, a later append(dst, src...) overwrites the src elements`
+		dst = append(dst, src...)
+		println(dst)
+	}
+
+	{
+		dst := src[1:k] // want `dst is a subslice of src, a later append(dst, src...) overwrites the src elements`
+		println(len(dst))
+		dst = append(dst, src...)
+		println(dst)
+	}
+
+	{
+		dst := src[:k] // want `dst is a subslice of src, a later append(dst, src...) overwrites the src elements`
+		result := append(dst, src...)
+		println(result)
+	}
+}
+
+func Ignore(src, other []int, k int) {
+	_ = append(other[:k], src...)
+	_ = append(src[:k], other...)
+	_ = append(src[:k:k], src...)
+	_ = append(src, src...)
+
+	{
+		dst := other[:k]
+		dst = append(dst, src...)
+		println(dst)
+	}
+
+	{
+		dst := make([]int, k, k+len(src))
+		copy(dst, src[:k])
+		dst = append(dst, src...)
+		println(dst)
+	}
+}
@@ -0,0 +1,36 @@
+package rulestest
+
+import (
+	"time"
+)
+
+type clock struct{}
+
+func (clock) Now() clock                { return clock{} }
+func (clock) Sub(c clock) time.Duration { return 0 }
+
+type session struct {
+	started time.Time
+}
+
+func Warn(start, deadline time.Time, s *session) {
+	_ = time.Now().Sub(start)     // want `time.Now().Sub(start) => time.Since(start)`
+	_ = deadline.Sub(time.Now())  // want `deadline.Sub(time.Now()) => time.Until(deadline)`
+	_ = time.Now().Sub(s.started) // want `time.Now().Sub(s.started) => time.Since(s.started)`
+	_ = s.started.Sub(time.Now()) // want `s.started.Sub(time.Now()) => time.Until(s.started)`
+
+	now := time.Now()
+	_ = time.Now().Sub(now) // want `time.Now().Sub(now) => time.Since(now)`
+}
+
+func Ignore(start time.Time, c clock) {
+	now := time.Now()
+	_ = now.Sub(start)
+	_ = start.Sub(now)
+
+	_ = c.Now().Sub(c)
+	_ = c.Sub(c.Now())
+
+	_ = time.Since(start)
+	_ = time.Until(start)
+}
@@ -0,0 +1,62 @@
+package rulestest
+
+import (
+	"time"
+)
+
+func Warn() {
+	{
+		start := time.Now().UnixNano() // want `start is used to measure the elapsed time, use time.Since(start) with start := time.Now() instead`
+		work()
+		elapsed := time.Now().UnixNano() - start
+		println(elapsed)
+	}
+
+	{
+		var elapsed int64
+		begin := time.Now().UnixMilli() // want `begin is used to measure the elapsed time, use time.Since(begin) with begin := time.Now() instead`
+		work()
+		work()
+		elapsed = time.Now().UnixMilli() - begin
+		println(elapsed)
+	}
+
+	{
+		start := time.Now().Unix() // want `start is used to measure the elapsed time, use time.Since(start) with start := time.Now() instead`
+		elapsed := time.Now().Unix() - start
+		println(elapsed)
+	}
+}
+
+func Ignore(deadline int64, t time.Time) {
+	{
+		// A timestamp that comes from elsewhere.
+		left := deadline - time.Now().UnixNano()
+		println(left)
+		elapsed := time.Now().UnixNano() - t.UnixNano()
+		println(elapsed)
+	}
+
+	{
+		// Different units.
+		start := time.Now().UnixNano()
+		elapsed := time.Now().Unix() - start
+		println(elapsed)
+	}
+
+	{
+		// Not an elapsed time measurement.
+		ts := time.Now().UnixNano()
+		other := time.Now().UnixNano()
+		println(ts, other)
+	}
+
+	{
+		start := time.Now()
+		work()
+		elapsed := time.Since(start)
+		println(elapsed)
+	}
+}
+
+func work() {}
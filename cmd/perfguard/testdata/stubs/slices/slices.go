@@ -0,0 +1,16 @@
+// Package slices mimics the slices.Contains and slices.SortFunc functions
+// for the Go versions without generics.
+package slices
+
+func Contains(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// SortFunc mimics the slices.SortFunc signature shape,
+// the comparator is not type-checked against the slice elements.
+func SortFunc(s interface{}, cmp interface{}) {}
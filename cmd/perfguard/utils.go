@@ -1,10 +1,62 @@
 package main
 
 import (
+	"fmt"
 	"go/ast"
+	"go/build"
+	"go/token"
+	"os"
+	"path/filepath"
 	"strings"
+
+	"github.com/quasilyte/stdinfo"
 )
 
+// stdlibPackages is stdinfo.PathByName extended with the
+// packages that were added to the stdlib after Go 1.18.
+var stdlibPackages = func() map[string]string {
+	m := make(map[string]string, len(stdinfo.PathByName)+3)
+	for name, path := range stdinfo.PathByName {
+		m[name] = path
+	}
+	for _, path := range []string{"cmp", "maps", "slices"} {
+		if _, ok := m[path]; !ok {
+			m[path] = path
+		}
+	}
+	return m
+}()
+
+// cyclomaticComplexity returns the fn cyclomatic complexity:
+// 1 plus the number of the branch points.
+// The function literals are counted as a part of the enclosing function.
+func cyclomaticComplexity(fn *ast.FuncDecl) int {
+	complexity := 1
+	if fn.Body == nil {
+		return complexity
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			complexity++
+		case *ast.CaseClause:
+			if n.List != nil {
+				complexity++ // Not a default case
+			}
+		case *ast.CommClause:
+			if n.Comm != nil {
+				complexity++ // Not a default case
+			}
+		case *ast.BinaryExpr:
+			if n.Op == token.LAND || n.Op == token.LOR {
+				complexity++
+			}
+		}
+		return true
+	})
+	return complexity
+}
+
 func isAutogenFile(f *ast.File) bool {
 	for _, comment := range f.Comments {
 		if isAutogenComment(comment) {
@@ -33,3 +85,87 @@ func isAutogenComment(comment *ast.CommentGroup) bool {
 	}
 	return false
 }
+
+// parseNameSet converts a comma-separated list into a set.
+// Empty list elements are ignored.
+func parseNameSet(s string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		set[name] = struct{}{}
+	}
+	return set
+}
+
+// parseImpacts parses a comma-separated list of the rule impacts.
+// The "allocation" is accepted as an alias for "alloc".
+func parseImpacts(s string) (map[string]struct{}, error) {
+	impacts := make(map[string]struct{})
+	for impact := range parseNameSet(s) {
+		switch impact {
+		case "alloc", "allocation":
+			impacts["alloc"] = struct{}{}
+		case "cpu", "readability":
+			impacts[impact] = struct{}{}
+		default:
+			return nil, fmt.Errorf("unknown impact: %q", impact)
+		}
+	}
+	return impacts, nil
+}
+
+// matchesFileGlob reports whether the filename or any of its
+// parent directories matches the pattern.
+// A pattern without path separators is matched against
+// every filename path element instead, so `*_gen.go` and `vendor`
+// work at any depth.
+func matchesFileGlob(pattern, filename string) bool {
+	if !strings.ContainsRune(pattern, filepath.Separator) {
+		for _, part := range strings.Split(filename, string(filepath.Separator)) {
+			if ok, _ := filepath.Match(pattern, part); ok {
+				return true
+			}
+		}
+		return false
+	}
+	for p := filename; p != "." && p != string(filepath.Separator); p = filepath.Dir(p) {
+		if ok, _ := filepath.Match(pattern, p); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// goModCacheDir returns the module cache location, like `go env GOMODCACHE` does.
+func goModCacheDir() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+	gopath := filepath.SplitList(build.Default.GOPATH)
+	if len(gopath) == 0 || gopath[0] == "" {
+		return ""
+	}
+	return filepath.Join(gopath[0], "pkg", "mod")
+}
+
+// isThirdPartyFile reports whether filename is located
+// inside a vendor directory or inside the module cache.
+// Only the path part below wd is checked for vendor directories,
+// so a project that is located inside some vendor dir is not affected.
+func isThirdPartyFile(filename, wd, modCacheDir string) bool {
+	if modCacheDir != "" && strings.HasPrefix(filename, modCacheDir+string(filepath.Separator)) {
+		return true
+	}
+	if rel, err := filepath.Rel(wd, filename); err == nil && !strings.HasPrefix(rel, "..") {
+		filename = rel
+	}
+	for _, part := range strings.Split(filepath.ToSlash(filepath.Dir(filename)), "/") {
+		if part == "vendor" {
+			return true
+		}
+	}
+	return false
+}
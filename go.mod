@@ -12,6 +12,7 @@ require (
 	github.com/google/pprof v0.0.0-20211214055906-6f57359322fd
 	github.com/quasilyte/go-ruleguard v0.3.16-0.20220322175800-003e476add13
 	github.com/quasilyte/go-ruleguard/dsl v0.3.19
+	github.com/quasilyte/gogrep v0.0.0-20220320171548-f7f5e21cda54
 	github.com/quasilyte/perf-heatmap v0.0.0-20220127163051-47fae5341e3f
 	github.com/quasilyte/stdinfo v0.0.0-20220114132959-f7386bf02567
 	golang.org/x/tools v0.1.10
@@ -19,7 +20,6 @@ require (
 
 require (
 	github.com/quasilyte/go-ruleguard/rules v0.0.0-20220322175800-003e476add13 // indirect
-	github.com/quasilyte/gogrep v0.0.0-20220320171548-f7f5e21cda54 // indirect
 	github.com/quasilyte/pprofutil v0.0.0-20220125111125-7b67e07006e9 // indirect
 	golang.org/x/mod v0.6.0-dev.0.20220106191415-9b9b3d81d5e3 // indirect
 	golang.org/x/sys v0.0.0-20211019181941-9d821ace8654 // indirect
@@ -0,0 +1,167 @@
+package gitdiff
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Changes maps an absolute filename to its changed lines.
+type Changes map[string]*FileChanges
+
+// FileChanges describes the changed lines of a single file.
+type FileChanges struct {
+	// New is set for the files that don't exist in the base revision.
+	// All lines of a new file are changed.
+	New bool
+
+	// Lines is a set of the added or modified line numbers.
+	Lines map[int]struct{}
+}
+
+// IsChanged reports whether the file line is changed.
+func (c Changes) IsChanged(filename string, line int) bool {
+	f := c[filename]
+	if f == nil {
+		return false
+	}
+	if f.New {
+		return true
+	}
+	_, ok := f.Lines[line]
+	return ok
+}
+
+// ChangedLines returns the lines that are changed since the rev revision
+// of the git repository that contains dir.
+//
+// Both committed and uncommitted changes are included,
+// the untracked files are treated as new files.
+func ChangedLines(dir, rev string) (Changes, error) {
+	// The repository root is derived from dir instead of using
+	// the --show-toplevel path as the latter resolves the symlinks
+	// and the filenames would not match the loaded packages files.
+	prefix, err := runGit(dir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return nil, err
+	}
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, part := range strings.Split(strings.TrimSpace(string(prefix)), "/") {
+		if part != "" {
+			root = filepath.Dir(root)
+		}
+	}
+
+	diff, err := runGit(dir, "diff", "--no-ext-diff", "--no-color", "--unified=0",
+		"--src-prefix=a/", "--dst-prefix=b/", rev, "--")
+	if err != nil {
+		return nil, err
+	}
+	changes, err := Parse(root, diff)
+	if err != nil {
+		return nil, err
+	}
+
+	untracked, err := runGit(root, "ls-files", "--others", "--exclude-standard", "--full-name")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range strings.Split(string(untracked), "\n") {
+		if name == "" {
+			continue
+		}
+		changes[filepath.Join(root, filepath.FromSlash(name))] = &FileChanges{New: true}
+	}
+
+	return changes, nil
+}
+
+// Parse collects the changed lines from a unified diff.
+// The diff should have a/ and b/ prefixes and no context lines.
+// The filenames are resolved relative to the root dir.
+func Parse(root string, diff []byte) (Changes, error) {
+	changes := make(Changes)
+
+	var current *FileChanges
+	fromNull := false
+	for _, line := range strings.Split(string(diff), "\n") {
+		switch {
+		case strings.HasPrefix(line, "--- "):
+			fromNull = line == "--- /dev/null"
+
+		case strings.HasPrefix(line, "+++ "):
+			current = nil
+			name := strings.TrimPrefix(line, "+++ ")
+			if name == "/dev/null" {
+				continue // A deleted file
+			}
+			if strings.HasPrefix(name, `"`) {
+				unquoted, err := strconv.Unquote(name)
+				if err != nil {
+					return nil, fmt.Errorf("parse %s filename: %w", name, err)
+				}
+				name = unquoted
+			}
+			name = strings.TrimPrefix(name, "b/")
+			current = &FileChanges{
+				New:   fromNull,
+				Lines: make(map[int]struct{}),
+			}
+			changes[filepath.Join(root, filepath.FromSlash(name))] = current
+
+		case strings.HasPrefix(line, "@@ "):
+			if current == nil {
+				continue
+			}
+			start, count, err := parseHunkHeader(line)
+			if err != nil {
+				return nil, err
+			}
+			for i := 0; i < count; i++ {
+				current.Lines[start+i] = struct{}{}
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// parseHunkHeader returns the new file range of a `@@ -a,b +c,d @@` hunk header.
+func parseHunkHeader(line string) (start, count int, err error) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 || !strings.HasPrefix(fields[2], "+") {
+		return 0, 0, fmt.Errorf("unexpected hunk header: %q", line)
+	}
+	newRange := strings.TrimPrefix(fields[2], "+")
+	count = 1
+	if comma := strings.IndexByte(newRange, ','); comma != -1 {
+		count, err = strconv.Atoi(newRange[comma+1:])
+		if err != nil {
+			return 0, 0, fmt.Errorf("unexpected hunk header: %q", line)
+		}
+		newRange = newRange[:comma]
+	}
+	start, err = strconv.Atoi(newRange)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unexpected hunk header: %q", line)
+	}
+	return start, count, nil
+}
+
+func runGit(dir string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
@@ -0,0 +1,71 @@
+package gitdiff
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	diff := `diff --git a/x.go b/x.go
+index 1111111..2222222 100644
+--- a/x.go
++++ b/x.go
+@@ -3 +3 @@ func f() {
+-	a()
++	b()
+@@ -10,0 +11,2 @@ func g() {
++	c()
++	d()
+@@ -20,2 +21,0 @@ func h() {
+-	e()
+-	f()
+diff --git a/dir/new.go b/dir/new.go
+new file mode 100644
+index 0000000..3333333
+--- /dev/null
++++ b/dir/new.go
+@@ -0,0 +1,3 @@
++package dir
++
++func f() {}
+diff --git a/old.go b/old.go
+deleted file mode 100644
+index 4444444..0000000
+--- a/old.go
++++ /dev/null
+@@ -1 +0,0 @@
+-package old
+`
+
+	root := filepath.FromSlash("/repo")
+	changes, err := Parse(root, []byte(diff))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		filename string
+		line     int
+		want     bool
+	}{
+		{"x.go", 2, false},
+		{"x.go", 3, true},
+		{"x.go", 4, false},
+		{"x.go", 10, false},
+		{"x.go", 11, true},
+		{"x.go", 12, true},
+		{"x.go", 13, false},
+		{"x.go", 21, false},
+		{"dir/new.go", 1, true},
+		{"dir/new.go", 100, true},
+		{"old.go", 1, false},
+		{"other.go", 1, false},
+	}
+	for _, test := range tests {
+		filename := filepath.Join(root, filepath.FromSlash(test.filename))
+		have := changes.IsChanged(filename, test.line)
+		if have != test.want {
+			t.Errorf("IsChanged(%s, %d): have %v, want %v", test.filename, test.line, have, test.want)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package udiff
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// contextLines is a number of unchanged lines printed around every change.
+const contextLines = 3
+
+type opKind byte
+
+const (
+	opEqual  opKind = ' '
+	opDelete opKind = '-'
+	opInsert opKind = '+'
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+// Unified returns a unified diff between old and new texts.
+//
+// It's formatted like the `diff -u` output with oldName and newName
+// used as the file headers, so the result can be consumed by
+// the `patch` and `git apply` tools.
+// If texts are identical, nil is returned.
+func Unified(oldName, newName string, old, new []byte) []byte {
+	if bytes.Equal(old, new) {
+		return nil
+	}
+
+	ops := diffLines(splitLines(old), splitLines(new))
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s\n", oldName)
+	fmt.Fprintf(&buf, "+++ %s\n", newName)
+
+	i := 0
+	oldLine := 0
+	newLine := 0
+	for i < len(ops) {
+		// Skip the unchanged lines until the next change.
+		if ops[i].kind == opEqual {
+			i++
+			oldLine++
+			newLine++
+			continue
+		}
+
+		// A hunk starts with a context before the first change.
+		start := i - contextLines
+		if start < 0 {
+			start = 0
+		}
+		oldStart := oldLine - (i - start)
+		newStart := newLine - (i - start)
+
+		// Extend the hunk while the changes are close enough
+		// to share their context lines.
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != opEqual {
+				end++
+				continue
+			}
+			numEqual := 0
+			for end+numEqual < len(ops) && ops[end+numEqual].kind == opEqual {
+				numEqual++
+			}
+			if end+numEqual == len(ops) || numEqual > 2*contextLines {
+				if numEqual > contextLines {
+					numEqual = contextLines
+				}
+				end += numEqual
+				break
+			}
+			end += numEqual
+		}
+
+		oldCount := 0
+		newCount := 0
+		for _, o := range ops[start:end] {
+			if o.kind != opInsert {
+				oldCount++
+			}
+			if o.kind != opDelete {
+				newCount++
+			}
+		}
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n",
+			hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+		for _, o := range ops[start:end] {
+			buf.WriteByte(byte(o.kind))
+			buf.WriteString(o.line)
+			if o.line == "" || o.line[len(o.line)-1] != '\n' {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		for _, o := range ops[i:end] {
+			if o.kind != opInsert {
+				oldLine++
+			}
+			if o.kind != opDelete {
+				newLine++
+			}
+		}
+		i = end
+	}
+
+	return buf.Bytes()
+}
+
+// hunkRange formats a 0-based line offset and a lines count
+// as a hunk header range.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		// An empty range refers to the line before it.
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// splitLines splits the text into lines, they keep the trailing newline.
+func splitLines(text []byte) []string {
+	var lines []string
+	for len(text) != 0 {
+		i := bytes.IndexByte(text, '\n')
+		if i == -1 {
+			lines = append(lines, string(text))
+			break
+		}
+		lines = append(lines, string(text[:i+1]))
+		text = text[i+1:]
+	}
+	return lines
+}
+
+// diffLines computes the shortest edit script that turns a into b.
+func diffLines(a, b []string) []op {
+	// The common prefix and suffix are trimmed first, the quick fixes
+	// usually touch only a few lines and it keeps the search space small.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, op{kind: opEqual, line: line})
+	}
+	ops = append(ops, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, op{kind: opEqual, line: line})
+	}
+	return ops
+}
+
+// myers implements the "An O(ND) Difference Algorithm and Its Variations"
+// paper algorithm by Eugene W. Myers.
+func myers(a, b []string) []op {
+	n := len(a)
+	m := len(b)
+	maxSteps := n + m
+	offset := maxSteps + 1
+	v := make([]int, 2*maxSteps+3)
+
+	// trace[d] is a state of v before the step d.
+	var trace [][]int
+
+stepsLoop:
+	for d := 0; d <= maxSteps; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break stepsLoop
+			}
+		}
+	}
+
+	// Walk the trace backwards to recover the edit script.
+	var ops []op
+	x := n
+	y := m
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			ops = append(ops, op{kind: opEqual, line: a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			ops = append(ops, op{kind: opInsert, line: b[y-1]})
+			y--
+		} else {
+			ops = append(ops, op{kind: opDelete, line: a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		ops = append(ops, op{kind: opEqual, line: a[x-1]})
+		x--
+		y--
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
@@ -0,0 +1,131 @@
+package udiff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		old  string
+		new  string
+		want string
+	}{
+		{
+			old:  "a\nb\nc\n",
+			new:  "a\nb\nc\n",
+			want: "",
+		},
+
+		{
+			old: "a\nb\nc\n",
+			new: "a\nx\nc\n",
+			want: `--- a/f.go
++++ b/f.go
+@@ -1,3 +1,3 @@
+ a
+-b
++x
+ c
+`,
+		},
+
+		{
+			old: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			new: "1\n2\nx\n4\n5\n6\n7\n8\n9\n10\n11\ny\n",
+			want: `--- a/f.go
++++ b/f.go
+@@ -1,6 +1,6 @@
+ 1
+ 2
+-3
++x
+ 4
+ 5
+ 6
+@@ -9,4 +9,4 @@
+ 9
+ 10
+ 11
+-12
++y
+`,
+		},
+
+		{
+			old: "1\n2\n3\n4\n5\n6\n7\n8\n",
+			new: "1\nx\n3\n4\n5\n6\n7\ny\n",
+			want: `--- a/f.go
++++ b/f.go
+@@ -1,8 +1,8 @@
+ 1
+-2
++x
+ 3
+ 4
+ 5
+ 6
+ 7
+-8
++y
+`,
+		},
+
+		{
+			old: "a\nb\n",
+			new: "a\nb\nc\nd\n",
+			want: `--- a/f.go
++++ b/f.go
+@@ -1,2 +1,4 @@
+ a
+ b
++c
++d
+`,
+		},
+
+		{
+			old: "a\nb\nc\n",
+			new: "c\n",
+			want: `--- a/f.go
++++ b/f.go
+@@ -1,3 +1 @@
+-a
+-b
+ c
+`,
+		},
+
+		{
+			old: "",
+			new: "a\n",
+			want: `--- a/f.go
++++ b/f.go
+@@ -0,0 +1 @@
++a
+`,
+		},
+
+		{
+			old: "a\nb",
+			new: "a\nc",
+			want: `--- a/f.go
++++ b/f.go
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++c
+\ No newline at end of file
+`,
+		},
+	}
+
+	for _, test := range tests {
+		have := string(Unified("a/f.go", "b/f.go", []byte(test.old), []byte(test.new)))
+		if have != test.want {
+			t.Errorf("diff %q %q:\nhave:\n%s\nwant:\n%s",
+				test.old, test.new, have, strings.TrimSpace(test.want))
+		}
+	}
+}
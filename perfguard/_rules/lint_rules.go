@@ -0,0 +1,375 @@
+package gorules
+
+import (
+	"github.com/quasilyte/go-ruleguard/dsl"
+)
+
+// Lint rules are only executed in `lint` mode.
+//
+// These rules are not about performance, so they don't need
+// o1 or o2 tags: there is no profile to filter the matches with.
+// The scoring system works the same way as in universal rules.
+
+//doc:summary Detects len comparisons that can be written as a sign check
+//doc:tags    score1
+//doc:impact  readability
+//doc:before  len(s) >= 1
+//doc:after   len(s) > 0
+func lenSignCheck(m dsl.Matcher) {
+	m.Match(`len($x) >= 1`, `1 <= len($x)`).Suggest(`len($x) > 0`)
+	m.Match(`len($x) < 1`, `1 > len($x)`).Suggest(`len($x) == 0`)
+
+	// len() is never negative, these are likely to be bugs.
+	m.Match(`len($x) > -1`, `len($x) >= 0`, `-1 < len($x)`, `0 <= len($x)`).
+		Report(`len($x) is never negative, the condition is always true`)
+	m.Match(`len($x) < 0`, `0 > len($x)`).
+		Report(`len($x) is never negative, the condition is always false`)
+}
+
+//doc:summary Detects slices.SortFunc comparators that can use cmp.Compare
+//doc:tags    score2
+//doc:impact  readability
+//doc:before  slices.SortFunc(xs, func(a, b T) int { if a.X < b.X { return -1 } else if a.X > b.X { return 1 }; return 0 })
+//doc:after   slices.SortFunc(xs, func(a, b T) int { return cmp.Compare(a.X, b.X) })
+//doc:note    float types are not reported as cmp.Compare orders NaN values differently
+func sortFuncCmpCompare(m dsl.Matcher) {
+	isOrdered := func(v dsl.Var) bool {
+		return v.Type.OfKind("integer") || v.Type.Underlying().Is(`string`)
+	}
+
+	m.Match(
+		`slices.SortFunc($s, func($*params) int { if $x < $y { return -1 } else if $x > $y { return 1 }; return 0 })`,
+		`slices.SortFunc($s, func($*params) int { if $x < $y { return -1 } else if $x > $y { return 1 } else { return 0 } })`,
+		`slices.SortFunc($s, func($*params) int { if $x < $y { return -1 }; if $x > $y { return 1 }; return 0 })`,
+	).
+		Where(m.GoVersion().GreaterEqThan("1.21") &&
+			isOrdered(m["x"]) && m["x"].Type.IdenticalTo(m["y"])).
+		Report(`use cmp.Compare($x, $y) in the comparator`).
+		Suggest(`slices.SortFunc($s, func$params int { return cmp.Compare($x, $y) })`)
+
+	m.Match(
+		`slices.SortFunc($s, func($*params) int { if $x > $y { return -1 } else if $x < $y { return 1 }; return 0 })`,
+		`slices.SortFunc($s, func($*params) int { if $x > $y { return -1 } else if $x < $y { return 1 } else { return 0 } })`,
+		`slices.SortFunc($s, func($*params) int { if $x > $y { return -1 }; if $x < $y { return 1 }; return 0 })`,
+	).
+		Where(m.GoVersion().GreaterEqThan("1.21") &&
+			isOrdered(m["x"]) && m["x"].Type.IdenticalTo(m["y"])).
+		Report(`use cmp.Compare($y, $x) in the comparator`).
+		Suggest(`slices.SortFunc($s, func$params int { return cmp.Compare($y, $x) })`)
+}
+
+//doc:summary Detects appends of a slice to its own subslice
+//doc:tags    score3
+//doc:before  dst := src[:k]; dst = append(dst, src...)
+//doc:after   dst := make([]T, k, k+len(src)); copy(dst, src[:k]); dst = append(dst, src...)
+//doc:note    there is no autofix: the proper solution depends on the intended semantics
+func subsliceAppendAlias(m dsl.Matcher) {
+	// A subslice shares the backing array with the original slice.
+	// When there is enough capacity, append writes into that array,
+	// so the src elements after k are overwritten.
+	m.Match(`append($src[:$_], $src...)`, `append($src[$_:$_], $src...)`).
+		Report(`append to a subslice of $src overwrites the $src elements, copy the subslice before appending`)
+
+	m.Match(
+		`$dst := $src[:$_]; $*_; $dst = append($dst, $src...)`,
+		`$dst := $src[$_:$_]; $*_; $dst = append($dst, $src...)`,
+		`$dst := $src[:$_]; $*_; $_ := append($dst, $src...)`,
+		`$dst := $src[$_:$_]; $*_; $_ := append($dst, $src...)`,
+	).
+		Report(`$dst is a subslice of $src, a later append($dst, $src...) overwrites the $src elements`)
+}
+
+//doc:summary Detects elapsed time measurements that use Unix timestamps
+//doc:tags    score2
+//doc:before  start := time.Now().UnixNano(); f(); elapsed := time.Now().UnixNano() - start
+//doc:after   start := time.Now(); f(); elapsed := time.Since(start)
+//doc:note    there is no autofix: the start var type changes from int64 to time.Time
+func unixNanoElapsed(m dsl.Matcher) {
+	// Unix timestamps are based on the wall clock that can jump,
+	// time.Since uses the monotonic clock reading instead.
+	//
+	// The start timestamp must be created in the same block:
+	// timestamps that come from somewhere else may be
+	// real points in time, not a measurement start.
+	m.Match(
+		`$start := time.Now().$method(); $*_; $_ := time.Now().$method() - $start`,
+		`$start := time.Now().$method(); $*_; $_ = time.Now().$method() - $start`,
+	).
+		Where(m["method"].Text.Matches(`^Unix(Nano|Micro|Milli)?$`)).
+		Report(`$start is used to measure the elapsed time, use time.Since($start) with $start := time.Now() instead`)
+}
+
+//doc:summary Detects range loops with a redundant blank value variable
+//doc:tags    score1
+//doc:impact  readability
+//doc:before  for i, _ := range xs {}
+//doc:after   for i := range xs {}
+//doc:note    named value vars that are never used are already rejected by the compiler
+func rangeValueUnused(m dsl.Matcher) {
+	// The compiler doesn't copy the elements for the blank value,
+	// but the next edit that gives it a name will do that.
+	// For big elements it's better to index the slice anyway.
+	m.Match(`for $i, _ := range $xs { $*body }`).
+		Where(m["i"].Text != `_`).
+		Report(`for $i, _ := range $xs => for $i := range $xs, the value is unused (a value var would copy every element)`).
+		Suggest(`for $i := range $xs { $body }`)
+	m.Match(`for $i, _ = range $xs { $*body }`).
+		Where(m["i"].Text != `_`).
+		Report(`for $i, _ = range $xs => for $i = range $xs, the value is unused (a value var would copy every element)`).
+		Suggest(`for $i = range $xs { $body }`)
+	m.Match(`for _, _ = range $xs { $*body }`, `for _ = range $xs { $*body }`).
+		Report(`use for range $xs, the range vars are unused`).
+		Suggest(`for range $xs { $body }`)
+}
+
+//doc:summary Detects deferred calls with arguments that call other functions
+//doc:tags    score1
+//doc:before  defer log.Printf("%v", expensiveToString())
+//doc:after   defer func() { log.Printf("%v", expensiveToString()) }()
+//doc:note    there is no autofix: wrapping the call into a closure changes the semantics
+func deferEagerArgs(m dsl.Matcher) {
+	// The deferred call itself runs on return, but its arguments
+	// are evaluated at the defer statement, even if the function
+	// returns early and the result could be computed later.
+	//
+	// Only the arguments are inspected: the callee expression
+	// is usually a cheap selector or a func value.
+	// Function literal args are skipped as their body is not executed
+	// at the defer statement. time.Now() is a common measurement
+	// start idiom that is evaluated eagerly on purpose.
+	// Simple len and cap calls are cheap enough to be ignored.
+	//
+	// Every arg position is a separate pattern: Where() is applied
+	// to the first $arg binding only, so `$*_, $arg, $*_` is not enough.
+	m.Match(
+		`defer $f($arg, $*_)`,
+		`defer $f($_, $arg, $*_)`,
+		`defer $f($_, $_, $arg, $*_)`,
+		`defer $f($_, $_, $_, $arg, $*_)`,
+	).
+		Where(m["arg"].Contains(`$_($*_)`) &&
+			!m["arg"].Node.Is(`FuncLit`) &&
+			!m["arg"].Text.Matches(`^time\.Now\(\)$`) &&
+			!m["arg"].Text.Matches(`^(len|cap)\([^()]*\)$`)).
+		Report(`$arg is evaluated at the defer statement, not when $f is called`).
+		At(m["arg"])
+}
+
+//doc:summary Detects comparisons with a constant that is converted to the other operand type
+//doc:tags    score1
+//doc:impact  readability
+//doc:before  d == time.Duration(0)
+//doc:after   d == 0
+//doc:note    only literal constants are matched as typed constants don't convert implicitly
+func redundantConstConv(m dsl.Matcher) {
+	// The conversion wraps a literal that is implicitly
+	// converted to the other operand type without it.
+	// string(65) is not "A" after the conversion removal,
+	// so strings need a string literal.
+	isImplicitConv := func(x, t, c dsl.Var) bool {
+		return t.Object.Is(`TypeName`) && x.Type.IdenticalTo(t) && c.Const &&
+			((x.Type.Underlying().OfKind(`numeric`) && c.Text.Matches(`^-?[0-9.]`)) ||
+				(x.Type.Underlying().Is(`string`) && c.Text.Matches("^[\"`]")))
+	}
+
+	m.Match(`$x == $t($c)`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$x == $c`)
+	m.Match(`$t($c) == $x`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$c == $x`)
+	m.Match(`$x != $t($c)`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$x != $c`)
+	m.Match(`$t($c) != $x`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$c != $x`)
+	m.Match(`$x < $t($c)`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$x < $c`)
+	m.Match(`$t($c) < $x`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$c < $x`)
+	m.Match(`$x <= $t($c)`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$x <= $c`)
+	m.Match(`$t($c) <= $x`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$c <= $x`)
+	m.Match(`$x > $t($c)`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$x > $c`)
+	m.Match(`$t($c) > $x`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$c > $x`)
+	m.Match(`$x >= $t($c)`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$x >= $c`)
+	m.Match(`$t($c) >= $x`).Where(isImplicitConv(m["x"], m["t"], m["c"])).Suggest(`$c >= $x`)
+}
+
+//doc:summary Detects constant durations that are converted to time.Duration before a multiplication
+//doc:tags    score1
+//doc:impact  readability
+//doc:before  time.Duration(5) * time.Second
+//doc:after   5 * time.Second
+//doc:note    only literal constants are matched as typed constants don't convert implicitly
+func durationLiteral(m dsl.Matcher) {
+	// An untyped constant is converted to time.Duration implicitly
+	// when it's multiplied by a duration.
+	// The variables need the conversion, they're not reported.
+	isDurationLiteral := func(n, d dsl.Var) bool {
+		return n.Const && n.Text.Matches(`^-?[0-9]`) && d.Type.Is(`time.Duration`)
+	}
+
+	m.Match(`time.Duration($n) * $d`).
+		Where(isDurationLiteral(m["n"], m["d"])).
+		Suggest(`$n * $d`)
+	m.Match(`$d * time.Duration($n)`).
+		Where(isDurationLiteral(m["n"], m["d"])).
+		Suggest(`$d * $n`)
+}
+
+//doc:summary Detects appends to a slice that was made with a non-zero length
+//doc:tags    score3
+//doc:before  s := make([]T, n); s = append(s, x)
+//doc:after   s := make([]T, 0, n); s = append(s, x)
+//doc:note    there is no autofix: a make length may be intended if the slice is indexed later
+func makeLenAppend(m dsl.Matcher) {
+	// make([]T, n) creates n zero elements, so the append adds
+	// the new elements after them. It's a common length and capacity mix-up.
+	//
+	// Only the appends that follow the make immediately are matched:
+	// either the next statement or the next range loop that
+	// appends to the slice. Anything in between could use
+	// the zero elements, like s[i] = x does.
+	// A constant 0 length is fine.
+	m.Match(
+		`$s := make($_, $n); $s = append($s, $*_)`,
+		`$s := make($_, $n, $_); $s = append($s, $*_)`,
+		`$s = make($_, $n); $s = append($s, $*_)`,
+		`$s = make($_, $n, $_); $s = append($s, $*_)`,
+		`$s := make($_, $n); for $_, $_ := range $_ { $*_; $s = append($s, $*_); $*_ }`,
+		`$s := make($_, $n, $_); for $_, $_ := range $_ { $*_; $s = append($s, $*_); $*_ }`,
+		`$s := make($_, $n); for $_ := range $_ { $*_; $s = append($s, $*_); $*_ }`,
+		`$s := make($_, $n, $_); for $_ := range $_ { $*_; $s = append($s, $*_); $*_ }`,
+	).
+		Where(!(m["n"].Const && m["n"].Value.Int() == 0)).
+		Report(`append adds elements after the $n zero values of $s, use make with a 0 length and a $n capacity instead`)
+}
+
+//doc:summary Detects channel operations that are guarded by a len(ch) check
+//doc:tags    score3 concurrency
+//doc:before  for len(ch) > 0 { <-ch }
+//doc:after   for { select { case <-ch: default: return } }
+//doc:note    a single receiver can rely on len(ch), it's reported anyway as the invariant is fragile
+func chanLenCheck(m dsl.Matcher) {
+	// The channel length can change between the check and the operation:
+	// another receiver can drain the channel, so <-ch blocks;
+	// another sender can fill it, so ch <- x blocks.
+	// A select with a default case performs the check and the operation atomically.
+	//
+	// The operation should be a statement inside the if or for body
+	// that is guarded by the len(ch) condition.
+	// $ch is a channel of any direction.
+	isChan := func(v dsl.Var) bool {
+		return v.Type.Underlying().Is(`chan $_`) ||
+			v.Type.Underlying().Is(`<-chan $_`) ||
+			v.Type.Underlying().Is(`chan<- $_`)
+	}
+
+	m.Match(
+		`for len($ch) > 0 { $*_; <-$ch; $*_ }`,
+		`for len($ch) > 0 { $*_; $_ = <-$ch; $*_ }`,
+		`for len($ch) > 0 { $*_; $_ := <-$ch; $*_ }`,
+		`for len($ch) != 0 { $*_; <-$ch; $*_ }`,
+		`for len($ch) != 0 { $*_; $_ = <-$ch; $*_ }`,
+		`for len($ch) != 0 { $*_; $_ := <-$ch; $*_ }`,
+		`if len($ch) > 0 { $*_; <-$ch; $*_ }`,
+		`if len($ch) > 0 { $*_; $_ = <-$ch; $*_ }`,
+		`if len($ch) > 0 { $*_; $_ := <-$ch; $*_ }`,
+		`if len($ch) != 0 { $*_; <-$ch; $*_ }`,
+		`if len($ch) != 0 { $*_; $_ = <-$ch; $*_ }`,
+		`if len($ch) != 0 { $*_; $_ := <-$ch; $*_ }`,
+	).
+		Where(isChan(m["ch"])).
+		Report(`len($ch) can change before the receive, use a select with a default case instead`).
+		At(m["ch"])
+
+	m.Match(
+		`for len($ch) < cap($ch) { $*_; $ch <- $_; $*_ }`,
+		`if len($ch) < cap($ch) { $*_; $ch <- $_; $*_ }`,
+	).
+		Where(isChan(m["ch"])).
+		Report(`len($ch) can change before the send, use a select with a default case instead`).
+		At(m["ch"])
+}
+
+//doc:summary Detects type switches with a single case that can be a type assertion
+//doc:tags    score1
+//doc:impact  readability
+//doc:before  switch v := x.(type) { case T: use(v) }
+//doc:after   if v, ok := x.(T); ok { use(v) }
+//doc:note    there is no autofix: the case body is re-indented and may need an else branch
+func singleCaseTypeSwitch(m dsl.Matcher) {
+	// Only the switches with exactly one case clause that lists
+	// a single type are matched; an empty default clause is ignored.
+	// A non-empty default clause becomes an else branch.
+	// The default clause is expected to go after the case clause,
+	// the patterns don't match it otherwise.
+	//
+	// The binding var is bound to $v in the case body for both forms.
+	// In the default clause it has the $x value, but in the else branch
+	// it's a zero T value, so the else branch should use $x instead.
+	//
+	// case nil has no assertion form.
+	// A break inside the case body would exit the enclosing loop
+	// after the rewrite, so such bodies are not matched.
+	m.Match(
+		`switch $v := $x.(type) { case $t: $*body }`,
+		`switch $v := $x.(type) { case $t: $*body; default: }`,
+	).
+		Where(m["t"].Text != `nil` && !m["body"].Contains(`break`)).
+		Report(`use if $v, ok := $x.($t); ok { … } instead of a single-case type switch`)
+	m.Match(
+		`switch $x.(type) { case $t: $*body }`,
+		`switch $x.(type) { case $t: $*body; default: }`,
+	).
+		Where(m["t"].Text != `nil` && !m["body"].Contains(`break`)).
+		Report(`use if _, ok := $x.($t); ok { … } instead of a single-case type switch`)
+
+	m.Match(`switch $v := $x.(type) { case $t: $*body; default: $*_ }`).
+		Where(m["t"].Text != `nil` && !m["body"].Contains(`break`)).
+		Report(`use if $v, ok := $x.($t); ok { … } else { … } instead of a type switch with a single case and a default, use $x in the else branch`)
+	m.Match(`switch $x.(type) { case $t: $*body; default: $*_ }`).
+		Where(m["t"].Text != `nil` && !m["body"].Contains(`break`)).
+		Report(`use if _, ok := $x.($t); ok { … } else { … } instead of a type switch with a single case and a default`)
+}
+
+//doc:summary Detects redundant nil checks around range loops over slices and maps
+//doc:tags    score1
+//doc:impact  readability
+//doc:before  if xs != nil { for _, x := range xs { use(x) } }
+//doc:after   for _, x := range xs { use(x) }
+func nilCheckBeforeRange(m dsl.Matcher) {
+	// A range over a nil slice or map does zero iterations.
+	// A range over a nil channel blocks forever, so channels are not matched.
+	// The if body should consist of the range loop only.
+	isSliceOrMap := func(v dsl.Var) bool {
+		return v.Type.Underlying().Is(`[]$_`) || v.Type.Underlying().Is(`map[$_]$_`)
+	}
+
+	m.Match(
+		`if $s != nil { for $k, $v := range $s { $*body } }`,
+		`if nil != $s { for $k, $v := range $s { $*body } }`,
+	).
+		Where(m["s"].Pure && isSliceOrMap(m["s"])).
+		Report(`the $s != nil check is redundant, a range over a nil slice or map is a no-op`).
+		Suggest(`for $k, $v := range $s { $body }`)
+	m.Match(
+		`if $s != nil { for $k, $v = range $s { $*body } }`,
+		`if nil != $s { for $k, $v = range $s { $*body } }`,
+	).
+		Where(m["s"].Pure && isSliceOrMap(m["s"])).
+		Report(`the $s != nil check is redundant, a range over a nil slice or map is a no-op`).
+		Suggest(`for $k, $v = range $s { $body }`)
+	m.Match(
+		`if $s != nil { for $k := range $s { $*body } }`,
+		`if nil != $s { for $k := range $s { $*body } }`,
+	).
+		Where(m["s"].Pure && isSliceOrMap(m["s"])).
+		Report(`the $s != nil check is redundant, a range over a nil slice or map is a no-op`).
+		Suggest(`for $k := range $s { $body }`)
+	m.Match(
+		`if $s != nil { for $k = range $s { $*body } }`,
+		`if nil != $s { for $k = range $s { $*body } }`,
+	).
+		Where(m["s"].Pure && isSliceOrMap(m["s"])).
+		Report(`the $s != nil check is redundant, a range over a nil slice or map is a no-op`).
+		Suggest(`for $k = range $s { $body }`)
+	m.Match(
+		`if $s != nil { for range $s { $*body } }`,
+		`if nil != $s { for range $s { $*body } }`,
+	).
+		Where(m["s"].Pure && isSliceOrMap(m["s"])).
+		Report(`the $s != nil check is redundant, a range over a nil slice or map is a no-op`).
+		Suggest(`for range $s { $body }`)
+}
@@ -6,6 +6,7 @@ import (
 
 //doc:summary Detects string concat in hot paths
 //doc:tags    o2 score5
+//doc:impact  alloc
 func stringConcatAssign(m dsl.Matcher) {
 	m.Match(`$s += $_`).
 		Where(m["s"].Type.Is(`string`)).
@@ -14,18 +15,23 @@ func stringConcatAssign(m dsl.Matcher) {
 
 //doc:summary Detects regexp compilation on hot execution paths
 //doc:tags    o1 score4
+//doc:impact  cpu
 func regexpCompile(m dsl.Matcher) {
-	// TODO: for constant string patterns we can move the regexp compilation
-	// to a global scope and use compiled var on the original call site.
-	// But this can't be done by the rules.
+	// The constant patterns compilation is reported by the regexpHoist
+	// checker that suggests moving it to a package-level var.
 
+	// Explicit compilation.
+	m.Match(
+		`regexp.Compile($pattern)`,
+		`regexp.MustCompile($pattern)`,
+		`regexp.CompilePOSIX($pattern)`,
+		`regexp.MustCompilePOSIX($pattern)`,
+	).
+		Where(!m["pattern"].Const).
+		Report(`regexp compilation should be avoided on the hot paths`)
+
+	// Implicit compilation - these calls do a compile per call without any cache.
 	m.Match(
-		// Explicit compilation.
-		`regexp.Compile($*_)`,
-		`regexp.MustCompile($*_)`,
-		`regexp.CompilePOSIX($*_)`,
-		`regexp.MustCompilePOSIX($*_)`,
-		// Implicit compilation - these calls do a compile per call without any cache.
 		`regexp.Match($*_)`,
 		`regexp.MatchString($*_)`,
 		`regexp.MatchReader($*_)`,
@@ -34,6 +40,7 @@ func regexpCompile(m dsl.Matcher) {
 
 //doc:summary Detects sprint calls that can be rewritten as a string concat
 //doc:tags    o2 score2
+//doc:impact  alloc
 func sprintfConcat2(m dsl.Matcher) {
 	// It's impractical to implement this kind of analysis via the rules.
 	// I've added a few most common patterns here just in case, but
@@ -62,6 +69,7 @@ func sprintfConcat2(m dsl.Matcher) {
 
 //doc:summary Detects Write calls that should be rewritten as io.WriteString
 //doc:tags    o2 score3
+//doc:impact  alloc
 //doc:before  w.Write([]byte(s))
 //doc:after   io.WriteString(w, s)
 func writeString2(m dsl.Matcher) {
@@ -72,6 +80,7 @@ func writeString2(m dsl.Matcher) {
 
 //doc:summary Detects range loops that copy large value on every iteration
 //doc:tags    o1 score2
+//doc:impact  cpu
 func rangeValueCopy(m dsl.Matcher) {
 	// TODO: move to a hand-written checker so we can provide a quickfix for this.
 	m.Match(`for $_, $v := range $_`, `for $_, $v = range $_`).
@@ -81,8 +90,46 @@ func rangeValueCopy(m dsl.Matcher) {
 
 //doc:summary Detects errors.New that can be allocated exactly once
 //doc:tags    o1 score3
+//doc:impact  alloc
 func constErrorNew(m dsl.Matcher) {
 	m.Match(`errors.New($x)`).
 		Where(m["x"].Const).
 		Report(`errors with const message can be a global var, allocated only once`)
 }
+
+//doc:summary Detects hot formatting of slices, maps and structs that looks like a debug leftover
+//doc:tags    o2 score2
+//doc:impact  alloc
+func hotDebugFormat(m dsl.Matcher) {
+	// Formatting composite values with %v walks them with reflection
+	// and allocates a lot; this is rarely needed outside of debugging.
+	// The o2 tag makes it report only the hottest lines.
+	isComposite := func(v dsl.Var) bool {
+		return v.Type.Underlying().Is(`[]$_`) ||
+			v.Type.Underlying().Is(`map[$_]$_`) ||
+			v.Type.Underlying().Is(`struct{$*_}`)
+	}
+
+	m.Match(`fmt.Sprintf("%v", $x)`, `fmt.Sprintf("%+v", $x)`, `fmt.Sprint($x)`).
+		Where(isComposite(m["x"]) && !m["x"].Type.Implements(`fmt.Stringer`)).
+		Report(`formatting $x on a hot path is expensive, remove it or guard it behind a debug flag`)
+}
+
+//doc:summary Detects map accesses with a case-normalized key on hot paths
+//doc:tags    o2 score2
+//doc:impact  alloc
+//doc:before  v := m[strings.ToLower(k)]
+//doc:after   v := m[k] // k is normalized once, when it enters the program
+//doc:note    there is no autofix: the keys normalization point depends on the program
+func caseFoldMapKey(m dsl.Matcher) {
+	// ToLower and ToUpper allocate a new string unless k
+	// is already in the target case.
+	// Unlike m[string(b)], the compiler can't avoid it.
+	//
+	// The o2 tag makes it report only the hottest lines:
+	// for the cold map accesses the allocation doesn't matter.
+	// Note that the insertions match as well.
+	m.Match(`$m[strings.ToLower($k)]`, `$m[strings.ToUpper($k)]`).
+		Where(m["m"].Type.Underlying().Is(`map[string]$_`)).
+		Report(`case-insensitive $m key lookups allocate, normalize the keys once instead of on every access`)
+}
@@ -11,6 +11,7 @@ import (
 	"go/types"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -33,6 +34,7 @@ func precompile() error {
 	flagRules := flag.String("rules", "", "path to a ruleguard rules file")
 	flagOutput := flag.String("o", "", "output file name")
 	flagVarName := flag.String("varname", "PrecompiledRules", "generated variable name")
+	flagLint := flag.Bool("lint", false, "whether these are lint rules that don't need o1/o2 tags")
 	flag.Parse()
 
 	fset := token.NewFileSet()
@@ -47,6 +49,11 @@ func precompile() error {
 	if err != nil {
 		return fmt.Errorf("parse %s: %v", filename, err)
 	}
+	impacts, err := extractImpacts(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+
 	imp := importer.For("source", nil)
 	typechecker := types.Config{Importer: imp}
 	types := &types.Info{
@@ -82,12 +89,14 @@ func precompile() error {
 				tagScore = true
 			case "reformat":
 				// OK.
+			case "concurrency":
+				// OK: a concurrency-safety warning, not a performance issue.
 			default:
 				return fmt.Errorf("%s: unknown tag: %s", g.Name, tag)
 			}
 		}
-		if !tagO {
-			return fmt.Errorf("%s: add o1 or o2 tag")
+		if !tagO && !*flagLint {
+			return fmt.Errorf("%s: add o1 or o2 tag", g.Name)
 		}
 		if !tagScore {
 			return fmt.Errorf("%s: add score[1-5] tag", g.Name)
@@ -108,6 +117,12 @@ func precompile() error {
 	var rulesText bytes.Buffer
 	irprint.File(&rulesText, irfile)
 
+	impactNames := make([]string, 0, len(impacts))
+	for name := range impacts {
+		impactNames = append(impactNames, name)
+	}
+	sort.Strings(impactNames)
+
 	fileTemplate := template.Must(template.New("gorules").Parse(`// Code generated by "precompile.go". DO NOT EDIT.
 
 package rulesdata
@@ -115,12 +130,21 @@ package rulesdata
 import "github.com/quasilyte/go-ruleguard/ruleguard/ir"
 
 var {{$.VarName}} = &{{$.RulesText}}
+
+// {{$.VarName}}Impact maps a rule group name to its doc:impact value.
+var {{$.VarName}}Impact = map[string]string{
+{{- range $.ImpactNames}}
+	"{{.}}": "{{index $.Impacts .}}",
+{{- end}}
+}
 `))
 
 	var generated bytes.Buffer
 	err = fileTemplate.Execute(&generated, map[string]interface{}{
-		"RulesText": rulesText.String(),
-		"VarName":   *flagVarName,
+		"RulesText":   strings.TrimRight(rulesText.String(), "\n"),
+		"VarName":     *flagVarName,
+		"Impacts":     impacts,
+		"ImpactNames": impactNames,
 	})
 	if err != nil {
 		return err
@@ -132,3 +156,33 @@ var {{$.VarName}} = &{{$.RulesText}}
 
 	return nil
 }
+
+// extractImpacts collects the `//doc:impact` directives of the rule groups.
+//
+// ruleguard doesn't know about this directive, so we remove
+// these comments from the AST before converting it to IR.
+func extractImpacts(f *ast.File) (map[string]string, error) {
+	impacts := make(map[string]string)
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Doc == nil {
+			continue
+		}
+		comments := fn.Doc.List[:0]
+		for _, c := range fn.Doc.List {
+			if !strings.HasPrefix(c.Text, "//doc:impact") {
+				comments = append(comments, c)
+				continue
+			}
+			impact := strings.TrimSpace(strings.TrimPrefix(c.Text, "//doc:impact"))
+			switch impact {
+			case "alloc", "cpu", "readability":
+				impacts[fn.Name.Name] = impact
+			default:
+				return nil, fmt.Errorf("%s: unknown impact: %q", fn.Name.Name, impact)
+			}
+		}
+		fn.Doc.List = comments
+	}
+	return impacts, nil
+}
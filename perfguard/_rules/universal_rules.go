@@ -41,6 +41,7 @@ import (
 
 //doc:summary Detects use cases for strings.Cut
 //doc:tags    o1 score3
+//doc:impact  alloc
 //doc:before  email := strings.Split(s, "@")[0]
 //doc:after   email, _, _ := strings.Cut(s, "@")
 func stringsCut(m dsl.Matcher) {
@@ -50,10 +51,18 @@ func stringsCut(m dsl.Matcher) {
 	m.Match(`$dst = strings.Split($s, $sep)[0]`).
 		Where(m.GoVersion().GreaterEqThan("1.18")).
 		Suggest(`$dst, _, _ = strings.Cut($s, $sep)`)
+
+	// strings.Cut returns several values, so it can't replace
+	// the expression inside a call or a return statement.
+	// The assignments are handled above.
+	m.Match(`strings.Split($s, $sep)[0]`).
+		Where(m.GoVersion().GreaterEqThan("1.18") && !m["$$"].Node.Parent().Is(`AssignStmt`)).
+		Report(`strings.Split allocates a slice of all fields, use before, _, _ := strings.Cut($s, $sep) to get the first one`)
 }
 
 //doc:summary Detects use cases for bytes.Cut
 //doc:tags    o1 score3
+//doc:impact  alloc
 //doc:before  email := bytes.Split(b, "@")[0]
 //doc:after   email, _, _ := bytes.Cut(b, []byte("@"))
 func bytesCut(m dsl.Matcher) {
@@ -65,8 +74,21 @@ func bytesCut(m dsl.Matcher) {
 		Suggest(`$dst, _, _ = bytes.Cut($b, $sep)`)
 }
 
+//doc:summary Detects strings.Split calls that are only used to count the separators
+//doc:tags    o1 score3
+//doc:impact  alloc
+//doc:before  n := len(strings.Split(s, ",")) - 1
+//doc:after   n := strings.Count(s, ",")
+//doc:note    an empty separator makes Split and Count disagree, so only non-empty constant separators are matched
+func stringsCount(m dsl.Matcher) {
+	m.Match(`len(strings.Split($s, $sep)) - 1`).
+		Where(m["sep"].Const && !m["sep"].Text.Matches("^(\"\"|``)$")).
+		Suggest(`strings.Count($s, $sep)`)
+}
+
 //doc:summary Detects use cases for strings.Clone
 //doc:tags    o1 score3
+//doc:impact  alloc
 //doc:before  s2 := string([]byte(s1))
 //doc:after   s2 := strings.Clone(s1)
 func stringsClone(m dsl.Matcher) {
@@ -79,6 +101,7 @@ func stringsClone(m dsl.Matcher) {
 
 //doc:summary Detects unoptimal strings/bytes case-insensitive comparison
 //doc:tags    o1 score2
+//doc:impact  alloc
 //doc:before  strings.ToLower(x) == strings.ToLower(y)
 //doc:after   strings.EqualFold(x, y)
 func equalFold(m dsl.Matcher) {
@@ -142,6 +165,8 @@ func equalFold(m dsl.Matcher) {
 
 //doc:summary Detects redundant fmt.Sprint calls
 //doc:tags    o1 score3
+//doc:impact  alloc
+//doc:note    a []byte is only rewritten for %s, fmt.Sprint and %v print its byte values
 func redundantSprint(m dsl.Matcher) {
 	m.Match(`fmt.Sprint($x)`, `fmt.Sprintf("%s", $x)`, `fmt.Sprintf("%v", $x)`).
 		Where(m["x"].Type.Implements(`fmt.Stringer`)).
@@ -155,16 +180,24 @@ func redundantSprint(m dsl.Matcher) {
 		Where(m["x"].Type.Is(`string`)).
 		Suggest(`$x`)
 
+	// A byte slice is printed as a string only with %s,
+	// fmt.Sprint and %v print it as a list of numbers.
+	m.Match(`fmt.Sprintf("%s", $x)`).
+		Where(m["x"].Type.Underlying().Is(`[]byte`)).
+		Suggest(`string($x)`)
+
 	m.Match(`fmt.Sprint($x)`, `fmt.Sprintf("%s", $x)`, `fmt.Sprintf("%v", $x)`).
 		Where(
 			m["x"].Type.ConvertibleTo(`string`) &&
 				!m["x"].Type.OfKind("numeric") &&
+				!m["x"].Type.Underlying().Is(`[]byte`) &&
 				!m["x"].Type.Is(`[]rune`)).
 		Suggest(`string($x)`)
 }
 
 //doc:summary Detects redundant fmt.Fprint calls
 //doc:tags    o1 score3
+//doc:impact  alloc
 //doc:before  fmt.Fprintf(w, "%s", data)
 //doc:after   w.WriteString(data.String())
 func redundantFprint(m dsl.Matcher) {
@@ -180,13 +213,38 @@ func redundantFprint(m dsl.Matcher) {
 		Where(m["x"].Type.Is(`string`) && m["w"].Type.Implements(`io.StringWriter`)).
 		Suggest(`$w.WriteString($x)`)
 
-	m.Match(`fmt.Fprint($w, $x)`, `fmt.Fprintf($w, "%s", $x)`, `fmt.Fprintf($w, "%v", $x)`).
-		Where(m["x"].Type.Is(`[]byte`)).
+	// See redundantSprint: only %s prints a byte slice as a string.
+	m.Match(`fmt.Fprintf($w, "%s", $x)`).
+		Where(m["x"].Type.Underlying().Is(`[]byte`)).
 		Suggest(`$w.Write($x)`)
 }
 
+//doc:summary Detects fmt format strings that are built with a fmt.Sprintf concat
+//doc:tags    o1 score2
+//doc:impact  alloc
+//doc:before  fmt.Fprintf(w, "id="+fmt.Sprintf("%d", id)+" name=%s", name)
+//doc:after   fmt.Fprintf(w, "id=%d name=%s", id, name)
+//doc:note    there is no autofix: the verbs and the args of both calls need to be merged
+func nestedSprintfFormat(m dsl.Matcher) {
+	// The inner Sprintf result is formatted twice: first by Sprintf
+	// and then it's scanned for the verbs by the outer call.
+	// It's also a bug if the inner result contains a '%'.
+	//
+	// Merging the formats requires the args to be inserted at the
+	// correct positions and the inner result to be escaped, so it's report-only.
+	isSprintfConcat := func(v dsl.Var) bool {
+		return v.Node.Is(`BinaryExpr`) && v.Contains(`fmt.Sprintf($*_)`)
+	}
+
+	m.Match(`fmt.Fprintf($_, $format, $*_)`, `fmt.Printf($format, $*_)`, `fmt.Sprintf($format, $*_)`).
+		Where(isSprintfConcat(m["format"])).
+		Report(`the format string is built with a fmt.Sprintf concat, merge the inner format into the outer one`).
+		At(m["format"])
+}
+
 //doc:summary Detects slice copying patterns that can be optimized
 //doc:tags    o2 score2
+//doc:impact  alloc
 //doc:before  dst := append([]int(nil), src...)
 //doc:after   dst := make([]int, len(src)); copy(dst, src)
 func sliceClone(m dsl.Matcher) {
@@ -205,8 +263,81 @@ func sliceClone(m dsl.Matcher) {
 		Suggest(`$dst := make([]$elem, len($src)); copy($dst, $src)`)
 }
 
+//doc:summary Detects zero capacity slices that are filled by a spread append right away
+//doc:tags    o2 score2
+//doc:impact  alloc
+//doc:before  dst := make([]int, 0); dst = append(dst, src...)
+//doc:after   dst := make([]int, 0, len(src)); dst = append(dst, src...)
+func makeZeroCap(m dsl.Matcher) {
+	// Only the append that follows make immediately is checked,
+	// so there are no other writes to $s in between.
+	// The capacity hint makes the append fit into the initial allocation.
+	//
+	// $src is evaluated twice after the fix, so it should be pure.
+	m.Match(`$s := make([]$t, 0); $s = append($s, $src...)`).
+		Where(m["src"].Pure).
+		Suggest(`$s := make([]$t, 0, len($src)); $s = append($s, $src...)`)
+	m.Match(`$s = make([]$t, 0); $s = append($s, $src...)`).
+		Where(m["src"].Pure).
+		Suggest(`$s = make([]$t, 0, len($src)); $s = append($s, $src...)`)
+}
+
+//doc:summary Detects byte slices that are zeroed by make and then fully overwritten
+//doc:tags    o2 score2
+//doc:impact  cpu
+//doc:before  b := make([]byte, len(s)); copy(b, s)
+//doc:after   b := []byte(s)
+func makeOverwrite(m dsl.Matcher) {
+	// Only the statements that follow make immediately are checked.
+	// They fill the entire slice, so the zeroing done by make is wasted.
+	//
+	// Note that make+copy from a slice is not reported:
+	// the compiler recognizes this pattern and doesn't zero the memory.
+	m.Match(`$b := make([]byte, len($s)); copy($b, $s)`).
+		Where(m["s"].Type.Underlying().Is(`string`)).
+		Report(`$b is zeroed by make and then overwritten by copy, use $b := []byte($s) instead`)
+
+	// io.ReadFull overwrites the entire slice unless it returns an error.
+	// There is no way to avoid the zeroing here, but the buffer can be re-used.
+	m.Match(
+		`$b := make([]byte, $_); $_, $_ := io.ReadFull($_, $b)`,
+		`$b := make([]byte, $_); $_, $_ = io.ReadFull($_, $b)`,
+		`$b := make([]byte, $_); if _, $_ := io.ReadFull($_, $b); $_ { $*_ }`,
+	).
+		Report(`$b is zeroed by make and then overwritten by io.ReadFull, consider re-using the buffer`)
+}
+
+//doc:summary Detects io.ReadAll results that are wrapped into bytes.NewReader right away
+//doc:tags    o2 score2
+//doc:impact  alloc
+//doc:before  data, err := io.ReadAll(r); if err != nil { return err }; return decode(bytes.NewReader(data))
+//doc:after   return decode(r)
+//doc:note    there is no autofix: io.ReadAll reports the read errors before the data is consumed
+func readAllReader(m dsl.Matcher) {
+	// The flow is matched as two statements: the io.ReadAll call and
+	// the bytes.NewReader call that immediately follows it.
+	// The only statement that is allowed between them is an error check.
+	// The reader argument should be exactly the io.ReadAll result,
+	// so the data is not transformed in any way before it's re-read.
+	//
+	// The data may still be used after that, the original reader may
+	// not be usable as a replacement if a Seek or Len is needed;
+	// that's why this rule is report-only.
+	m.Match(
+		`$b, $err := io.ReadAll($r); if $err != nil { $*_ }; $x := bytes.NewReader($b)`,
+		`$b, $err := io.ReadAll($r); if $err != nil { $*_ }; $x = bytes.NewReader($b)`,
+		`$b, $err = io.ReadAll($r); if $err != nil { $*_ }; $x := bytes.NewReader($b)`,
+		`$b, $err = io.ReadAll($r); if $err != nil { $*_ }; $x = bytes.NewReader($b)`,
+		`$b, $_ := io.ReadAll($r); $x := bytes.NewReader($b)`,
+		`$b, $_ := io.ReadAll($r); $x = bytes.NewReader($b)`,
+	).
+		Where(m["r"].Pure).
+		Report(`$b is read from $r only to be wrapped into bytes.NewReader, consider passing $r directly`)
+}
+
 //doc:summary Detect strings.Join usages that can be rewritten as a string concat
 //doc:tags    o1 score3
+//doc:impact  alloc
 func stringsJoinConcat(m dsl.Matcher) {
 	m.Match(`strings.Join([]string{$x, $y}, "")`).
 		Where(!m["x"].Const && !m["y"].Const).
@@ -226,6 +357,7 @@ func stringsJoinConcat(m dsl.Matcher) {
 
 //doc:summary Detects sprint calls that can be rewritten as a string concat
 //doc:tags    o1 score3
+//doc:impact  alloc
 //doc:before  fmt.Sprintf("%s%s", x, y)
 //doc:after   x + y
 func sprintfConcat(m dsl.Matcher) {
@@ -236,10 +368,17 @@ func sprintfConcat(m dsl.Matcher) {
 	m.Match(`fmt.Sprintf("%s%s", $x, $y)`).
 		Where(m["x"].Type.Implements(`fmt.Stringer`) && m["y"].Type.Implements(`fmt.Stringer`)).
 		Suggest(`$x.String() + $y.String()`)
+
+	// Sprint adds spaces between operands when neither is a string.
+	// It's only safe to do a concat of string operands.
+	m.Match(`fmt.Sprint($x, $y)`).
+		Where(m["x"].Type.Is(`string`) && m["y"].Type.Is(`string`)).
+		Suggest(`$x + $y`)
 }
 
 //doc:summary Detects sprintf calls that are used to create an error
 //doc:tags    o1 score2
+//doc:impact  cpu
 //doc:before  errors.New(fmt.Sprintf("%s:%d", file, line))
 //doc:after   fmt.Errorf("%s:%d", file, line)
 func sprintfError(m dsl.Matcher) {
@@ -249,6 +388,7 @@ func sprintfError(m dsl.Matcher) {
 
 //doc:summary Detects fmt uses that can be replaced with strconv
 //doc:tags    o1 score2
+//doc:impact  alloc
 //doc:before  fmt.Sprintf("%d", i)
 //doc:after   strconv.Itoa(i)
 func strconv(m dsl.Matcher) {
@@ -280,8 +420,49 @@ func strconv(m dsl.Matcher) {
 		Where(m["x"].Type.OfKind(`uint`)).Suggest(`strconv.FormatUint(uint64($x), 16)`)
 }
 
+//doc:summary Detects strconv.FormatInt calls that can be strconv.Itoa
+//doc:tags    o1 score1
+//doc:impact  readability
+//doc:before  strconv.FormatInt(int64(x), 10)
+//doc:after   strconv.Itoa(x)
+//doc:note    only the int typed values are matched, Itoa doesn't accept other integer types
+func formatIntToItoa(m dsl.Matcher) {
+	// Itoa is a FormatInt(int64(x), 10) wrapper, so the conversion
+	// is only a noise. A named type or int32 would need an int()
+	// conversion instead, so they're left as is.
+	// An int64 value has no conversion to remove and can't be passed to Itoa.
+	m.Match(`strconv.FormatInt(int64($x), 10)`).
+		Where(m["x"].Type.Is(`int`)).
+		Suggest(`strconv.Itoa($x)`)
+}
+
+//doc:summary Detects string concatenations that are appended to a byte slice
+//doc:tags    o1 score2
+//doc:impact  alloc
+//doc:before  b = append(b, "key="+s...)
+//doc:after   b = append(append(b, "key="...), s...)
+func appendConcat(m dsl.Matcher) {
+	// The concatenation allocates a new string that is only
+	// copied into the byte slice, the chained appends copy
+	// every operand directly.
+	// The constant concatenations are folded by the compiler.
+	isString := func(v dsl.Var) bool {
+		return v.Type.Underlying().Is(`string`)
+	}
+
+	m.Match(`append($b, $x + $y + $z...)`).
+		Where(m["b"].Type.Is(`[]byte`) && isString(m["x"]) && isString(m["y"]) && isString(m["z"]) &&
+			!(m["x"].Const && m["y"].Const && m["z"].Const)).
+		Suggest(`append(append(append($b, $x...), $y...), $z...)`)
+	m.Match(`append($b, $x + $y...)`).
+		Where(m["b"].Type.Is(`[]byte`) && isString(m["x"]) && isString(m["y"]) &&
+			!(m["x"].Const && m["y"].Const)).
+		Suggest(`append(append($b, $x...), $y...)`)
+}
+
 //doc:summary Detects cases that can benefit from append-friendly APIs
 //doc:tags    o1 score4
+//doc:impact  alloc
 //doc:before  b = append(b, strconv.Itoa(v)...)
 //doc:after   b = strconv.AppendInt(b, v, 10)
 func appendAPI(m dsl.Matcher) {
@@ -320,6 +501,7 @@ func appendAPI(m dsl.Matcher) {
 
 //doc:summary Detects patterns that can be reordered to make the code faster
 //doc:tags    o1 score2
+//doc:impact  alloc
 //doc:before  strings.TrimSpace(string(b))
 //doc:after   string(bytes.TrimSpace(b))
 func convReorder(m dsl.Matcher) {
@@ -347,6 +529,7 @@ func convReorder(m dsl.Matcher) {
 
 //doc:summary Detects sliced slice copying that can be optimized
 //doc:tags    o1 score3
+//doc:impact  alloc
 //doc:before  string(b)[:n]
 //doc:after   string(b[:n])
 func slicedConv(m dsl.Matcher) {
@@ -361,6 +544,7 @@ func slicedConv(m dsl.Matcher) {
 
 //doc:summary Detects redundant conversions between string and []byte
 //doc:tags    o1 score4
+//doc:impact  alloc
 //doc:before  copy(b, []byte(s))
 //doc:after   copy(b, s)
 func stringCopyElim(m dsl.Matcher) {
@@ -399,6 +583,7 @@ func stringCopyElim(m dsl.Matcher) {
 
 //doc:summary Detects inefficient regexp usage in regard to string/[]byte conversions
 //doc:tags    o1 score3
+//doc:impact  alloc
 //doc:before  regexp.ReplaceAll([]byte(s), []byte("foo"))
 //doc:after   regexp.ReplaceAllString(s, "foo")
 func regexpStringCopyElim(m dsl.Matcher) {
@@ -447,8 +632,44 @@ func regexpStringCopyElim(m dsl.Matcher) {
 		Suggest(`$re.ReplaceAll($b, []byte($s))`)
 }
 
+//doc:summary Detects regexp FindString calls that are only used to test for a match
+//doc:tags    o1 score2
+//doc:impact  cpu
+//doc:before  re.FindString(s) != ""
+//doc:after   re.MatchString(s)
+//doc:note    if the pattern can match an empty string, FindString returns "" even on a match
+func regexpFindMatch(m dsl.Matcher) {
+	// MatchString stops at the first match and doesn't
+	// need to record the submatch positions.
+	m.Match(`$re.FindString($s) != ""`, `"" != $re.FindString($s)`).
+		Where(m["re"].Type.Is(`*regexp.Regexp`)).
+		Suggest(`$re.MatchString($s)`)
+
+	m.Match(`$re.FindString($s) == ""`, `"" == $re.FindString($s)`).
+		Where(m["re"].Type.Is(`*regexp.Regexp`)).
+		Suggest(`!$re.MatchString($s)`)
+}
+
+//doc:summary Detects regexp MatchString guards before FindString calls with the same input
+//doc:tags    o1 score2
+//doc:impact  cpu
+//doc:before  if re.MatchString(s) { x := re.FindString(s); use(x) }
+//doc:after   if x := re.FindString(s); x != "" { use(x) }
+//doc:note    if the pattern can match an empty string, FindString returns "" even on a match
+func regexpGuardedFind(m dsl.Matcher) {
+	// The guard runs the same regexp over the same input twice:
+	// the FindString empty result already tells that there is no match.
+	// Only the guards with the FindString assignment as
+	// the first if body statement are matched.
+	m.Match(`if $re.MatchString($s) { $x := $re.FindString($s); $*body }`).
+		Where(m["re"].Type.Is(`*regexp.Regexp`) && m["re"].Pure && m["s"].Pure).
+		Report(`$re.MatchString($s) guard is redundant, use if $x := $re.FindString($s); $x != "" { … }`).
+		Suggest(`if $x := $re.FindString($s); $x != "" { $body }`)
+}
+
 //doc:summary Detects strings.Index()-like calls that may allocate more than they should
 //doc:tags    o1 score3
+//doc:impact  alloc
 //doc:before  strings.Index(string(x), y)
 //doc:after   bytes.Index(x, []byte(y))
 //doc:note    See Go issue for details: https://github.com/golang/go/issues/25864
@@ -491,6 +712,7 @@ func indexAlloc(m dsl.Matcher) {
 
 //doc:summary Detects WriteRune calls with rune literal argument that is single byte and reports to use WriteByte instead
 //doc:tags    o1 score1
+//doc:impact  cpu
 //doc:before  w.WriteRune('\n')
 //doc:after   w.WriteByte('\n')
 func writeByte(m dsl.Matcher) {
@@ -504,6 +726,7 @@ func writeByte(m dsl.Matcher) {
 
 //doc:summary Detects slice clear loops, suggests an idiom that is recognized by the Go compiler
 //doc:tags    o1 score2
+//doc:impact  cpu
 //doc:before  for i := 0; i < len(buf); i++ { buf[i] = 0 }
 //doc:after   for i := range buf { buf[i] = 0 }
 func sliceClear(m dsl.Matcher) {
@@ -515,15 +738,66 @@ func sliceClear(m dsl.Matcher) {
 
 //doc:summary Detects cases where map clear idiom can be used
 //doc:tags    o1 score2 reformat
-//doc:before  o.set = make(map[string]int, len(o.set))
-//doc:after   for k := range o.set { delete(o.set, k) }
+//doc:impact  cpu
+//doc:before  for k := range o.set { delete(o.set, k) }
+//doc:after   clear(o.set)
+//doc:note    before Go 1.21, a map re-make is rewritten as a delete loop instead
 func mapClear(m dsl.Matcher) {
+	// The loop body should be a single delete statement:
+	// if it does anything else, it's not just a clear.
+	m.Match(`for $k := range $m { delete($m, $k) }`).
+		Where(m.GoVersion().GreaterEqThan("1.21") && m["m"].Pure).
+		Suggest(`clear($m)`).
+		Report(`for ... { ... } => clear($m)`)
+
 	m.Match(`$m = make(map[$_]$_, len($m))`).
+		Where(m.GoVersion().GreaterEqThan("1.21")).
+		Suggest(`clear($m)`)
+	m.Match(`$m = make(map[$_]$_, len($m))`).
+		Where(m.GoVersion().LessThan("1.21")).
 		Suggest(`for k := range $m { delete($m, k) }`)
 }
 
+//doc:summary Detects sort.Slice calls on basic type slices that can use slices.Sort
+//doc:tags    o1 score3
+//doc:impact  cpu
+//doc:before  sort.Slice(xs, func(i, j int) bool { return xs[i] < xs[j] })
+//doc:after   slices.Sort(xs)
+//doc:note    only the unnamed element types are matched, a descending order is report-only
+func slicesSort(m dsl.Matcher) {
+	// slices.Sort is generic, it doesn't need the reflection-based
+	// swapper and it doesn't call the less function for every comparison.
+	//
+	// The comparator should compare the elements indexed by its own
+	// params, in the same order; this is ensured by the pattern backrefs.
+	// A struct field access like xs[i].Key needs slices.SortFunc,
+	// it's not matched as the element type is not a basic type.
+	//
+	// The sort order of NaN values is different: sort.Slice with `<`
+	// puts them in an unspecified order, slices.Sort puts them first.
+	isOrderedSlice := func(v dsl.Var) bool {
+		return v.Type.Is(`[]int`) || v.Type.Is(`[]int8`) || v.Type.Is(`[]int16`) ||
+			v.Type.Is(`[]int32`) || v.Type.Is(`[]int64`) ||
+			v.Type.Is(`[]uint`) || v.Type.Is(`[]uint8`) || v.Type.Is(`[]uint16`) ||
+			v.Type.Is(`[]uint32`) || v.Type.Is(`[]uint64`) || v.Type.Is(`[]uintptr`) ||
+			v.Type.Is(`[]float32`) || v.Type.Is(`[]float64`) ||
+			v.Type.Is(`[]string`)
+	}
+
+	m.Match(`sort.Slice($s, func($i, $j int) bool { return $s[$i] < $s[$j] })`).
+		Where(m.GoVersion().GreaterEqThan("1.21") && m["s"].Pure && isOrderedSlice(m["s"])).
+		Suggest(`slices.Sort($s)`)
+
+	// There is no reverse order slices.Sort, the cmp.Compare based
+	// comparator needs the element type name, so it's not suggested.
+	m.Match(`sort.Slice($s, func($i, $j int) bool { return $s[$i] > $s[$j] })`).
+		Where(m.GoVersion().GreaterEqThan("1.21") && m["s"].Pure && isOrderedSlice(m["s"])).
+		Report(`use slices.Sort($s) followed by slices.Reverse($s), or slices.SortFunc with a reversed cmp.Compare`)
+}
+
 //doc:summary Detects map <op>= patterns that can be rewritten to avoid double hashing
 //doc:tags    o1 score2
+//doc:impact  cpu
 func mapAssignOp(m dsl.Matcher) {
 	m.Match(`$m[$k] = $m[$k] + 1`, `$m[$k] += 1`).
 		Where(m["m"].Type.Is(`map[$_]$_`) && m["k"].Pure).
@@ -543,8 +817,46 @@ func mapAssignOp(m dsl.Matcher) {
 		Suggest(`$m[$k] /= $v`)
 }
 
+//doc:summary Detects expressions like []byte(s)[i] that may cause unwanted byte slice allocation
+//doc:tags    o1 score4
+//doc:impact  alloc
+//doc:before  b := []byte(s)[i]
+//doc:after   b := s[i]
+func stringByteIndex(m dsl.Matcher) {
+	// Indexing a string gives the same byte, there is no decoding involved.
+	// We can't replace the expressions that need to be addressable.
+	//
+	// A string element can't be assigned, so the assignments are
+	// matched as a whole: only the right-hand side is replaced.
+	isString := func(v dsl.Var) bool {
+		return v.Type.Underlying().Is(`string`) && !v.Node.Is(`BinaryExpr`)
+	}
+	isStringConcat := func(v dsl.Var) bool {
+		return v.Type.Underlying().Is(`string`) && v.Node.Is(`BinaryExpr`)
+	}
+
+	m.Match(`$x := []byte($s)[$i]`).Where(isString(m["s"])).Suggest(`$x := $s[$i]`)
+	m.Match(`$x := []byte($s)[$i]`).Where(isStringConcat(m["s"])).Suggest(`$x := ($s)[$i]`)
+	m.Match(`$x = []byte($s)[$i]`).Where(isString(m["s"])).Suggest(`$x = $s[$i]`)
+	m.Match(`$x = []byte($s)[$i]`).Where(isStringConcat(m["s"])).Suggest(`$x = ($s)[$i]`)
+
+	m.Match(`[]byte($s)[$i]`).
+		Where(isString(m["s"]) &&
+			!m["$$"].Node.Parent().Is(`AssignStmt`) &&
+			!m["$$"].Node.Parent().Is(`UnaryExpr`) &&
+			!m["$$"].Node.Parent().Is(`IncDecStmt`)).
+		Suggest(`$s[$i]`)
+	m.Match(`[]byte($s)[$i]`).
+		Where(isStringConcat(m["s"]) &&
+			!m["$$"].Node.Parent().Is(`AssignStmt`) &&
+			!m["$$"].Node.Parent().Is(`UnaryExpr`) &&
+			!m["$$"].Node.Parent().Is(`IncDecStmt`)).
+		Suggest(`($s)[$i]`)
+}
+
 //doc:summary Detects expressions like []rune(s)[0] that may cause unwanted rune slice allocation
 //doc:tags    o1 score4
+//doc:impact  alloc
 //doc:before  r := []rune(s)[0]
 //doc:after   r, _ := utf8.DecodeRuneInString(s)
 //doc:note    See Go issue for details: https://github.com/golang/go/issues/45260
@@ -572,6 +884,7 @@ func utf8DecodeRune(m dsl.Matcher) {
 
 //doc:summary Detects fmt.Sprint(f/ln) calls which can be replaced with fmt.Fprint(f/ln)
 //doc:tags    o1 score2
+//doc:impact  alloc
 //doc:before  w.Write([]byte(fmt.Sprintf("%x", 10)))
 //doc:after   fmt.Fprintf(w, "%x", 10)
 func fprint(m dsl.Matcher) {
@@ -597,8 +910,52 @@ func fprint(m dsl.Matcher) {
 		Suggest(`fmt.Fprintln($w, $args)`)
 }
 
+//doc:summary Detects bytes.Buffer and strings.Builder WriteString calls with fmt.Sprint(f/ln) argument
+//doc:tags    o1 score2
+//doc:impact  alloc
+//doc:before  buf.WriteString(fmt.Sprintf("%x", 10))
+//doc:after   fmt.Fprintf(buf, "%x", 10)
+func bufferFprintf(m dsl.Matcher) {
+	isBufferPtr := func(v dsl.Var) bool {
+		return (v.Type.Is(`*bytes.Buffer`) || v.Type.Is(`*strings.Builder`)) &&
+			v.Type.Implements(`io.Writer`)
+	}
+	isBufferValue := func(v dsl.Var) bool {
+		return (v.Type.Is(`bytes.Buffer`) || v.Type.Is(`strings.Builder`)) && v.Addressable
+	}
+
+	m.Match(`$buf.WriteString(fmt.Sprintf($format, $args...))`).
+		Where(isBufferPtr(m["buf"])).
+		Suggest(`fmt.Fprintf($buf, $format, $args...)`)
+	m.Match(`$buf.WriteString(fmt.Sprint($*args))`).
+		Where(isBufferPtr(m["buf"])).
+		Suggest(`fmt.Fprint($buf, $args)`)
+	m.Match(`$buf.WriteString(fmt.Sprintf($*args))`).
+		Where(isBufferPtr(m["buf"])).
+		Suggest(`fmt.Fprintf($buf, $args)`)
+	m.Match(`$buf.WriteString(fmt.Sprintln($*args))`).
+		Where(isBufferPtr(m["buf"])).
+		Suggest(`fmt.Fprintln($buf, $args)`)
+
+	// A value receiver is passed by its address, so the
+	// buffer is not copied by fmt.Fprint calls.
+	m.Match(`$buf.WriteString(fmt.Sprintf($format, $args...))`).
+		Where(isBufferValue(m["buf"])).
+		Suggest(`fmt.Fprintf(&$buf, $format, $args...)`)
+	m.Match(`$buf.WriteString(fmt.Sprint($*args))`).
+		Where(isBufferValue(m["buf"])).
+		Suggest(`fmt.Fprint(&$buf, $args)`)
+	m.Match(`$buf.WriteString(fmt.Sprintf($*args))`).
+		Where(isBufferValue(m["buf"])).
+		Suggest(`fmt.Fprintf(&$buf, $args)`)
+	m.Match(`$buf.WriteString(fmt.Sprintln($*args))`).
+		Where(isBufferValue(m["buf"])).
+		Suggest(`fmt.Fprintln(&$buf, $args)`)
+}
+
 //doc:summary Detects w.Write calls which can be replaced with w.WriteString
 //doc:tags    o1 score4
+//doc:impact  alloc
 //doc:before  w.Write([]byte("foo"))
 //doc:after   w.WriteString("foo")
 func writeString(m dsl.Matcher) {
@@ -609,6 +966,7 @@ func writeString(m dsl.Matcher) {
 
 //doc:summary Detects w.WriteString calls which can be replaced with w.Write
 //doc:tags    o1 score4
+//doc:impact  alloc
 //doc:before  w.WriteString(buf.String())
 //doc:after   w.Write(buf.Bytes())
 func writeBytes(m dsl.Matcher) {
@@ -635,6 +993,7 @@ func writeBytes(m dsl.Matcher) {
 
 //doc:summary Detects bytes.Buffer String() calls where Bytes() could be used instead
 //doc:tags    o1 score4
+//doc:impact  alloc
 //doc:before  strings.Contains(buf.String(), string(b))
 //doc:after   bytes.Contains(buf.Bytes(), b)
 func bufferString(m dsl.Matcher) {
@@ -696,6 +1055,7 @@ func bufferString(m dsl.Matcher) {
 
 //doc:summary Detects array range loops that result in an excessive full data copy
 //doc:tags    o1 score2
+//doc:impact  cpu
 func rangeExprCopy(m dsl.Matcher) {
 	m.Match(`for $_, $_ := range $e`, `for $_, $_ = range $e`).
 		Where(m["e"].Addressable && m["e"].Type.Is(`[$_]$_`) && m["e"].Type.Size > 2048).
@@ -712,6 +1072,7 @@ func rangeExprCopy(m dsl.Matcher) {
 
 //doc:summary Detects range loops that can be turned into a single append call
 //doc:tags    o1 score3
+//doc:impact  cpu
 func rangeToAppend(m dsl.Matcher) {
 	m.Match(`for _, $x := range $src { $dst = append($dst, $x) }`).
 		Where(m["src"].Type.Is(`[]$_`) && !m["dst"].Contains(`$x`) && m["src"].Type.IdenticalTo(m["dst"])).
@@ -721,6 +1082,7 @@ func rangeToAppend(m dsl.Matcher) {
 
 //doc:summary Detects range loops that can be turned into a single copy call
 //doc:tags    o1 score4
+//doc:impact  cpu
 func rangeToCopy(m dsl.Matcher) {
 	m.Match(
 		`for $i := range $src { $dst[$i] = $src[$i] }`,
@@ -729,10 +1091,24 @@ func rangeToCopy(m dsl.Matcher) {
 		Where(m["src"].Type.Is(`[]$_`) && m["src"].Type.IdenticalTo(m["dst"])).
 		Suggest(`copy($dst, $src)`).
 		Report(`for … { … } => copy($dst, $src)`)
+
+	// The loops over dst overwrite the existing dst elements.
+	// The loop body should be a single assignment with the same
+	// index on both sides, so dst[i] is never read before it's written.
+	// If dst and src overlap, like in dst=s[1:] and src=s, the loop
+	// propagates the already written elements while copy moves the
+	// original ones, so there is no autofix for these loops.
+	m.Match(
+		`for $i := range $dst { $dst[$i] = $src[$i] }`,
+		`for $i := 0; $i < len($dst); $i++ { $dst[$i] = $src[$i] }`).
+		Where(m["dst"].Type.Is(`[]$_`) && m["src"].Type.IdenticalTo(m["dst"]) &&
+			m["dst"].Pure && m["src"].Pure && m["dst"].Text != m["src"].Text).
+		Report(`for … { … } can be replaced with copy($dst, $src) if $dst and $src don't overlap`)
 }
 
 //doc:summary Detects loops where slice dst=src and they can be replaced with a copy call
 //doc:tags    o1 score4
+//doc:impact  cpu
 func sliceSelfCopy(m dsl.Matcher) {
 	m.Match(
 		`for $i := 0; i < $n; $i++ { $s[$i] = $s[$offset+$i] }`).
@@ -743,6 +1119,7 @@ func sliceSelfCopy(m dsl.Matcher) {
 
 //doc:summary Detects a range over []rune(string) where copying to a new slice is redundant
 //doc:tags    o1 score3
+//doc:impact  alloc
 func rangeRuneSlice(m dsl.Matcher) {
 	m.Match(`for _, $r := range []rune($s)`).
 		Where(m["s"].Type.Underlying().Is(`string`)).
@@ -767,6 +1144,7 @@ func rangeRuneSlice(m dsl.Matcher) {
 
 //doc:summary Detects usages of reflect.DeepEqual that can be rewritten
 //doc:tags    o1 score2
+//doc:impact  cpu
 func reflectDeepEqual(m dsl.Matcher) {
 	m.Match(`reflect.DeepEqual($x, $y)`).
 		Where(m["x"].Type.Is(`[]byte`) && m["y"].Type.Is(`[]byte`)).
@@ -788,6 +1166,7 @@ func reflectDeepEqual(m dsl.Matcher) {
 
 //doc:summary Detects reflect Type() related patterns that can be optimized
 //doc:tags    o1 score1
+//doc:impact  cpu
 func reflectType(m dsl.Matcher) {
 	m.Match(`reflect.ValueOf($x).Type()`).Suggest(`reflect.TypeOf($x)`)
 
@@ -804,6 +1183,7 @@ func reflectType(m dsl.Matcher) {
 
 //doc:summary Detects reflect Value.Type().Kind() that can be simplified to Value.Kind()
 //doc:tags    o1 score2
+//doc:impact  cpu
 //doc:before  v.Type().Kind()
 //doc:after   v.Kind()
 func reflectValueKind(m dsl.Matcher) {
@@ -814,6 +1194,7 @@ func reflectValueKind(m dsl.Matcher) {
 
 //doc:summary Detects array copies that can be optimized
 //doc:tags    o1 score2
+//doc:impact  cpu
 func arrayCopy(m dsl.Matcher) {
 	// TODO: how to handle copy($x[:], $y[:]) when it's
 	// pointers to arrays, not just arrays?
@@ -825,8 +1206,29 @@ func arrayCopy(m dsl.Matcher) {
 		Suggest(`$x = $y`)
 }
 
+//doc:summary Detects copy arguments that are resliced to the other argument length
+//doc:tags    o1 score1
+//doc:impact  readability
+//doc:before  copy(dst, src[:len(dst)])
+//doc:after   copy(dst, src)
+//doc:note    the rewrite is only equivalent if len(src) >= len(dst), so there is no autofix
+func copyReslice(m dsl.Matcher) {
+	// copy already stops at min(len(dst), len(src)),
+	// reslicing one of the arguments to the other one length
+	// is redundant if that argument is not shorter.
+	// Otherwise, the reslice extends the argument into its capacity
+	// or panics, and the rewrite would copy fewer elements.
+	m.Match(`copy($dst, $src[:len($dst)])`, `copy($dst, $src[0:len($dst)])`).
+		Where(m["dst"].Pure && m["src"].Pure).
+		Report(`$$ can be replaced with copy($dst, $src) if len($src) >= len($dst)`)
+	m.Match(`copy($dst[:len($src)], $src)`, `copy($dst[0:len($src)], $src)`).
+		Where(m["dst"].Pure && m["src"].Pure).
+		Report(`$$ can be replaced with copy($dst, $src) if len($dst) >= len($src)`)
+}
+
 //doc:summary Detects binary.Write uses that can be optimized
 //doc:tags    o1 score3
+//doc:impact  alloc
 func binaryWrite(m dsl.Matcher) {
 	m.Match(`$err := binary.Write($w, $_, $b)`).
 		Where(m["b"].Type.Is(`[]byte`)).
@@ -847,6 +1249,7 @@ func binaryWrite(m dsl.Matcher) {
 
 //doc:summary Detects sync.Pool usage on non pointer objects
 //doc:tags    o1 score3
+//doc:impact  alloc
 func syncPoolPut(m dsl.Matcher) {
 	isPtrLike := func(x dsl.Var) bool {
 		return x.Type.Underlying().Is("*$_") || x.Type.Underlying().Is("chan $_") ||
@@ -862,6 +1265,7 @@ func syncPoolPut(m dsl.Matcher) {
 
 //doc:summary Detects trim calls that can be optimized
 //doc:tags    o1 score2
+//doc:impact  cpu
 //doc:before  strings.TrimFunc(s, unicode.IsSpace)
 //doc:after   strings.TrimSpace(s)
 func trim(m dsl.Matcher) {
@@ -889,6 +1293,7 @@ func trim(m dsl.Matcher) {
 
 //doc:summary Detects redundant nil checks
 //doc:tags    o1 score1
+//doc:impact  cpu
 //doc:before  b == nil || len(b) == 0
 //doc:after   len(b) == 0
 func redundantNilCheck(m dsl.Matcher) {
@@ -902,6 +1307,7 @@ func redundantNilCheck(m dsl.Matcher) {
 
 //doc:summary Detects strings.Compare calls that can be optimized
 //doc:tags    o1 score1
+//doc:impact  cpu
 //doc:before  strings.Compare(s1, s2) == 0
 //doc:after   s1 == s2
 func stringsCompare(m dsl.Matcher) {
@@ -921,7 +1327,8 @@ func stringsCompare(m dsl.Matcher) {
 
 //doc:summary Detects bytes.Compare calls that can be optimized
 //doc:tags    o1 score1
-//doc:before  bytes.Compare(b1, b1) == 0
+//doc:impact  cpu
+//doc:before  bytes.Compare(b1, b2) == 0
 //doc:after   bytes.Equal(b1, b2)
 func bytesCompare(m dsl.Matcher) {
 	m.Match(`bytes.Compare($a, $b) == 0`).Suggest(`bytes.Equal($a, $b)`)
@@ -930,6 +1337,7 @@ func bytesCompare(m dsl.Matcher) {
 
 //doc:summary Detects suitable places for slice literals
 //doc:tags    o1 score2
+//doc:impact  alloc
 //doc:before  append([]byte, b1, b2)
 //doc:after   []byte{b1, b2}
 func sliceLit(m dsl.Matcher) {
@@ -945,6 +1353,7 @@ func sliceLit(m dsl.Matcher) {
 
 //doc:summary Detects math package expressions that can be optimized
 //doc:tags    o1 score1
+//doc:impact  cpu
 //doc:before  math.Abs(x) * math.Abs(y)
 //doc:after   math.Abs(x * y)
 func mathExpr(m dsl.Matcher) {
@@ -952,8 +1361,23 @@ func mathExpr(m dsl.Matcher) {
 	m.Match(`math.Abs($x) / math.Abs($y)`).Suggest(`math.Abs(($x) / ($y))`)
 }
 
+//doc:summary Detects integer abs computed via float64 conversions
+//doc:tags    o1 score3
+//doc:impact  cpu
+//doc:before  int(math.Abs(float64(x)))
+//doc:after   abs(x) // func abs(x int) int { if x < 0 { return -x }; return x }
+func intAbs(m dsl.Matcher) {
+	// Conversions are not free and float64 can't represent
+	// all int64 values precisely, so the result can be wrong for big ints.
+	// There is no integer abs in stdlib, so we can't provide an autofix.
+	m.Match(`$typ(math.Abs(float64($x)))`).
+		Where(m["x"].Type.Underlying().OfKind("integer") && m["typ"].Type.Underlying().OfKind("integer")).
+		Report(`use an integer abs helper instead of $$: func abs(x int) int { if x < 0 { return -x }; return x }`)
+}
+
 //doc:summary Detects io.Copy calls that can be optimized
 //doc:tags    o1 score3
+//doc:impact  alloc
 //doc:before  io.Copy(dst, src)
 //doc:after   src.WriteTo(dst)
 func ioCopy(m dsl.Matcher) {
@@ -978,6 +1402,7 @@ func ioCopy(m dsl.Matcher) {
 
 //doc:summary Detects places where bufio should and shouldn't be used
 //doc:tags    o1 score4
+//doc:impact  cpu
 //doc:before  bufio.Reader(bytes.NewReader(data))
 //doc:after   bytes.NewReader(data)
 func bufio(m dsl.Matcher) {
@@ -992,3 +1417,62 @@ func bufio(m dsl.Matcher) {
 		Where(isInmemoryReader(m["r"]) && m["$$"].SinkType.Is(`io.Reader`)).
 		Suggest(`$r`)
 }
+
+//doc:summary Detects struct load-modify-store sequences that can modify the value in place
+//doc:tags    o2 score2
+//doc:impact  cpu
+//doc:before  v := *p; v.Field = x; *p = v
+//doc:after   p.Field = x
+//doc:note    only structs that are bigger than 64 bytes are reported
+func derefStore(m dsl.Matcher) {
+	// The statements must go one after another: if there is anything
+	// between them, the local copy may be observed or *p may be changed.
+	// After the store, the local copy is equal to *p, its later
+	// usages can be replaced with *p if needed; that's why this
+	// rule is report-only.
+	isBigStruct := func(v dsl.Var) bool {
+		return v.Type.Underlying().Is(`struct{$*_}`) && v.Type.Size > 64
+	}
+
+	m.Match(`$v := *$p; $v.$f = $x; *$p = $v`).
+		Where(isBigStruct(m["v"]) && m["p"].Pure && !m["x"].Contains(`$v`)).
+		Report(`$v is a copy of *$p that is stored back, use $p.$f = $x to modify it in place`)
+
+	m.Match(`$v := *$p; $v.$f1 = $x1; $v.$f2 = $x2; *$p = $v`).
+		Where(isBigStruct(m["v"]) && m["p"].Pure && !m["x1"].Contains(`$v`) && !m["x2"].Contains(`$v`)).
+		Report(`$v is a copy of *$p that is stored back, use $p.$f1 = $x1; $p.$f2 = $x2 to modify it in place`)
+}
+
+//doc:summary Detects strings.Replace and bytes.Replace calls that can use ReplaceAll
+//doc:tags    o1 score1
+//doc:impact  readability
+//doc:before  strings.Replace(s, old, new, -1)
+//doc:after   strings.ReplaceAll(s, old, new)
+//doc:note    only a literal -1 is matched, a variable n may have another value
+func replaceAll(m dsl.Matcher) {
+	// ReplaceAll is a Replace wrapper, so there is no performance
+	// difference; the intent is clearer though.
+	m.Match(`strings.Replace($s, $old, $new, -1)`).
+		Where(m.GoVersion().GreaterEqThan("1.12")).
+		Suggest(`strings.ReplaceAll($s, $old, $new)`)
+	m.Match(`bytes.Replace($b, $old, $new, -1)`).
+		Where(m.GoVersion().GreaterEqThan("1.12")).
+		Suggest(`bytes.ReplaceAll($b, $old, $new)`)
+}
+
+//doc:summary Detects time.Now().Sub() calls that can use time.Since or time.Until
+//doc:tags    o1 score1
+//doc:impact  readability
+//doc:before  elapsed := time.Now().Sub(start)
+//doc:after   elapsed := time.Since(start)
+func timeSince(m dsl.Matcher) {
+	// time.Until(t) is t.Sub(time.Now()), so it's not negated.
+	// The time.Now() receiver or argument is matched literally:
+	// a stored time.Now() result is a different moment.
+	m.Match(`time.Now().Sub($x)`).
+		Where(m["x"].Type.Is(`time.Time`)).
+		Suggest(`time.Since($x)`)
+	m.Match(`$x.Sub(time.Now())`).
+		Where(m["x"].Type.Is(`time.Time`)).
+		Suggest(`time.Until($x)`)
+}
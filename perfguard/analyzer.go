@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/token"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,18 +13,32 @@ import (
 	"github.com/quasilyte/perf-heatmap/heatmap"
 
 	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
 	"github.com/quasilyte/go-perfguard/perfguard/lint"
 	"github.com/quasilyte/go-perfguard/perfguard/rulesdata"
 )
 
 //go:generate go run ./_rules/precompile/precompile.go -varname Universal -rules ./_rules/universal_rules.go -o ./rulesdata/universal_rules.go
 //go:generate go run ./_rules/precompile/precompile.go -varname Opt -rules ./_rules/opt_rules.go -o ./rulesdata/opt_rules.go
+//go:generate go run ./_rules/precompile/precompile.go -lint -varname Lint -rules ./_rules/lint_rules.go -o ./rulesdata/lint_rules.go
 
 type analyzer struct {
 	rulesEngine *ruleguard.Engine
 
+	ruleDebugger *ruleDebugger
+
+	// ruleImpacts maps rule group names to their doc:impact values.
+	ruleImpacts map[string]string
+
 	checkers []*targetChecker
 
+	// ruleNames are the loaded rule groups and checkers names.
+	ruleNames []string
+
+	// onlyEnabled is set if config.EnableRules lists anything
+	// besides the opt-in checkers, then only the listed rules run.
+	onlyEnabled bool
+
 	goVersion ruleguard.GoVersion
 	config    *Config
 }
@@ -34,8 +49,60 @@ func newAnalyzer() *analyzer {
 
 func (a *analyzer) Init(config *Config) error {
 	a.config = config
-	a.checkers = createCheckers(config)
-	return a.initRulesEngine()
+	if err := a.initRulesFilter(); err != nil {
+		return err
+	}
+	a.checkers = createCheckers(config, a.onlyEnabled)
+	a.ruleNames = checkers.Names(checkersFilter(config, a.onlyEnabled))
+	if err := a.initRulesEngine(); err != nil {
+		return err
+	}
+	sort.Strings(a.ruleNames)
+	return nil
+}
+
+func (a *analyzer) initRulesFilter() error {
+	known := make(map[string]struct{})
+	for _, name := range registeredRules() {
+		known[name] = struct{}{}
+	}
+	var unknown []string
+	for _, set := range []map[string]struct{}{a.config.EnableRules, a.config.DisableRules} {
+		for name := range set {
+			if _, ok := known[name]; !ok {
+				unknown = append(unknown, name)
+			}
+		}
+	}
+	if len(unknown) != 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown rules: %s; valid names are: %s",
+			strings.Join(unknown, ", "), strings.Join(registeredRules(), ", "))
+	}
+
+	optIn := make(map[string]struct{})
+	for _, name := range checkers.Names(func(doc checkers.Doc) bool { return doc.OptIn }) {
+		optIn[name] = struct{}{}
+	}
+	for name := range a.config.EnableRules {
+		if _, ok := optIn[name]; !ok {
+			a.onlyEnabled = true
+			break
+		}
+	}
+	return nil
+}
+
+// registeredRules returns the sorted names of all rule groups and checkers.
+func registeredRules() []string {
+	names := checkers.Names(func(doc checkers.Doc) bool { return true })
+	for _, f := range []*ir.File{rulesdata.Universal, rulesdata.Opt, rulesdata.Lint} {
+		for _, g := range f.RuleGroups {
+			names = append(names, g.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
 }
 
 func (a *analyzer) initRulesEngine() error {
@@ -46,28 +113,50 @@ func (a *analyzer) initRulesEngine() error {
 	a.goVersion = goVersion
 
 	rulesEngine := ruleguard.NewEngine()
+	if a.config.DebugRule != "" {
+		a.ruleDebugger = newRuleDebugger(a.config.DebugRule, a.config.DebugPrint)
+	}
 
 	fset := token.NewFileSet()
 	loadContext := ruleguard.LoadContext{
 		Fset: fset,
+		GroupFilter: func(g *ruleguard.GoRuleGroup) bool {
+			return a.config.ruleEnabled(g.Name, false, a.onlyEnabled) &&
+				a.config.impactEnabled(a.ruleImpacts[g.Name])
+		},
 	}
 
 	toLoad := []struct {
 		filename string
 		ir       *ir.File
+		impacts  map[string]string
 		enabled  bool
 	}{
-		{"universal_rules.go", rulesdata.Universal, a.config.LoadUniversalRules},
-		{"opt_rules.go", rulesdata.Opt, a.config.LoadOptRules},
-		// {"lint_rules.go", rulesdata.Lint, a.config.LoadLintRules},
+		{"universal_rules.go", rulesdata.Universal, rulesdata.UniversalImpact, a.config.LoadUniversalRules},
+		{"opt_rules.go", rulesdata.Opt, rulesdata.OptImpact, a.config.LoadOptRules},
+		{"lint_rules.go", rulesdata.Lint, rulesdata.LintImpact, a.config.LoadLintRules},
 	}
+	a.ruleImpacts = make(map[string]string)
 	for _, x := range toLoad {
 		if !x.enabled {
 			continue
 		}
+		for name, impact := range x.impacts {
+			a.ruleImpacts[name] = impact
+		}
+		for _, g := range x.ir.RuleGroups {
+			if a.config.ruleEnabled(g.Name, false, a.onlyEnabled) && a.config.impactEnabled(x.impacts[g.Name]) {
+				a.ruleNames = append(a.ruleNames, g.Name)
+			}
+		}
 		if err := rulesEngine.LoadFromIR(&loadContext, x.filename, x.ir); err != nil {
 			return err
 		}
+		if a.ruleDebugger != nil {
+			if err := a.ruleDebugger.AddFile(x.filename, x.ir); err != nil {
+				return err
+			}
+		}
 	}
 
 	a.rulesEngine = rulesEngine
@@ -115,18 +204,25 @@ func (a *analyzer) runRules(target *lint.Target) error {
 		Fset:        target.Fset,
 		GoVersion:   a.goVersion,
 		TruncateLen: 100,
+		Debug:       a.config.DebugRule,
+		DebugPrint:  a.config.DebugPrint,
 	}
 
 	var currentFile *lint.SourceFile
 
 	ruleguardContext.Report = func(data *ruleguard.ReportData) {
+		if a.ruleDebugger != nil && data.RuleInfo.Group.Name == a.config.DebugRule {
+			a.ruleDebugger.PrintMatch(target, currentFile.Syntax, data)
+		}
+
 		startPos := target.Fset.Position(data.Node.Pos())
+		endPos := target.Fset.Position(data.Node.End())
 
 		samplesTime := time.Duration(0)
+		heatLevel := 0
 		if a.config.Heatmap != nil {
 			minLevel := a.minHeatLevel(&data.RuleInfo)
 			if minLevel != 0 {
-				endPos := target.Fset.Position(data.Node.End())
 				lineFrom := startPos.Line
 				lineTo := endPos.Line
 				isHot := false
@@ -142,6 +238,9 @@ func (a *analyzer) runRules(target *lint.Target) error {
 					if l.GlobalHeatLevel >= minLevel {
 						isHot = true
 					}
+					if l.GlobalHeatLevel > heatLevel {
+						heatLevel = l.GlobalHeatLevel
+					}
 					totalValue += l.Value
 					return true
 				})
@@ -169,10 +268,16 @@ func (a *analyzer) runRules(target *lint.Target) error {
 		a.config.Warn(lint.Warning{
 			Filename:    startPos.Filename,
 			Line:        startPos.Line,
+			Column:      startPos.Column,
+			EndLine:     endPos.Line,
+			EndColumn:   endPos.Column,
 			Tag:         data.RuleInfo.Group.Name,
+			DocTags:     data.RuleInfo.Group.DocTags,
 			Text:        message,
 			Fixes:       fixes,
+			Impact:      a.ruleImpacts[data.RuleInfo.Group.Name],
 			SamplesTime: samplesTime,
+			HeatLevel:   heatLevel,
 		})
 	}
 
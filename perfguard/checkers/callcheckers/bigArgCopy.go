@@ -12,8 +12,9 @@ import (
 
 func init() {
 	doc := checkers.Doc{
-		Name:  "bigArgCopy",
-		Score: 2,
+		Name:   "bigArgCopy",
+		Score:  2,
+		Impact: "cpu",
 	}
 	checkers.RegisterCallChecker(doc, func() checkers.CallChecker {
 		return &bigArgCopyChecker{}
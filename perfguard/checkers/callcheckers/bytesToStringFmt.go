@@ -13,8 +13,9 @@ import (
 
 func init() {
 	doc := checkers.Doc{
-		Name:  "bytesToStringFmt",
-		Score: 2,
+		Name:   "bytesToStringFmt",
+		Score:  2,
+		Impact: "alloc",
 	}
 	checkers.RegisterCallChecker(doc, func() checkers.CallChecker {
 		return &BytesToStringFmtChecker{}
@@ -0,0 +1,84 @@
+package callcheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "convRoundTrip",
+		Score:    2,
+		LintOnly: true,
+		Impact:   "readability",
+	}
+	checkers.RegisterCallChecker(doc, func() checkers.CallChecker {
+		return &convRoundTripChecker{}
+	})
+}
+
+// convRoundTripChecker finds conversions to another named type
+// that are converted back right away:
+//
+//	type Celsius float64
+//	type Fahrenheit float64
+//	Celsius(Fahrenheit(c)) // c is Celsius
+//
+// The conversion between types with identical underlying types
+// doesn't change the value, so the round trip is a no-op.
+// It's often a leftover of a refactoring or a sign of a unit mix-up.
+//
+// The outer conversion result type should be identical to the
+// converted value type, and the intermediate type should have
+// the identical underlying type. Conversions between numeric types
+// with different underlying types, like int(float64(x)), change
+// the value and are not reported.
+type convRoundTripChecker struct{}
+
+func (c *convRoundTripChecker) CheckCall(ctx *lint.Context, outer *ast.CallExpr) error {
+	outerType := c.conversionType(ctx, outer)
+	if outerType == nil {
+		return nil
+	}
+	inner, ok := outer.Args[0].(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	innerType := c.conversionType(ctx, inner)
+	if innerType == nil {
+		return nil
+	}
+	x := inner.Args[0]
+	xType := ctx.Target.Types.TypeOf(x)
+	if xType == nil || !types.Identical(xType, outerType) {
+		return nil
+	}
+	if !types.Identical(innerType.Underlying(), outerType.Underlying()) {
+		return nil
+	}
+
+	ctx.SuggestNode(lint.SuggestParams{
+		OldNode: outer,
+		NewNode: x,
+		Message: fmt.Sprintf("%s => %s, the conversion to %s and back is a no-op",
+			ctx.NodeText(outer), ctx.NodeText(x), ctx.NodeText(inner.Fun)),
+	})
+
+	return nil
+}
+
+// conversionType returns the call result type if it's a conversion.
+func (c *convRoundTripChecker) conversionType(ctx *lint.Context, call *ast.CallExpr) types.Type {
+	if len(call.Args) != 1 {
+		return nil
+	}
+	tv, ok := ctx.Target.Types.Types[call.Fun]
+	if !ok || !tv.IsType() {
+		return nil
+	}
+	return tv.Type
+}
@@ -0,0 +1,129 @@
+package callcheckers
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"strings"
+
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "doubleWrap",
+		Score:    1,
+		LintOnly: true,
+	}
+	checkers.RegisterCallChecker(doc, func() checkers.CallChecker {
+		return &doubleWrapChecker{}
+	})
+}
+
+// doubleWrapChecker finds fmt.Errorf calls that wrap another
+// fmt.Errorf result with the same context:
+//
+//	fmt.Errorf("read config: %w", fmt.Errorf("read config: %w", err))
+//
+// This is usually a copy-paste mistake.
+//
+// The outer format string is scanned for the %w verb to find its argument.
+// The argument should be a fmt.Errorf call with a %w verb too.
+// The format parts before %w (the context) are compared;
+// if they contain other verbs, the arguments consumed by them
+// must be identical as well. Explicit argument indexes are not supported.
+type doubleWrapChecker struct{}
+
+type doubleWrapFormat struct {
+	// prefix is a format string part before the %w verb.
+	prefix string
+
+	// argIndex is a %w verb argument index.
+	argIndex int
+}
+
+func (c *doubleWrapChecker) CheckCall(ctx *lint.Context, outer *ast.CallExpr) error {
+	if ctx.Sym.PkgPath != "fmt" || ctx.Sym.FuncName != "Errorf" {
+		return nil
+	}
+	outerFormat, ok := c.parseFormat(ctx, outer)
+	if !ok || outerFormat.argIndex+1 >= len(outer.Args) {
+		return nil
+	}
+	inner, ok := outer.Args[outerFormat.argIndex+1].(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	sym := resolve.Call(ctx.Target.Types, inner)
+	if sym.PkgPath != "fmt" || sym.FuncName != "Errorf" {
+		return nil
+	}
+	innerFormat, ok := c.parseFormat(ctx, inner)
+	if !ok || innerFormat.prefix != outerFormat.prefix || innerFormat.argIndex != outerFormat.argIndex {
+		return nil
+	}
+	if strings.TrimSpace(outerFormat.prefix) == "" {
+		return nil
+	}
+	// The args before %w are a part of the context.
+	for i := 1; i <= outerFormat.argIndex; i++ {
+		if i >= len(inner.Args) || !bytes.Equal(ctx.NodeText(outer.Args[i]), ctx.NodeText(inner.Args[i])) {
+			return nil
+		}
+	}
+
+	ctx.Report(lint.ReportParams{
+		PosNode: outer,
+		Message: fmt.Sprintf("the error is wrapped twice with the same %q context", outerFormat.prefix),
+	})
+
+	return nil
+}
+
+func (c *doubleWrapChecker) parseFormat(ctx *lint.Context, call *ast.CallExpr) (doubleWrapFormat, bool) {
+	var result doubleWrapFormat
+	if len(call.Args) < 2 || call.Ellipsis.IsValid() {
+		return result, false
+	}
+	tv, ok := ctx.Target.Types.Types[call.Args[0]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return result, false
+	}
+	s := constant.StringVal(tv.Value)
+
+	argIndex := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			continue
+		}
+		// Skip the flags, width and precision to get to the verb.
+		j := i + 1
+		for j < len(s) && strings.IndexByte("+-# 0123456789.*", s[j]) != -1 {
+			if s[j] == '*' {
+				argIndex++
+			}
+			j++
+		}
+		if j == len(s) {
+			return result, false
+		}
+		switch s[j] {
+		case '%':
+			// Not a verb.
+		case '[':
+			return result, false
+		case 'w':
+			result.prefix = s[:i]
+			result.argIndex = argIndex
+			return result, true
+		default:
+			argIndex++
+		}
+		i = j
+	}
+
+	return result, false
+}
@@ -0,0 +1,113 @@
+package callcheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "jsonTags",
+		Score:    1,
+		LintOnly: true,
+		OptIn:    true,
+	}
+	checkers.RegisterCallChecker(doc, func() checkers.CallChecker {
+		return &jsonTagsChecker{}
+	})
+}
+
+// jsonTagsChecker finds json.Marshal arguments of a struct type
+// that have exported fields without a json tag.
+// The keys of such fields default to the Go field names,
+// which is often not intended.
+//
+// Only the static type of the json.Marshal and json.MarshalIndent
+// argument is inspected; the values that reach the encoder in other ways
+// are not tracked. Pointers, slices, arrays and map values are unwrapped
+// to get to the struct type. Embedded fields are skipped as they're
+// flattened into the outer object, types with MarshalJSON method are
+// skipped as well.
+//
+// This is a matter of the style and the API contract, not performance or
+// correctness, so the checker needs to be enabled with -enable flag.
+type jsonTagsChecker struct{}
+
+func (c *jsonTagsChecker) CheckCall(ctx *lint.Context, call *ast.CallExpr) error {
+	if ctx.Sym.PkgPath != "encoding/json" {
+		return nil
+	}
+	switch ctx.Sym.FuncName {
+	case "Marshal", "MarshalIndent":
+		// OK.
+	default:
+		return nil
+	}
+	if len(call.Args) == 0 {
+		return nil
+	}
+
+	typ := ctx.TypeOf(call.Args[0])
+	if typ == nil {
+		return nil
+	}
+	typ = c.elemType(typ)
+	st, ok := typ.Underlying().(*types.Struct)
+	if !ok || c.hasMarshalJSON(typ) {
+		return nil
+	}
+
+	var untagged []string
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if !field.Exported() || field.Embedded() {
+			continue
+		}
+		if _, ok := reflect.StructTag(st.Tag(i)).Lookup("json"); ok {
+			continue
+		}
+		untagged = append(untagged, field.Name())
+	}
+	if len(untagged) == 0 {
+		return nil
+	}
+
+	ctx.Report(lint.ReportParams{
+		PosNode: call.Args[0],
+		Message: fmt.Sprintf("%s fields without json tags use the Go field names as keys: %s",
+			types.TypeString(typ, types.RelativeTo(ctx.Target.Pkg)), strings.Join(untagged, ", ")),
+	})
+
+	return nil
+}
+
+func (c *jsonTagsChecker) elemType(typ types.Type) types.Type {
+	// The depth is limited as recursive types like `type T []T` are possible.
+	for depth := 0; depth < 8; depth++ {
+		switch t := typ.Underlying().(type) {
+		case *types.Pointer:
+			typ = t.Elem()
+		case *types.Slice:
+			typ = t.Elem()
+		case *types.Array:
+			typ = t.Elem()
+		case *types.Map:
+			typ = t.Elem()
+		default:
+			return typ
+		}
+	}
+	return typ
+}
+
+func (c *jsonTagsChecker) hasMarshalJSON(typ types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(typ), true, nil, "MarshalJSON")
+	_, isFunc := obj.(*types.Func)
+	return isFunc
+}
@@ -22,10 +22,16 @@ type funcCheckerInfo struct {
 	new func() FuncChecker
 }
 
+type pkgCheckerInfo struct {
+	doc Doc
+	new func() PkgChecker
+}
+
 var (
 	callCheckers = make(map[string]callCheckerInfo)
 	stmtCheckers = make(map[string]stmtCheckerInfo)
 	funcCheckers = make(map[string]funcCheckerInfo)
+	pkgCheckers  = make(map[string]pkgCheckerInfo)
 )
 
 type Doc struct {
@@ -35,6 +41,16 @@ type Doc struct {
 	OptLevel int
 
 	NeedsProfile bool
+
+	// LintOnly checkers are not executed in the optimize mode.
+	LintOnly bool
+
+	// OptIn checkers are only executed if they're enabled explicitly,
+	// see perfguard.Config.EnableRules.
+	OptIn bool
+
+	// Impact is the same as //doc:impact for the rules.
+	Impact string
 }
 
 type CallChecker interface {
@@ -45,10 +61,20 @@ type StmtChecker interface {
 	CheckStmt(ctx *lint.Context, stmt ast.Stmt) error
 }
 
+// FuncChecker is executed for every function and function literal body.
+// The nested function literals are checked separately, so the checkers
+// usually don't descend into them.
 type FuncChecker interface {
 	CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error
 }
 
+// PkgChecker is executed once per package.
+// It's useful for the checks that need to see all package files at once,
+// like the usages of some type.
+type PkgChecker interface {
+	CheckPkg(ctx *lint.Context, files []lint.SourceFile) error
+}
+
 type PackageChecker interface {
 	CheckPackage(ctx *lint.SharedContext, files []lint.SourceFile) error
 }
@@ -83,6 +109,16 @@ func RegisterFuncChecker(doc Doc, constructor func() FuncChecker) {
 	}
 }
 
+func RegisterPkgChecker(doc Doc, constructor func() PkgChecker) {
+	if _, ok := pkgCheckers[doc.Name]; ok {
+		panic(fmt.Sprintf("%s pkg checker is already registered", doc.Name))
+	}
+	pkgCheckers[doc.Name] = pkgCheckerInfo{
+		doc: doc,
+		new: constructor,
+	}
+}
+
 func minHeatLevel(doc *Doc) int {
 	if doc.OptLevel == 2 {
 		return 5
@@ -90,12 +126,46 @@ func minHeatLevel(doc *Doc) int {
 	return 1
 }
 
+// docTags returns the checker tags in the rules doc:tags form.
+func docTags(doc *Doc) []string {
+	var tags []string
+	if doc.OptLevel != 0 {
+		tags = append(tags, fmt.Sprintf("o%d", doc.OptLevel))
+	}
+	tags = append(tags, fmt.Sprintf("score%d", doc.Score))
+	return tags
+}
+
+// Names returns the names of the registered checkers that pass the filter.
+// The order is unspecified.
+func Names(filter func(doc Doc) bool) []string {
+	var names []string
+	add := func(doc Doc) {
+		if filter(doc) {
+			names = append(names, doc.Name)
+		}
+	}
+	for _, c := range callCheckers {
+		add(c.doc)
+	}
+	for _, c := range stmtCheckers {
+		add(c.doc)
+	}
+	for _, c := range funcCheckers {
+		add(c.doc)
+	}
+	for _, c := range pkgCheckers {
+		add(c.doc)
+	}
+	return names
+}
+
 func Create(filter func(doc Doc) bool) []PackageChecker {
 	callChecker := &callcheckerWalker{}
 	for _, c := range callCheckers {
 		if filter(c.doc) {
 			callChecker.checkers = append(callChecker.checkers, callcheckerWithContext{
-				ctx: lint.NewContext(c.doc.Name, minHeatLevel(&c.doc)),
+				ctx: lint.NewContext(c.doc.Name, minHeatLevel(&c.doc), c.doc.Impact, docTags(&c.doc)),
 				obj: c.new(),
 			})
 		}
@@ -105,7 +175,7 @@ func Create(filter func(doc Doc) bool) []PackageChecker {
 	for _, c := range stmtCheckers {
 		if filter(c.doc) {
 			stmtChecker.checkers = append(stmtChecker.checkers, stmtcheckerWithContext{
-				ctx: lint.NewContext(c.doc.Name, minHeatLevel(&c.doc)),
+				ctx: lint.NewContext(c.doc.Name, minHeatLevel(&c.doc), c.doc.Impact, docTags(&c.doc)),
 				obj: c.new(),
 			})
 		}
@@ -115,7 +185,17 @@ func Create(filter func(doc Doc) bool) []PackageChecker {
 	for _, c := range funcCheckers {
 		if filter(c.doc) {
 			funcChecker.checkers = append(funcChecker.checkers, funccheckerWithContext{
-				ctx: lint.NewContext(c.doc.Name, minHeatLevel(&c.doc)),
+				ctx: lint.NewContext(c.doc.Name, minHeatLevel(&c.doc), c.doc.Impact, docTags(&c.doc)),
+				obj: c.new(),
+			})
+		}
+	}
+
+	pkgChecker := &pkgcheckerWalker{}
+	for _, c := range pkgCheckers {
+		if filter(c.doc) {
+			pkgChecker.checkers = append(pkgChecker.checkers, pkgcheckerWithContext{
+				ctx: lint.NewContext(c.doc.Name, minHeatLevel(&c.doc), c.doc.Impact, docTags(&c.doc)),
 				obj: c.new(),
 			})
 		}
@@ -125,7 +205,8 @@ func Create(filter func(doc Doc) bool) []PackageChecker {
 	result = append(result,
 		callChecker,
 		stmtChecker,
-		funcChecker)
+		funcChecker,
+		pkgChecker)
 
 	return result
 }
@@ -0,0 +1,169 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "appendChain",
+		Score:    2,
+		LintOnly: true,
+		Impact:   "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &appendChainChecker{}
+	})
+}
+
+// appendChainChecker finds empty slices that are filled right away
+// by the appends of constant values.
+// A composite literal is more readable and allocates only once.
+//
+// The chain starts with `s := []T{}`, `s := make([]T, 0)` or `var s []T`
+// and continues while the next statement is `s = append(s, c1, ...)`
+// with constant elements only. Any other statement ends the chain.
+// The chain is reported if it has at least 2 appends.
+//
+// There is no autofix as the rewrite spans several statements.
+type appendChainChecker struct {
+	ctx *lint.Context
+}
+
+func (c *appendChainChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	walkStmtLists(body, c.checkStmtList)
+
+	return nil
+}
+
+func (c *appendChainChecker) checkStmtList(list []ast.Stmt) {
+	for i, stmt := range list {
+		obj, typeExpr := c.matchInit(stmt)
+		if obj == nil {
+			continue
+		}
+		var elems []string
+		numAppends := 0
+		for _, next := range list[i+1:] {
+			args := c.matchAppend(next, obj)
+			if args == nil {
+				break
+			}
+			numAppends++
+			for _, arg := range args {
+				elems = append(elems, string(c.ctx.NodeText(arg)))
+			}
+		}
+		if numAppends < 2 {
+			continue
+		}
+		c.ctx.Report(lint.ReportParams{
+			PosNode: stmt,
+			Message: fmt.Sprintf("%s can be initialized with %s{%s} instead of %d appends",
+				obj.Name(), c.ctx.NodeText(typeExpr), strings.Join(elems, ", "), numAppends),
+		})
+	}
+}
+
+// matchInit returns the slice var object and its type expr
+// if stmt declares an empty slice.
+func (c *appendChainChecker) matchInit(stmt ast.Stmt) (types.Object, ast.Expr) {
+	switch stmt := stmt.(type) {
+	case *ast.DeclStmt:
+		decl, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.VAR || len(decl.Specs) != 1 {
+			return nil, nil
+		}
+		spec := decl.Specs[0].(*ast.ValueSpec)
+		if len(spec.Names) != 1 || len(spec.Values) != 0 || spec.Type == nil {
+			return nil, nil
+		}
+		return c.sliceObject(spec.Names[0]), spec.Type
+
+	case *ast.AssignStmt:
+		if stmt.Tok != token.DEFINE || len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 {
+			return nil, nil
+		}
+		id, ok := stmt.Lhs[0].(*ast.Ident)
+		if !ok {
+			return nil, nil
+		}
+		switch rhs := stmt.Rhs[0].(type) {
+		case *ast.CompositeLit:
+			if rhs.Type == nil || len(rhs.Elts) != 0 {
+				return nil, nil
+			}
+			return c.sliceObject(id), rhs.Type
+		case *ast.CallExpr:
+			called, ok := rhs.Fun.(*ast.Ident)
+			if !ok || called.Name != "make" || len(rhs.Args) != 2 {
+				return nil, nil
+			}
+			if _, ok := c.ctx.ObjectOf(called).(*types.Builtin); !ok {
+				return nil, nil
+			}
+			lengthArg, ok := rhs.Args[1].(*ast.BasicLit)
+			if !ok || lengthArg.Kind != token.INT || lengthArg.Value != `0` {
+				return nil, nil
+			}
+			return c.sliceObject(id), rhs.Args[0]
+		}
+	}
+
+	return nil, nil
+}
+
+// matchAppend returns the appended elements if stmt is
+// a `s = append(s, c1, ...)` with constant elements.
+func (c *appendChainChecker) matchAppend(stmt ast.Stmt, obj types.Object) []ast.Expr {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil
+	}
+	lhs, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || c.ctx.ObjectOf(lhs) != obj {
+		return nil
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 || call.Ellipsis.IsValid() {
+		return nil
+	}
+	called, ok := call.Fun.(*ast.Ident)
+	if !ok || called.Name != "append" {
+		return nil
+	}
+	if _, ok := c.ctx.ObjectOf(called).(*types.Builtin); !ok {
+		return nil
+	}
+	slice, ok := call.Args[0].(*ast.Ident)
+	if !ok || c.ctx.ObjectOf(slice) != obj {
+		return nil
+	}
+	for _, arg := range call.Args[1:] {
+		tv, ok := c.ctx.Target.Types.Types[arg]
+		if !ok || tv.Value == nil {
+			return nil
+		}
+	}
+	return call.Args[1:]
+}
+
+func (c *appendChainChecker) sliceObject(id *ast.Ident) types.Object {
+	obj := c.ctx.ObjectOf(id)
+	if obj == nil || isBlankIdent(id) {
+		return nil
+	}
+	if _, ok := obj.Type().Underlying().(*types.Slice); !ok {
+		return nil
+	}
+	return obj
+}
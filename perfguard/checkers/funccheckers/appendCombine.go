@@ -0,0 +1,199 @@
+package funccheckers
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/typep"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "appendCombine",
+		Score:    2,
+		OptLevel: 1,
+		Impact:   "cpu",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &appendCombineChecker{}
+	})
+}
+
+// appendCombineChecker finds consecutive appends to the same slice
+// that can be combined into a single variadic append:
+//
+//	s = append(s, a)
+//	s = append(s, b)
+//	s = append(s, c)
+//
+// It's replaced with `s = append(s, a, b, c)` that does only one
+// capacity check and grows the slice at most once.
+//
+// The chain continues while the next statement is `s = append(s, x, ...)`
+// with the same s destination, any other statement ends the chain.
+// The chain is reported if it has at least 2 appends.
+// The destination should be a side effect free expression, like s or p.items,
+// that is matched syntactically.
+//
+// The combined append evaluates all elements before appending them,
+// so the elements of the second and later appends should be
+// side effect free and must not refer to the destination.
+// The elements of the first append are evaluated before any
+// slice update in both cases, so they are not restricted.
+//
+// A chain of constants that follows an empty slice declaration
+// is left for the appendChain checker when it runs (in the lint mode).
+type appendCombineChecker struct {
+	ctx *lint.Context
+}
+
+func (c *appendCombineChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	walkStmtLists(body, c.checkStmtList)
+
+	return nil
+}
+
+func (c *appendCombineChecker) checkStmtList(list []ast.Stmt) {
+	for i := 0; i < len(list); i++ {
+		first := c.matchAppend(list[i])
+		if first == nil {
+			continue
+		}
+		dst := first.Lhs[0]
+		if !typep.SideEffectFree(c.ctx.Target.Types, dst) {
+			continue
+		}
+
+		var elems []ast.Expr
+		elems = append(elems, c.appendArgs(first)...)
+		last := first
+		numAppends := 1
+		for _, next := range list[i+1:] {
+			assign := c.matchAppend(next)
+			if assign == nil || !astequal.Expr(assign.Lhs[0], dst) {
+				break
+			}
+			args := c.appendArgs(assign)
+			if !c.isSafeToCombine(args, dst) {
+				break
+			}
+			elems = append(elems, args...)
+			last = assign
+			numAppends++
+		}
+		if numAppends < 2 {
+			continue
+		}
+		if c.ctx.LintRules && c.isAppendChain(list[:i], dst, list[i:i+numAppends]) {
+			i += numAppends - 1
+			continue
+		}
+
+		var buf bytes.Buffer
+		dstText := c.ctx.NodeText(dst)
+		buf.Write(dstText)
+		buf.WriteString(" = append(")
+		buf.Write(dstText)
+		for _, elem := range elems {
+			buf.WriteString(", ")
+			buf.Write(c.ctx.NodeText(elem))
+		}
+		buf.WriteString(")")
+		combinedText := buf.Bytes()
+		c.ctx.MultiChangeSuggest(lint.MultiChangeSuggestParams{
+			ReportPos:     first.Pos(),
+			ReportMessage: fmt.Sprintf("combine %d appends into %s", numAppends, combinedText),
+			OldNodes:      []ast.Node{&nodeRange{from: first.Pos(), to: last.End()}},
+			NewNodes:      []lint.NodeReplacement{{Text: combinedText}},
+		})
+		i += numAppends - 1
+	}
+}
+
+// matchAppend returns stmt as assign if it's a `s = append(s, x, ...)`.
+func (c *appendCombineChecker) matchAppend(stmt ast.Stmt) *ast.AssignStmt {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 || call.Ellipsis.IsValid() {
+		return nil
+	}
+	called, ok := call.Fun.(*ast.Ident)
+	if !ok || called.Name != "append" {
+		return nil
+	}
+	if _, ok := c.ctx.ObjectOf(called).(*types.Builtin); !ok {
+		return nil
+	}
+	if !astequal.Expr(call.Args[0], assign.Lhs[0]) {
+		return nil
+	}
+	return assign
+}
+
+func (c *appendCombineChecker) appendArgs(assign *ast.AssignStmt) []ast.Expr {
+	return assign.Rhs[0].(*ast.CallExpr).Args[1:]
+}
+
+func (c *appendCombineChecker) isSafeToCombine(args []ast.Expr, dst ast.Expr) bool {
+	for _, arg := range args {
+		if !typep.SideEffectFree(c.ctx.Target.Types, arg) {
+			return false
+		}
+		if c.refersToDst(arg, dst) {
+			return false
+		}
+	}
+	return true
+}
+
+// refersToDst reports whether e contains dst or any of the dst variables.
+func (c *appendCombineChecker) refersToDst(e, dst ast.Expr) bool {
+	found := false
+	ast.Inspect(dst, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if id, ok := n.(*ast.Ident); ok {
+			if obj, ok := c.ctx.ObjectOf(id).(*types.Var); ok && refersTo(c.ctx, e, obj) {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// isAppendChain reports whether the appends of constants follow
+// an empty slice declaration, so appendChain checker reports them.
+func (c *appendCombineChecker) isAppendChain(prev []ast.Stmt, dst ast.Expr, appends []ast.Stmt) bool {
+	if len(prev) == 0 {
+		return false
+	}
+	id, ok := dst.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	initChecker := appendChainChecker{ctx: c.ctx}
+	obj, _ := initChecker.matchInit(prev[len(prev)-1])
+	if obj == nil || obj != c.ctx.ObjectOf(id) {
+		return false
+	}
+	for _, stmt := range appends {
+		if initChecker.matchAppend(stmt, obj) == nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,213 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "appendReuse",
+		Score:    2,
+		OptLevel: 2,
+		Impact:   "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &appendReuseChecker{}
+	})
+}
+
+// appendReuseChecker finds slices that are re-allocated inside a loop
+// to be filled from scratch:
+//
+//	for _, row := range rows {
+//		buf = make([]byte, 0, cap(buf))
+//		buf = appendRow(buf, row)
+//		w.Write(buf)
+//	}
+//
+// The capacity of the old slice is kept, but a new backing array
+// is allocated on every iteration. `buf = buf[:0]` resets the length
+// and reuses the old array.
+//
+// The reuse is only correct if the old contents are not retained anywhere.
+// The slice var should be declared outside of the loops and it must not
+// be stored inside them: in the assignments to other vars, composite
+// literals, channel sends, closures, go and defer statements.
+// The slice that is passed to a function that retains it can't be detected,
+// so it's an o2 checker and there is no autofix.
+type appendReuseChecker struct {
+	ctx *lint.Context
+
+	// loops is a stack of the loops that enclose the current node.
+	loops []ast.Stmt
+}
+
+func (c *appendReuseChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.loops = c.loops[:0]
+
+	ast.Inspect(body, c.walk)
+
+	return nil
+}
+
+func (c *appendReuseChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		return false
+
+	case *ast.ForStmt:
+		c.loops = append(c.loops, n)
+		ast.Inspect(n.Body, c.walk)
+		c.loops = c.loops[:len(c.loops)-1]
+		return false
+
+	case *ast.RangeStmt:
+		c.loops = append(c.loops, n)
+		ast.Inspect(n.Body, c.walk)
+		c.loops = c.loops[:len(c.loops)-1]
+		return false
+
+	case *ast.AssignStmt:
+		if len(c.loops) != 0 {
+			c.checkAssign(n)
+		}
+	}
+
+	return true
+}
+
+func (c *appendReuseChecker) checkAssign(assign *ast.AssignStmt) {
+	// Match `s = make([]T, 0, cap(s))`.
+	if assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return
+	}
+	id, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return
+	}
+	obj, ok := c.ctx.ObjectOf(id).(*types.Var)
+	if !ok {
+		return
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 3 || !isBuiltinCall(c.ctx, call, "make") {
+		return
+	}
+	if lit, ok := call.Args[1].(*ast.BasicLit); !ok || lit.Value != "0" {
+		return
+	}
+	capCall, ok := call.Args[2].(*ast.CallExpr)
+	if !ok || len(capCall.Args) != 1 || !isBuiltinCall(c.ctx, capCall, "cap") || !isVar(c.ctx, capCall.Args[0], obj) {
+		return
+	}
+
+	// The outermost loop that doesn't contain the declaration
+	// re-allocates the slice on every iteration.
+	var loop ast.Stmt
+	for _, l := range c.loops {
+		if obj.Pos() < l.Pos() || obj.Pos() >= l.End() {
+			loop = l
+			break
+		}
+	}
+	if loop == nil || c.isStored(loop, obj) {
+		return
+	}
+
+	c.ctx.Report(lint.ReportParams{
+		PosNode: assign,
+		Message: fmt.Sprintf("use %[1]s = %[1]s[:0] to reuse the backing array instead of allocating a new one on every iteration; make sure the previous %[1]s contents are not retained elsewhere",
+			id.Name),
+		HotNodes: []ast.Node{assign},
+	})
+}
+
+// isStored reports whether the slice var may be retained inside the loop.
+func (c *appendReuseChecker) isStored(loop ast.Stmt, obj types.Object) bool {
+	stored := false
+	ast.Inspect(loop, func(n ast.Node) bool {
+		if stored {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			stored = refersTo(c.ctx, n, obj)
+		case *ast.GoStmt:
+			stored = refersTo(c.ctx, n.Call, obj)
+		case *ast.DeferStmt:
+			stored = refersTo(c.ctx, n.Call, obj)
+		case *ast.SendStmt:
+			stored = c.isAlias(n.Value, obj)
+		case *ast.CompositeLit:
+			for _, elt := range n.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					elt = kv.Value
+				}
+				if c.isAlias(elt, obj) {
+					stored = true
+				}
+			}
+		case *ast.ValueSpec:
+			for _, v := range n.Values {
+				if c.isAlias(v, obj) {
+					stored = true
+				}
+			}
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, rhs := range n.Rhs {
+				// Assigning the slice to itself, like in
+				// `s = append(s, x)`, is not a store.
+				if isVar(c.ctx, n.Lhs[i], obj) {
+					continue
+				}
+				if c.isAlias(rhs, obj) {
+					stored = true
+				}
+			}
+		}
+		return true
+	})
+	return stored
+}
+
+// isAlias reports whether e may share the backing array with the slice var.
+func (c *appendReuseChecker) isAlias(e ast.Expr, obj types.Object) bool {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return c.ctx.ObjectOf(e) == obj
+	case *ast.ParenExpr:
+		return c.isAlias(e.X, obj)
+	case *ast.SliceExpr:
+		return c.isAlias(e.X, obj)
+	case *ast.UnaryExpr:
+		return e.Op == token.AND && c.isAlias(e.X, obj)
+	case *ast.CallExpr:
+		// The other calls results are not tracked:
+		// a conversion like string(s) makes a copy.
+		if !isBuiltinCall(c.ctx, e, "append") {
+			return false
+		}
+		// The result may share the first argument array,
+		// the other arguments are stored as elements.
+		// The spread argument elements are copied.
+		for i, arg := range e.Args {
+			if i != 0 && i == len(e.Args)-1 && e.Ellipsis.IsValid() {
+				break
+			}
+			if c.isAlias(arg, obj) {
+				return true
+			}
+		}
+	}
+	return false
+}
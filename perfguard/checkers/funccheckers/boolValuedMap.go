@@ -11,8 +11,9 @@ import (
 
 func init() {
 	doc := checkers.Doc{
-		Name:  "boolValuedMap",
-		Score: 3,
+		Name:   "boolValuedMap",
+		Score:  3,
+		Impact: "alloc",
 	}
 	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
 		return &boolValuedMapChecker{
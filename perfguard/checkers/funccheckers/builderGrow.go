@@ -0,0 +1,233 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/internal/typeis"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "builderGrow",
+		Score:    2,
+		OptLevel: 2,
+		Impact:   "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &builderGrowChecker{
+			candidates: make(map[types.Object]*builderGrowCandidate),
+		}
+	})
+}
+
+// builderGrowChecker finds strings.Builder and bytes.Buffer vars that
+// are written inside a loop over a sized source without a Grow call.
+//
+// A candidate is a local var declared as `var b T`, `b := T{}`,
+// `b := &T{}` or `b := new(T)`, where T is one of the types above.
+// When a range loop over a slice, array, map or string is found,
+// its body is scanned for the Write* method calls on the candidates
+// declared before the loop. If there was no Grow call on that
+// candidate between its declaration and the loop, it's reported.
+// A Grow call inside the loop body suppresses the warning too.
+//
+// The bytes written per iteration are unknown, so there is no autofix;
+// only the first loop is reported for every candidate.
+type builderGrowChecker struct {
+	ctx *lint.Context
+
+	nestedFunc bool
+
+	candidates map[types.Object]*builderGrowCandidate
+}
+
+type builderGrowCandidate struct {
+	grown    bool
+	reported bool
+}
+
+func (c *builderGrowChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.nestedFunc = false
+	for k := range c.candidates {
+		delete(c.candidates, k)
+	}
+
+	ast.Inspect(body, c.walk)
+
+	return nil
+}
+
+func (c *builderGrowChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		nestedFunc := c.nestedFunc
+		c.nestedFunc = true
+		ast.Inspect(n.Body, c.walk)
+		c.nestedFunc = nestedFunc
+		return false
+
+	case *ast.ValueSpec:
+		if c.nestedFunc || len(n.Names) != 1 || len(n.Values) != 0 {
+			return true
+		}
+		// A nil pointer var can't be a candidate.
+		if typ := c.ctx.TypeOf(n.Names[0]); typ != nil && typeis.Pointer(typ) {
+			return true
+		}
+		c.track(n.Names[0])
+
+	case *ast.AssignStmt:
+		if c.nestedFunc || n.Tok != token.DEFINE || len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+			return true
+		}
+		id, ok := n.Lhs[0].(*ast.Ident)
+		if !ok || !c.isEmptyValue(n.Rhs[0]) {
+			return true
+		}
+		c.track(id)
+
+	case *ast.CallExpr:
+		if id, method := c.methodCall(n); id != nil && method == "Grow" {
+			if candidate := c.candidates[c.ctx.ObjectOf(id)]; candidate != nil {
+				candidate.grown = true
+			}
+		}
+
+	case *ast.RangeStmt:
+		if c.nestedFunc || !c.isSizedRange(n) {
+			return true
+		}
+		c.checkLoop(n)
+	}
+
+	return true
+}
+
+func (c *builderGrowChecker) checkLoop(loop *ast.RangeStmt) {
+	type loopWrite struct {
+		id   *ast.Ident
+		call *ast.CallExpr
+	}
+	var writes []loopWrite
+	var grownInLoop []types.Object
+	ast.Inspect(loop.Body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		id, method := c.methodCall(call)
+		switch method {
+		case "Grow":
+			grownInLoop = append(grownInLoop, c.ctx.ObjectOf(id))
+		case "Write", "WriteString", "WriteByte", "WriteRune":
+			writes = append(writes, loopWrite{id: id, call: call})
+		}
+		return true
+	})
+
+	for _, w := range writes {
+		obj := c.ctx.ObjectOf(w.id)
+		candidate := c.candidates[obj]
+		if candidate == nil || candidate.grown || candidate.reported {
+			continue
+		}
+		// Grow inside the loop is not ideal, but there
+		// is a chance it reserves enough memory for all iterations.
+		if containsObject(grownInLoop, obj) {
+			continue
+		}
+		candidate.reported = true
+		c.ctx.Report(lint.ReportParams{
+			PosNode: loop,
+			Message: fmt.Sprintf("%s is written in a loop over %s, consider calling %s.Grow before the loop",
+				w.id.Name, c.ctx.NodeText(loop.X), w.id.Name),
+			HotNodes: []ast.Node{w.call},
+		})
+	}
+}
+
+func (c *builderGrowChecker) track(id *ast.Ident) {
+	obj := c.ctx.ObjectOf(id)
+	if obj == nil || !c.isBuilderType(obj.Type()) {
+		return
+	}
+	c.candidates[obj] = &builderGrowCandidate{}
+}
+
+// methodCall returns the receiver and the method name of a `x.method()` call.
+func (c *builderGrowChecker) methodCall(call *ast.CallExpr) (*ast.Ident, string) {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, ""
+	}
+	id, ok := selector.X.(*ast.Ident)
+	if !ok || !c.isBuilderType(c.ctx.TypeOf(id)) {
+		return nil, ""
+	}
+	return id, selector.Sel.Name
+}
+
+func (c *builderGrowChecker) isEmptyValue(e ast.Expr) bool {
+	if unary, ok := e.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		e = unary.X
+	}
+	switch e := e.(type) {
+	case *ast.CompositeLit:
+		return len(e.Elts) == 0
+	case *ast.CallExpr:
+		called, ok := e.Fun.(*ast.Ident)
+		if !ok || called.Name != "new" || len(e.Args) != 1 {
+			return false
+		}
+		_, ok = c.ctx.ObjectOf(called).(*types.Builtin)
+		return ok
+	default:
+		return false
+	}
+}
+
+func (c *builderGrowChecker) isBuilderType(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	return typeis.Named(typ, "strings", "Builder") || typeis.Named(typ, "bytes", "Buffer")
+}
+
+func (c *builderGrowChecker) isSizedRange(loop *ast.RangeStmt) bool {
+	typ := c.ctx.TypeOf(loop.X)
+	if typ == nil {
+		return false
+	}
+	switch typ := typ.Underlying().(type) {
+	case *types.Slice, *types.Array, *types.Map:
+		return true
+	case *types.Basic:
+		return typ.Info()&types.IsString != 0
+	case *types.Pointer:
+		_, isArray := typ.Elem().Underlying().(*types.Array)
+		return isArray
+	default:
+		return false
+	}
+}
+
+func containsObject(list []types.Object, obj types.Object) bool {
+	for _, x := range list {
+		if x == obj {
+			return true
+		}
+	}
+	return false
+}
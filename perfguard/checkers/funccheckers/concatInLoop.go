@@ -0,0 +1,71 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "concatInLoop",
+		Score:    4,
+		OptLevel: 1,
+		Impact:   "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &concatInLoopChecker{}
+	})
+}
+
+// concatInLoopChecker finds loops that build a string
+// by concatenating it to a string accumulator:
+//
+//	for _, name := range names {
+//		s += name + ","
+//	}
+//
+// Every concatenation allocates a new string and copies the entire
+// accumulator into it, so the loop is quadratic.
+// A strings.Builder grows its buffer in place:
+//
+//	var sb strings.Builder
+//	for _, name := range names {
+//		sb.WriteString(name)
+//		sb.WriteString(",")
+//	}
+//	s := sb.String()
+//
+// The accumulator is matched in the same way as in itoaConcat,
+// the concatenations with strconv integer formatting are left to it.
+// There is no autofix: the replacement affects the code around the loop.
+type concatInLoopChecker struct {
+	ctx *lint.Context
+}
+
+func (c *concatInLoopChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	walkLoopAssigns(body, c.checkAssign)
+
+	return nil
+}
+
+func (c *concatInLoopChecker) checkAssign(loop ast.Node, assign *ast.AssignStmt) {
+	lhs, concat := matchLoopConcat(c.ctx, loop, assign)
+	if lhs == nil {
+		return
+	}
+	if findItoa(c.ctx, concat) != "" {
+		return // Reported by itoaConcat
+	}
+
+	c.ctx.Report(lint.ReportParams{
+		PosNode: assign,
+		Message: fmt.Sprintf("%[1]s is built with a string concat inside a loop: declare a strings.Builder before the loop, replace the concat with its WriteString calls and assign its String() to %[1]s after the loop",
+			lhs.Name),
+		HotNodes: []ast.Node{assign},
+	})
+}
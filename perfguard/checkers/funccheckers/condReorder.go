@@ -12,8 +12,9 @@ import (
 
 func init() {
 	doc := checkers.Doc{
-		Name:  "condReorder",
-		Score: 2,
+		Name:   "condReorder",
+		Score:  2,
+		Impact: "cpu",
 	}
 	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
 		return &condReorderChecker{}
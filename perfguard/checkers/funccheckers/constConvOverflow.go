@@ -0,0 +1,190 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "constConvOverflow",
+		Score:    3,
+		LintOnly: true,
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &constConvOverflowChecker{
+			candidates: make(map[types.Object]*constConvOverflowCandidate),
+		}
+	})
+}
+
+// constConvOverflowChecker finds integer conversions that truncate
+// a value that is known at compile time.
+//
+// A conversion of a constant like byte(300) is a compile error,
+// but the same value stored in a variable first is silently truncated.
+// The candidates are local vars that are initialized with an integer
+// constant expression and never modified after that.
+// The value is taken from the types.Info, so named constants
+// and constant expressions are handled as well as literals.
+//
+// The target type bounds are computed from its size and signedness,
+// int and uint sizes are taken from the target types.Sizes.
+type constConvOverflowChecker struct {
+	ctx *lint.Context
+
+	nestedFunc bool
+
+	candidates map[types.Object]*constConvOverflowCandidate
+
+	conversions []constConvOverflowConversion
+}
+
+type constConvOverflowConversion struct {
+	call *ast.CallExpr
+	arg  *ast.Ident
+}
+
+type constConvOverflowCandidate struct {
+	value    constant.Value
+	rejected bool
+}
+
+func (c *constConvOverflowChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.nestedFunc = false
+	for k := range c.candidates {
+		delete(c.candidates, k)
+	}
+	c.conversions = c.conversions[:0]
+
+	ast.Inspect(body, c.walk)
+
+	for _, conv := range c.conversions {
+		candidate := c.candidates[ctx.ObjectOf(conv.arg)]
+		if candidate.rejected {
+			continue
+		}
+		typ := ctx.TypeOf(conv.call)
+		if c.fitsInto(candidate.value, typ) {
+			continue
+		}
+		ctx.Report(lint.ReportParams{
+			PosNode: conv.call,
+			Message: fmt.Sprintf("%s truncates the %s value %s, it doesn't fit into %s",
+				ctx.NodeText(conv.call), conv.arg.Name, candidate.value.ExactString(), ctx.NodeText(conv.call.Fun)),
+		})
+	}
+
+	return nil
+}
+
+func (c *constConvOverflowChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		// Function literals are checked separately, but an assignment
+		// inside of them can still modify a candidate of this function.
+		// Their declarations and conversions are not collected.
+		nestedFunc := c.nestedFunc
+		c.nestedFunc = true
+		ast.Inspect(n.Body, c.walk)
+		c.nestedFunc = nestedFunc
+		return false
+
+	case *ast.ValueSpec:
+		if c.nestedFunc || len(n.Names) != 1 || len(n.Values) != 1 {
+			return true
+		}
+		c.visitDecl(n.Names[0], n.Values[0])
+
+	case *ast.AssignStmt:
+		if n.Tok == token.DEFINE && len(n.Lhs) == 1 && len(n.Rhs) == 1 {
+			if id, ok := n.Lhs[0].(*ast.Ident); ok && !c.nestedFunc {
+				c.visitDecl(id, n.Rhs[0])
+				return true
+			}
+		}
+		for _, lhs := range n.Lhs {
+			c.reject(lhs)
+		}
+
+	case *ast.IncDecStmt:
+		c.reject(n.X)
+
+	case *ast.UnaryExpr:
+		if n.Op == token.AND {
+			c.reject(n.X)
+		}
+
+	case *ast.RangeStmt:
+		c.reject(n.Key)
+		c.reject(n.Value)
+
+	case *ast.CallExpr:
+		if c.nestedFunc || len(n.Args) != 1 {
+			return true
+		}
+		tv, ok := c.ctx.Target.Types.Types[n.Fun]
+		if !ok || !tv.IsType() || !isIntegerType(tv.Type) {
+			return true
+		}
+		id, ok := n.Args[0].(*ast.Ident)
+		if !ok || c.candidates[c.ctx.ObjectOf(id)] == nil {
+			return true
+		}
+		c.conversions = append(c.conversions, constConvOverflowConversion{call: n, arg: id})
+	}
+
+	return true
+}
+
+func (c *constConvOverflowChecker) visitDecl(id *ast.Ident, init ast.Expr) {
+	tv, ok := c.ctx.Target.Types.Types[init]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return
+	}
+	obj, ok := c.ctx.ObjectOf(id).(*types.Var)
+	if !ok || !isIntegerType(obj.Type()) {
+		return
+	}
+	c.candidates[obj] = &constConvOverflowCandidate{value: tv.Value}
+}
+
+func (c *constConvOverflowChecker) reject(e ast.Expr) {
+	id, ok := e.(*ast.Ident)
+	if !ok {
+		return
+	}
+	if candidate := c.candidates[c.ctx.ObjectOf(id)]; candidate != nil {
+		candidate.rejected = true
+	}
+}
+
+func (c *constConvOverflowChecker) fitsInto(v constant.Value, typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok {
+		return true
+	}
+	bits := uint(8 * c.ctx.Target.Sizes.Sizeof(basic))
+	one := constant.MakeInt64(1)
+	var min, max constant.Value
+	if basic.Info()&types.IsUnsigned != 0 {
+		min = constant.MakeInt64(0)
+		max = constant.BinaryOp(constant.Shift(one, token.SHL, bits), token.SUB, one)
+	} else {
+		max = constant.BinaryOp(constant.Shift(one, token.SHL, bits-1), token.SUB, one)
+		min = constant.UnaryOp(token.SUB, constant.Shift(one, token.SHL, bits-1), 0)
+	}
+	return constant.Compare(v, token.GEQ, min) && constant.Compare(v, token.LEQ, max)
+}
+
+func isIntegerType(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0
+}
@@ -0,0 +1,153 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/typep"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "containsAppend",
+		Score:    3,
+		OptLevel: 2,
+		Impact:   "cpu",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &containsAppendChecker{}
+	})
+}
+
+// containsAppendChecker finds slices that are used as sets inside a loop:
+//
+//	for _, x := range xs {
+//		if !slices.Contains(seen, x) {
+//			seen = append(seen, x)
+//		}
+//	}
+//
+// Every Contains call is a linear scan, so the deduplication is O(n^2).
+// A map-based set makes it O(n).
+//
+// The if statement should have no init statement and no else branch,
+// its condition should be a negated Contains call, and one of the
+// if body statements should be `s = append(s, ...)`.
+// The Contains haystack, the append destination and the append
+// first argument must be the same side effect free expression.
+//
+// The Contains function is recognized by the imported package name,
+// so both stdlib slices and golang.org/x/exp/slices are handled.
+//
+// The fix introduces a map, so there is no autofix.
+type containsAppendChecker struct {
+	ctx *lint.Context
+
+	loopDepth int
+}
+
+func (c *containsAppendChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.loopDepth = 0
+
+	ast.Inspect(body, c.walk)
+
+	return nil
+}
+
+func (c *containsAppendChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		return false
+
+	case *ast.ForStmt:
+		c.loopDepth++
+		ast.Inspect(n.Body, c.walk)
+		c.loopDepth--
+		return false
+
+	case *ast.RangeStmt:
+		c.loopDepth++
+		ast.Inspect(n.Body, c.walk)
+		c.loopDepth--
+		return false
+
+	case *ast.IfStmt:
+		if c.loopDepth != 0 {
+			c.checkIf(n)
+		}
+	}
+
+	return true
+}
+
+func (c *containsAppendChecker) checkIf(ifStmt *ast.IfStmt) {
+	if ifStmt.Init != nil || ifStmt.Else != nil {
+		return
+	}
+	cond, ok := ifStmt.Cond.(*ast.UnaryExpr)
+	if !ok || cond.Op != token.NOT {
+		return
+	}
+	call, ok := cond.X.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 || !c.isContainsFunc(call.Fun) {
+		return
+	}
+	haystack := call.Args[0]
+	if !typep.SideEffectFree(c.ctx.Target.Types, haystack) {
+		return
+	}
+
+	for _, stmt := range ifStmt.Body.List {
+		if !c.isAppendTo(stmt, haystack) {
+			continue
+		}
+		c.ctx.Report(lint.ReportParams{
+			PosNode: call,
+			Message: fmt.Sprintf("%s is used as a set, Contains+append in a loop is O(n^2); consider a map[T]struct{} for the deduplication",
+				c.ctx.NodeText(haystack)),
+			HotNodes: []ast.Node{call},
+		})
+		return
+	}
+}
+
+// isContainsFunc reports whether fn is a slices.Contains function.
+func (c *containsAppendChecker) isContainsFunc(fn ast.Expr) bool {
+	selector, ok := fn.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != "Contains" {
+		return false
+	}
+	pkgIdent, ok := selector.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pkgName, ok := c.ctx.ObjectOf(pkgIdent).(*types.PkgName)
+	return ok && pkgName.Imported().Name() == "slices"
+}
+
+// isAppendTo reports whether stmt is a `dst = append(dst, ...)`.
+func (c *containsAppendChecker) isAppendTo(stmt ast.Stmt, dst ast.Expr) bool {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return false
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 {
+		return false
+	}
+	called, ok := call.Fun.(*ast.Ident)
+	if !ok || called.Name != "append" {
+		return false
+	}
+	if _, ok := c.ctx.ObjectOf(called).(*types.Builtin); !ok {
+		return false
+	}
+	return astequal.Expr(assign.Lhs[0], dst) && astequal.Expr(call.Args[0], dst)
+}
@@ -0,0 +1,143 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/typep"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "copyToAppend",
+		Score:    3,
+		OptLevel: 1,
+		Impact:   "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &copyToAppendChecker{}
+	})
+}
+
+// copyToAppendChecker finds temporary slice copies that are only appended:
+//
+//	tmp := make([]T, len(src))
+//	copy(tmp, src)
+//	dst = append(dst, tmp...)
+//
+// It's replaced with `dst = append(dst, src...)` that avoids
+// the temporary slice allocation and the extra copying.
+//
+// The 3 statements should go one after another.
+// The make length should be len(src), otherwise the copy may
+// copy less elements than src has; src and dst should be
+// side effect free expressions. The tmp var should not be used
+// in the rest of the block.
+type copyToAppendChecker struct {
+	ctx *lint.Context
+}
+
+func (c *copyToAppendChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	walkStmtLists(body, c.checkStmtList)
+
+	return nil
+}
+
+func (c *copyToAppendChecker) checkStmtList(list []ast.Stmt) {
+	for i := 0; i+2 < len(list); i++ {
+		tmp, src := c.matchMake(list[i])
+		if tmp == nil {
+			continue
+		}
+		tmpObj := c.ctx.ObjectOf(tmp)
+		if !c.matchCopy(list[i+1], tmpObj, src) {
+			continue
+		}
+		appendStmt, dst := c.matchAppend(list[i+2], tmpObj)
+		if appendStmt == nil {
+			continue
+		}
+		if !typep.SideEffectFree(c.ctx.Target.Types, src) || !typep.SideEffectFree(c.ctx.Target.Types, dst) {
+			continue
+		}
+		if refersTo(c.ctx, dst, tmpObj) || refersToList(c.ctx, list[i+3:], tmpObj) {
+			continue
+		}
+
+		dstText := c.ctx.NodeText(dst)
+		replacement := fmt.Sprintf("%s = append(%s, %s...)", dstText, dstText, c.ctx.NodeText(src))
+		c.ctx.MultiChangeSuggest(lint.MultiChangeSuggestParams{
+			ReportPos:     list[i].Pos(),
+			ReportMessage: fmt.Sprintf("%s is a redundant copy of %s, use %s", tmp.Name, c.ctx.NodeText(src), replacement),
+			OldNodes:      []ast.Node{&nodeRange{from: list[i].Pos(), to: appendStmt.End()}},
+			NewNodes:      []lint.NodeReplacement{{Text: []byte(replacement)}},
+		})
+		i += 2
+	}
+}
+
+// matchMake matches `tmp := make([]T, len(src))` and returns tmp and src.
+func (c *copyToAppendChecker) matchMake(stmt ast.Stmt) (*ast.Ident, ast.Expr) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, nil
+	}
+	tmp, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || isBlankIdent(tmp) {
+		return nil, nil
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 || !isBuiltinCall(c.ctx, call, "make") {
+		return nil, nil
+	}
+	lenCall, ok := call.Args[1].(*ast.CallExpr)
+	if !ok || len(lenCall.Args) != 1 || !isBuiltinCall(c.ctx, lenCall, "len") {
+		return nil, nil
+	}
+	return tmp, lenCall.Args[0]
+}
+
+// matchCopy matches `copy(tmp, src)`.
+func (c *copyToAppendChecker) matchCopy(stmt ast.Stmt, tmp types.Object, src ast.Expr) bool {
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+	call, ok := exprStmt.X.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 || !isBuiltinCall(c.ctx, call, "copy") {
+		return false
+	}
+	dst, ok := call.Args[0].(*ast.Ident)
+	if !ok || c.ctx.ObjectOf(dst) != tmp {
+		return false
+	}
+	return astequal.Expr(call.Args[1], src)
+}
+
+// matchAppend matches `dst = append(dst, tmp...)` and returns the stmt and dst.
+func (c *copyToAppendChecker) matchAppend(stmt ast.Stmt, tmp types.Object) (*ast.AssignStmt, ast.Expr) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, nil
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 || !call.Ellipsis.IsValid() || !isBuiltinCall(c.ctx, call, "append") {
+		return nil, nil
+	}
+	if !astequal.Expr(call.Args[0], assign.Lhs[0]) {
+		return nil, nil
+	}
+	arg, ok := call.Args[1].(*ast.Ident)
+	if !ok || c.ctx.ObjectOf(arg) != tmp {
+		return nil, nil
+	}
+	return assign, assign.Lhs[0]
+}
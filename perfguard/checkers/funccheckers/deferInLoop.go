@@ -0,0 +1,88 @@
+package funccheckers
+
+import (
+	"go/ast"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:         "deferInLoop",
+		Score:        2,
+		OptLevel:     1,
+		NeedsProfile: true,
+		Impact:       "cpu",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &deferInLoopChecker{}
+	})
+}
+
+// deferInLoopChecker finds defer statements inside hot loops:
+//
+//	for _, f := range files {
+//		mu.Lock()
+//		defer mu.Unlock()
+//		...
+//	}
+//
+// The deferred calls are executed only when the function returns,
+// so they accumulate with every iteration and every defer has
+// its own overhead.
+//
+// A function literal has its own defer scope: a defer inside it
+// runs when the literal returns, not when the enclosing function does.
+// Function literals are checked separately, so a defer inside a literal
+// is reported only if it's inside a loop of that literal.
+//
+// This checker doesn't run without a profile and there is no autofix:
+// the cleanup should be either hoisted out of the loop or called
+// directly at the end of each iteration.
+type deferInLoopChecker struct {
+	ctx *lint.Context
+
+	// loopDepth is a number of loops that enclose the current node.
+	loopDepth int
+}
+
+func (c *deferInLoopChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.loopDepth = 0
+
+	ast.Inspect(body, c.walk)
+
+	return nil
+}
+
+func (c *deferInLoopChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		return false
+
+	case *ast.ForStmt:
+		c.loopDepth++
+		ast.Inspect(n.Body, c.walk)
+		c.loopDepth--
+		return false
+
+	case *ast.RangeStmt:
+		c.loopDepth++
+		ast.Inspect(n.Body, c.walk)
+		c.loopDepth--
+		return false
+
+	case *ast.DeferStmt:
+		if c.loopDepth == 0 {
+			return true
+		}
+		c.ctx.Report(lint.ReportParams{
+			PosNode:  n,
+			Message:  "defer in a loop is executed only when the function returns; move the cleanup out of the loop or call it at the end of each iteration",
+			HotNodes: []ast.Node{n},
+		})
+	}
+
+	return true
+}
@@ -0,0 +1,314 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "deferNilRecv",
+		Score:    3,
+		LintOnly: true,
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &deferNilRecvChecker{}
+	})
+}
+
+// deferNilRecvChecker finds deferred method calls on a variable
+// that is assigned only in some of the preceding branches:
+//
+//	var f *os.File
+//	if cond {
+//		f, _ = os.Open(filename)
+//	}
+//	defer f.Close() // f is nil if cond is false
+//
+// The flow analysis is local to a statement list.
+// The tracked variables are declared with `var x T` without a value,
+// where T is a pointer or an interface type.
+// The statements that follow the declaration change the variable state:
+//   - a plain assignment makes it non-nil
+//   - an if or switch statement makes it non-nil if every branch
+//     either assigns it or leaves the function (return, panic, etc);
+//     `if x == nil { return }` is a nil check that counts too
+//   - otherwise, if some branch or a loop assigns it, it may be nil
+//   - taking its address or capturing it by a closure stops the tracking
+//
+// A deferred x.Method() call is reported if x may be nil at that point.
+// A nil-guarded defer, like `if x != nil { defer x.Close() }`, is
+// a part of another statement list, so it's never reported.
+type deferNilRecvChecker struct {
+	ctx *lint.Context
+}
+
+type deferNilRecvState int
+
+const (
+	// deferNilRecvNil is a state of a declared, but not yet assigned variable.
+	deferNilRecvNil deferNilRecvState = iota
+
+	// deferNilRecvMaybeNil is a state of a variable that is assigned in some branches.
+	deferNilRecvMaybeNil
+)
+
+func (c *deferNilRecvChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	walkStmtLists(body, c.checkStmtList)
+
+	return nil
+}
+
+func (c *deferNilRecvChecker) checkStmtList(list []ast.Stmt) {
+	var tracked map[*types.Var]deferNilRecvState
+
+	for _, stmt := range list {
+		if len(tracked) != 0 {
+			if deferStmt, ok := stmt.(*ast.DeferStmt); ok {
+				c.checkDefer(deferStmt, tracked)
+			}
+			for v, state := range tracked {
+				if c.isEscaping(stmt, v) {
+					delete(tracked, v)
+					continue
+				}
+				state, nilable := c.nextState(stmt, v, state)
+				if !nilable {
+					delete(tracked, v)
+					continue
+				}
+				tracked[v] = state
+			}
+		}
+
+		for _, v := range c.declaredVars(stmt) {
+			if tracked == nil {
+				tracked = make(map[*types.Var]deferNilRecvState)
+			}
+			tracked[v] = deferNilRecvNil
+		}
+	}
+}
+
+func (c *deferNilRecvChecker) checkDefer(n *ast.DeferStmt, tracked map[*types.Var]deferNilRecvState) {
+	sel, ok := n.Call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return
+	}
+	v, ok := c.ctx.ObjectOf(id).(*types.Var)
+	if !ok {
+		return
+	}
+	if state, ok := tracked[v]; !ok || state != deferNilRecvMaybeNil {
+		return
+	}
+	selection := c.ctx.Target.Types.Selections[sel]
+	if selection == nil || selection.Kind() != types.MethodVal {
+		return
+	}
+	c.ctx.Report(lint.ReportParams{
+		PosNode: n,
+		Message: fmt.Sprintf("%s is assigned only in some of the preceding branches, it may be nil when %s.%s() is deferred",
+			id.Name, id.Name, sel.Sel.Name),
+	})
+}
+
+// nextState returns the v state after the stmt is executed.
+// If v is known to be non-nil, the second result is false.
+func (c *deferNilRecvChecker) nextState(stmt ast.Stmt, v *types.Var, state deferNilRecvState) (deferNilRecvState, bool) {
+	if ifStmt, ok := stmt.(*ast.IfStmt); ok && c.isNilCheck(ifStmt, v) {
+		return state, false
+	}
+	if c.covers(stmt, v) {
+		return state, false
+	}
+	if c.assignsSomewhere(stmt, v) {
+		return deferNilRecvMaybeNil, true
+	}
+	return state, true
+}
+
+// covers reports whether every stmt execution path that
+// continues to the next statement assigns v.
+func (c *deferNilRecvChecker) covers(stmt ast.Stmt, v *types.Var) bool {
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		return c.assignsVar(stmt, v)
+
+	case *ast.BlockStmt:
+		return c.coversList(stmt.List, v)
+
+	case *ast.IfStmt:
+		if stmt.Else == nil {
+			return false
+		}
+		return c.covers(stmt.Body, v) && c.covers(stmt.Else, v)
+
+	case *ast.SwitchStmt:
+		return c.coversClauses(stmt.Body, v)
+	case *ast.TypeSwitchStmt:
+		return c.coversClauses(stmt.Body, v)
+
+	default:
+		return c.isTerminating(stmt)
+	}
+}
+
+func (c *deferNilRecvChecker) coversList(list []ast.Stmt, v *types.Var) bool {
+	for _, stmt := range list {
+		if c.covers(stmt, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *deferNilRecvChecker) coversClauses(body *ast.BlockStmt, v *types.Var) bool {
+	hasDefault := false
+	for _, stmt := range body.List {
+		clause := stmt.(*ast.CaseClause)
+		if clause.List == nil {
+			hasDefault = true
+		}
+		if !c.coversList(clause.Body, v) {
+			return false
+		}
+	}
+	return hasDefault
+}
+
+// isNilCheck reports whether stmt is `if v == nil { ... }` that
+// either leaves the function or assigns v.
+func (c *deferNilRecvChecker) isNilCheck(stmt *ast.IfStmt, v *types.Var) bool {
+	if stmt.Else != nil || stmt.Init != nil {
+		return false
+	}
+	cond, ok := stmt.Cond.(*ast.BinaryExpr)
+	if !ok || cond.Op != token.EQL {
+		return false
+	}
+	x, ok := cond.X.(*ast.Ident)
+	if !ok || c.ctx.ObjectOf(x) != v {
+		return false
+	}
+	y, ok := cond.Y.(*ast.Ident)
+	if !ok || y.Name != "nil" {
+		return false
+	}
+	return c.covers(stmt.Body, v)
+}
+
+func (c *deferNilRecvChecker) isTerminating(stmt ast.Stmt) bool {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := stmt.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		fn, ok := call.Fun.(*ast.Ident)
+		if !ok || fn.Name != "panic" {
+			return false
+		}
+		_, ok = c.ctx.ObjectOf(fn).(*types.Builtin)
+		return ok
+	default:
+		return false
+	}
+}
+
+func (c *deferNilRecvChecker) assignsVar(stmt *ast.AssignStmt, v *types.Var) bool {
+	for _, lhs := range stmt.Lhs {
+		if id, ok := lhs.(*ast.Ident); ok && c.ctx.ObjectOf(id) == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *deferNilRecvChecker) assignsSomewhere(stmt ast.Stmt, v *types.Var) bool {
+	found := false
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if assign, ok := n.(*ast.AssignStmt); ok && c.assignsVar(assign, v) {
+			found = true
+		}
+		return true
+	})
+	return found
+}
+
+// isEscaping reports whether stmt takes the v address or captures it by a closure.
+// After that, v can be assigned in a way that we can't track.
+func (c *deferNilRecvChecker) isEscaping(stmt ast.Stmt, v *types.Var) bool {
+	escaping := false
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if escaping {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.UnaryExpr:
+			if id, ok := n.X.(*ast.Ident); ok && n.Op == token.AND && c.ctx.ObjectOf(id) == v {
+				escaping = true
+			}
+		case *ast.FuncLit:
+			ast.Inspect(n.Body, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok && c.ctx.ObjectOf(id) == v {
+					escaping = true
+				}
+				return !escaping
+			})
+			return false
+		}
+		return true
+	})
+	return escaping
+}
+
+// declaredVars returns the nilable vars declared by stmt without a value.
+func (c *deferNilRecvChecker) declaredVars(stmt ast.Stmt) []*types.Var {
+	decl, ok := stmt.(*ast.DeclStmt)
+	if !ok {
+		return nil
+	}
+	genDecl, ok := decl.Decl.(*ast.GenDecl)
+	if !ok || genDecl.Tok != token.VAR {
+		return nil
+	}
+	var vars []*types.Var
+	for _, spec := range genDecl.Specs {
+		spec := spec.(*ast.ValueSpec)
+		if len(spec.Values) != 0 {
+			continue
+		}
+		for _, name := range spec.Names {
+			v, ok := c.ctx.ObjectOf(name).(*types.Var)
+			if !ok {
+				continue
+			}
+			switch {
+			case types.IsInterface(v.Type()):
+				vars = append(vars, v)
+			default:
+				if _, ok := v.Type().Underlying().(*types.Pointer); ok {
+					vars = append(vars, v)
+				}
+			}
+		}
+	}
+	return vars
+}
@@ -0,0 +1,148 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "discardedError",
+		Score:    1,
+		LintOnly: true,
+		OptIn:    true,
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &discardedErrorChecker{}
+	})
+}
+
+// discardedErrorChecker finds `_ = f()` and `_, _ = f()` assignments
+// where the last f result is an error.
+//
+// The error-returning calls are identified by the call result types:
+// a single error result or a tuple that ends with an error.
+// Only the assignments where every lhs is a blank identifier are checked;
+// calls used as expression statements are not reported.
+//
+// Some functions never return an error in practice, they're allowlisted:
+//   - bytes.Buffer and strings.Builder Write methods
+//   - bufio.Writer Write methods (the error is returned by Flush as well)
+//   - fmt.Fprint functions that write to a bytes.Buffer or strings.Builder
+//
+// Discarding an error is often intentional,
+// so the checker needs to be enabled with -enable flag.
+type discardedErrorChecker struct {
+	ctx *lint.Context
+}
+
+// discardedErrorSafeFuncs are the types.Func full names of the allowlisted functions.
+var discardedErrorSafeFuncs = map[string]struct{}{
+	"(*bytes.Buffer).Write":       {},
+	"(*bytes.Buffer).WriteByte":   {},
+	"(*bytes.Buffer).WriteRune":   {},
+	"(*bytes.Buffer).WriteString": {},
+
+	"(*strings.Builder).Write":       {},
+	"(*strings.Builder).WriteByte":   {},
+	"(*strings.Builder).WriteRune":   {},
+	"(*strings.Builder).WriteString": {},
+
+	"(*bufio.Writer).Write":       {},
+	"(*bufio.Writer).WriteByte":   {},
+	"(*bufio.Writer).WriteRune":   {},
+	"(*bufio.Writer).WriteString": {},
+}
+
+func (c *discardedErrorChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.AssignStmt:
+			c.checkAssign(n)
+		}
+		return true
+	})
+
+	return nil
+}
+
+func (c *discardedErrorChecker) checkAssign(assign *ast.AssignStmt) {
+	if assign.Tok != token.ASSIGN || len(assign.Rhs) != 1 {
+		return
+	}
+	for _, lhs := range assign.Lhs {
+		if !isBlankIdent(lhs) {
+			return
+		}
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || !c.returnsError(call) {
+		return
+	}
+	fn := c.calledFunc(call)
+	if fn != nil && c.isSafeFunc(fn, call) {
+		return
+	}
+
+	c.ctx.Report(lint.ReportParams{
+		PosNode: assign,
+		Message: fmt.Sprintf("the error returned by %s is discarded", c.ctx.NodeText(call.Fun)),
+	})
+}
+
+func (c *discardedErrorChecker) returnsError(call *ast.CallExpr) bool {
+	typ := c.ctx.TypeOf(call)
+	if tuple, ok := typ.(*types.Tuple); ok {
+		if tuple.Len() == 0 {
+			return false
+		}
+		typ = tuple.At(tuple.Len() - 1).Type()
+	}
+	return typ != nil && types.Identical(typ, types.Universe.Lookup("error").Type())
+}
+
+func (c *discardedErrorChecker) calledFunc(call *ast.CallExpr) *types.Func {
+	var id *ast.Ident
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		id = fn
+	case *ast.SelectorExpr:
+		id = fn.Sel
+	default:
+		return nil
+	}
+	fn, _ := c.ctx.ObjectOf(id).(*types.Func)
+	return fn
+}
+
+func (c *discardedErrorChecker) isSafeFunc(fn *types.Func, call *ast.CallExpr) bool {
+	if _, ok := discardedErrorSafeFuncs[fn.FullName()]; ok {
+		return true
+	}
+
+	switch fn.FullName() {
+	case "fmt.Fprint", "fmt.Fprintf", "fmt.Fprintln":
+		if len(call.Args) == 0 {
+			return false
+		}
+		typ := c.ctx.TypeOf(call.Args[0])
+		if typ == nil {
+			return false
+		}
+		switch types.TypeString(typ, nil) {
+		case "*bytes.Buffer", "*strings.Builder":
+			return true
+		}
+	}
+
+	return false
+}
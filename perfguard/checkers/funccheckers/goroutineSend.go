@@ -0,0 +1,169 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "goroutineSend",
+		Score:    1,
+		LintOnly: true,
+		OptIn:    true,
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &goroutineSendChecker{
+			buffered: make(map[types.Object]struct{}),
+		}
+	})
+}
+
+// goroutineSendChecker finds goroutines that do an unguarded channel send:
+//
+//	go func() {
+//		ch <- compute()
+//	}()
+//
+// If nobody receives from ch (the caller returned on a timeout or an error),
+// the send blocks forever and the goroutine leaks.
+//
+// Only the `go func() { ... }()` statements are checked, the sends
+// inside the nested function literals are ignored.
+// A send is guarded if it's a select case and the select has
+// a default clause or any receive case, like `<-ctx.Done()`.
+// The sends to the channels created as `ch := make(chan T, n)`
+// with a non-zero constant n in the same function are not reported:
+// a buffered channel is a common way to let the goroutine exit.
+//
+// It's an advisory concurrency warning with false positives:
+// the receiver may be guaranteed by the program logic.
+// The checker needs to be enabled with -enable flag.
+type goroutineSendChecker struct {
+	ctx *lint.Context
+
+	// buffered are the local buffered channel vars.
+	buffered map[types.Object]struct{}
+}
+
+func (c *goroutineSendChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	for k := range c.buffered {
+		delete(c.buffered, k)
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.AssignStmt:
+			c.visitAssign(n)
+		case *ast.GoStmt:
+			if fn, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				c.checkGoroutine(fn.Body)
+			}
+		}
+		return true
+	})
+
+	return nil
+}
+
+func (c *goroutineSendChecker) visitAssign(assign *ast.AssignStmt) {
+	if assign.Tok != token.DEFINE || len(assign.Lhs) != len(assign.Rhs) {
+		return
+	}
+	for i, rhs := range assign.Rhs {
+		id, ok := assign.Lhs[i].(*ast.Ident)
+		if !ok || !c.isBufferedMake(rhs) {
+			continue
+		}
+		if obj := c.ctx.ObjectOf(id); obj != nil {
+			c.buffered[obj] = struct{}{}
+		}
+	}
+}
+
+// isBufferedMake reports whether e is a `make(chan T, n)` with a non-zero const n.
+func (c *goroutineSendChecker) isBufferedMake(e ast.Expr) bool {
+	call, ok := e.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return false
+	}
+	fn, ok := call.Fun.(*ast.Ident)
+	if !ok || fn.Name != "make" {
+		return false
+	}
+	if _, ok := c.ctx.ObjectOf(fn).(*types.Builtin); !ok {
+		return false
+	}
+	tv, ok := c.ctx.Target.Types.Types[call.Args[1]]
+	if !ok || tv.Value == nil {
+		return false
+	}
+	size, ok := constant.Int64Val(tv.Value)
+	return ok && size != 0
+}
+
+func (c *goroutineSendChecker) checkGoroutine(body *ast.BlockStmt) {
+	// The guarded sends are select clause comms,
+	// they're collected before their SendStmt nodes are visited.
+	guarded := make(map[*ast.SendStmt]struct{})
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.SelectStmt:
+			if !c.hasEscape(n) {
+				return true
+			}
+			for _, clause := range n.Body.List {
+				if send, ok := clause.(*ast.CommClause).Comm.(*ast.SendStmt); ok {
+					guarded[send] = struct{}{}
+				}
+			}
+		case *ast.SendStmt:
+			if _, ok := guarded[n]; ok {
+				return true
+			}
+			if c.isBufferedChan(n.Chan) {
+				return true
+			}
+			c.ctx.Report(lint.ReportParams{
+				PosNode: n,
+				Message: fmt.Sprintf("possible goroutine leak: the send to %s blocks forever if there is no receiver, use a select with a ctx.Done() case or a buffered channel",
+					c.ctx.NodeText(n.Chan)),
+			})
+		}
+		return true
+	})
+}
+
+// hasEscape reports whether the select has a default clause or a receive case.
+func (c *goroutineSendChecker) hasEscape(stmt *ast.SelectStmt) bool {
+	for _, clause := range stmt.Body.List {
+		comm := clause.(*ast.CommClause).Comm
+		if comm == nil {
+			return true
+		}
+		if _, ok := comm.(*ast.SendStmt); !ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *goroutineSendChecker) isBufferedChan(e ast.Expr) bool {
+	id, ok := e.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = c.buffered[c.ctx.ObjectOf(id)]
+	return ok
+}
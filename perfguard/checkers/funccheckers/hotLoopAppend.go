@@ -0,0 +1,223 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/internal/typeis"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:         "hotLoopAppend",
+		Score:        2,
+		OptLevel:     2,
+		NeedsProfile: true,
+		Impact:       "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &hotLoopAppendChecker{
+			candidates: make(map[types.Object]*hotLoopAppendCandidate),
+		}
+	})
+}
+
+// hotLoopAppendChecker finds slices that are grown one element at a time
+// inside a loop without any capacity reserved for them.
+//
+// Appending in a loop is not a problem by itself, so this checker
+// doesn't run without a profile. With OptLevel=2, the append line itself
+// must have the max heat level for the warning to be reported:
+// only then the slice re-allocations are likely to be significant.
+//
+// The bound of the loop is often unknown, so there is no autofix:
+// it's up to the user to find the right make() capacity argument.
+// Range loops over slices, arrays and maps are skipped, prealloc
+// checker handles them as it knows the bound.
+type hotLoopAppendChecker struct {
+	ctx *lint.Context
+
+	nestedFunc bool
+
+	// loops is a stack of the loops that enclose the current node.
+	loops []ast.Stmt
+
+	candidates map[types.Object]*hotLoopAppendCandidate
+
+	candidatesOrder []types.Object
+}
+
+type hotLoopAppendCandidate struct {
+	typeExpr ast.Expr
+
+	// loopDepth is a number of loops that enclose the slice declaration.
+	loopDepth int
+
+	appendStmt *ast.AssignStmt
+
+	rejected bool
+}
+
+func (c *hotLoopAppendChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.nestedFunc = false
+	c.loops = c.loops[:0]
+	for k := range c.candidates {
+		delete(c.candidates, k)
+	}
+	c.candidatesOrder = c.candidatesOrder[:0]
+
+	ast.Inspect(body, c.walk)
+
+	for _, obj := range c.candidatesOrder {
+		candidate := c.candidates[obj]
+		if candidate.rejected || candidate.appendStmt == nil {
+			continue
+		}
+		ctx.Report(lint.ReportParams{
+			PosNode: candidate.appendStmt,
+			Message: fmt.Sprintf("%s is grown by append in a hot loop, consider preallocating it with make(%s, 0, n)",
+				obj.Name(), ctx.NodeText(candidate.typeExpr)),
+			HotNodes: []ast.Node{candidate.appendStmt},
+		})
+	}
+
+	return nil
+}
+
+func (c *hotLoopAppendChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		nestedFunc := c.nestedFunc
+		c.nestedFunc = true
+		ast.Inspect(n.Body, c.walk)
+		c.nestedFunc = nestedFunc
+		return false
+
+	case *ast.ForStmt:
+		c.loops = append(c.loops, n)
+		ast.Inspect(n.Body, c.walk)
+		c.loops = c.loops[:len(c.loops)-1]
+		return false
+
+	case *ast.RangeStmt:
+		c.loops = append(c.loops, n)
+		ast.Inspect(n.Body, c.walk)
+		c.loops = c.loops[:len(c.loops)-1]
+		return false
+
+	case *ast.ValueSpec:
+		if c.nestedFunc || len(n.Names) != 1 {
+			return true
+		}
+		switch len(n.Values) {
+		case 0:
+			if n.Type != nil {
+				c.track(n.Names[0], n.Type)
+			}
+		case 1:
+			c.visitDecl(n.Names[0], n.Values[0])
+		}
+
+	case *ast.AssignStmt:
+		if c.nestedFunc || len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+			return true
+		}
+		id, ok := n.Lhs[0].(*ast.Ident)
+		if !ok {
+			return true
+		}
+		if n.Tok == token.DEFINE {
+			c.visitDecl(id, n.Rhs[0])
+			return true
+		}
+		candidate := c.candidates[c.ctx.ObjectOf(id)]
+		if candidate == nil {
+			return true
+		}
+		if n.Tok != token.ASSIGN || !c.isSelfAppend(id, n.Rhs[0]) {
+			// Can't tell whether the capacity was reserved.
+			candidate.rejected = true
+			return true
+		}
+		if len(c.loops) > candidate.loopDepth && candidate.appendStmt == nil && !c.isBoundedLoop(c.loops[len(c.loops)-1]) {
+			candidate.appendStmt = n
+		}
+	}
+
+	return true
+}
+
+func (c *hotLoopAppendChecker) visitDecl(id *ast.Ident, init ast.Expr) {
+	switch init := init.(type) {
+	case *ast.CompositeLit:
+		// Track `x := []T{}`.
+		if init.Type != nil && len(init.Elts) == 0 {
+			c.track(id, init.Type)
+		}
+	case *ast.CallExpr:
+		// Track `x := make([]T, 0)`.
+		called, ok := init.Fun.(*ast.Ident)
+		if !ok || called.Name != "make" || len(init.Args) != 2 {
+			return
+		}
+		lengthArg, ok := init.Args[1].(*ast.BasicLit)
+		if !ok || lengthArg.Kind != token.INT || lengthArg.Value != `0` {
+			return
+		}
+		c.track(id, init.Args[0])
+	}
+}
+
+func (c *hotLoopAppendChecker) track(id *ast.Ident, typeExpr ast.Expr) {
+	obj := c.ctx.ObjectOf(id)
+	if obj == nil || !typeis.Slice(obj.Type().Underlying()) {
+		return
+	}
+	c.candidates[obj] = &hotLoopAppendCandidate{
+		typeExpr:  typeExpr,
+		loopDepth: len(c.loops),
+	}
+	c.candidatesOrder = append(c.candidatesOrder, obj)
+}
+
+// isSelfAppend reports whether e is a `append(id, elem)` call.
+func (c *hotLoopAppendChecker) isSelfAppend(id *ast.Ident, e ast.Expr) bool {
+	call, ok := e.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 || call.Ellipsis.IsValid() {
+		return false
+	}
+	called, ok := call.Fun.(*ast.Ident)
+	if !ok || called.Name != "append" {
+		return false
+	}
+	if _, ok := c.ctx.ObjectOf(called).(*types.Builtin); !ok {
+		return false
+	}
+	slice, ok := call.Args[0].(*ast.Ident)
+	return ok && c.ctx.ObjectOf(slice) == c.ctx.ObjectOf(id)
+}
+
+func (c *hotLoopAppendChecker) isBoundedLoop(loop ast.Stmt) bool {
+	rangeLoop, ok := loop.(*ast.RangeStmt)
+	if !ok {
+		return false
+	}
+	typ := c.ctx.TypeOf(rangeLoop.X)
+	if typ == nil {
+		return false
+	}
+	switch typ := typ.Underlying().(type) {
+	case *types.Slice, *types.Array, *types.Map:
+		return true
+	case *types.Pointer:
+		_, isArray := typ.Elem().Underlying().(*types.Array)
+		return isArray
+	default:
+		return false
+	}
+}
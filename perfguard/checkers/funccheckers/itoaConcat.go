@@ -0,0 +1,103 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "itoaConcat",
+		Score:    3,
+		OptLevel: 1,
+		Impact:   "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &itoaConcatChecker{}
+	})
+}
+
+// itoaConcatChecker finds loops that build a delimited string
+// by concatenating the formatted integers to a string accumulator:
+//
+//	for _, id := range ids {
+//		s += strconv.Itoa(id) + ","
+//	}
+//
+// Every concatenation copies the entire accumulator, so the loop is quadratic,
+// and every strconv call allocates a temporary string on top of that.
+// A []byte buffer with strconv.AppendInt writes the digits in place:
+//
+//	buf := make([]byte, 0, len(ids)*4)
+//	for _, id := range ids {
+//		buf = strconv.AppendInt(buf, int64(id), 10)
+//		buf = append(buf, ',')
+//	}
+//	s := string(buf)
+//
+// A strings.Builder with WriteString calls is a simpler alternative
+// that still removes the quadratic copying.
+//
+// Both `s += x` and `s = s + x` forms are matched, the s var should be
+// a string that is declared outside of the loop and x should contain
+// a strconv.Itoa, strconv.FormatInt or strconv.FormatUint call.
+// There is no autofix: the replacement affects the code around the loop.
+type itoaConcatChecker struct {
+	ctx *lint.Context
+}
+
+func (c *itoaConcatChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	walkLoopAssigns(body, c.checkAssign)
+
+	return nil
+}
+
+func (c *itoaConcatChecker) checkAssign(loop ast.Node, assign *ast.AssignStmt) {
+	lhs, concat := matchLoopConcat(c.ctx, loop, assign)
+	if lhs == nil {
+		return
+	}
+
+	itoaFunc := findItoa(c.ctx, concat)
+	if itoaFunc == "" {
+		return
+	}
+
+	c.ctx.Report(lint.ReportParams{
+		PosNode: assign,
+		Message: fmt.Sprintf("%s is built with strconv.%s concat inside a loop, use a []byte buffer with strconv.AppendInt or a strings.Builder",
+			lhs.Name, itoaFunc),
+		HotNodes: []ast.Node{assign},
+	})
+}
+
+// findItoa returns the name of the first strconv integer formatting func
+// that is called inside e; an empty string is returned if there are none.
+func findItoa(ctx *lint.Context, e ast.Expr) string {
+	funcName := ""
+	ast.Inspect(e, func(n ast.Node) bool {
+		if funcName != "" {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sym := resolve.Call(ctx.Target.Types, call)
+		if sym.PkgPath != "strconv" {
+			return true
+		}
+		switch sym.FuncName {
+		case "Itoa", "FormatInt", "FormatUint":
+			funcName = sym.FuncName
+		}
+		return true
+	})
+	return funcName
+}
@@ -0,0 +1,128 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:         "loopBoxing",
+		Score:        2,
+		OptLevel:     2,
+		NeedsProfile: true,
+		Impact:       "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &loopBoxingChecker{}
+	})
+}
+
+// loopBoxingChecker finds concrete values that are passed
+// as interface arguments inside a hot loop.
+// Such a conversion usually allocates a copy of the value on every iteration.
+//
+// The callee parameter types come from the call signature;
+// variadic `...interface{}` params are handled too.
+// The argument is reported if its type is not an interface and it's
+// not a constant. Types that are stored inside the interface without
+// an allocation are skipped: pointer-shaped types (pointers, maps, chans
+// and funcs), zero-sized and single byte types.
+//
+// Every loop is a candidate, the heatmap decides whether it's hot:
+// with OptLevel=2, the argument line must have the max heat level.
+type loopBoxingChecker struct {
+	ctx *lint.Context
+
+	loopDepth int
+}
+
+func (c *loopBoxingChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.loopDepth = 0
+
+	ast.Inspect(body, c.walk)
+
+	return nil
+}
+
+func (c *loopBoxingChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		return false
+
+	case *ast.ForStmt:
+		c.loopDepth++
+		ast.Inspect(n.Body, c.walk)
+		c.loopDepth--
+		return false
+
+	case *ast.RangeStmt:
+		c.loopDepth++
+		ast.Inspect(n.Body, c.walk)
+		c.loopDepth--
+		return false
+
+	case *ast.CallExpr:
+		if c.loopDepth != 0 {
+			c.checkCall(n)
+		}
+	}
+
+	return true
+}
+
+func (c *loopBoxingChecker) checkCall(call *ast.CallExpr) {
+	if call.Ellipsis.IsValid() {
+		return
+	}
+	sig, ok := c.ctx.TypeOf(call.Fun).(*types.Signature)
+	if !ok {
+		return // A conversion or a builtin call
+	}
+	params := sig.Params()
+	for i, arg := range call.Args {
+		var paramType types.Type
+		switch {
+		case sig.Variadic() && i >= params.Len()-1:
+			paramType = params.At(params.Len() - 1).Type().(*types.Slice).Elem()
+		case i < params.Len():
+			paramType = params.At(i).Type()
+		default:
+			return
+		}
+		if !types.IsInterface(paramType) || !c.isBoxingAlloc(arg) {
+			continue
+		}
+		c.ctx.Report(lint.ReportParams{
+			PosNode: arg,
+			Message: fmt.Sprintf("%s is converted to %s on every loop iteration, it may allocate",
+				c.ctx.NodeText(arg), types.TypeString(paramType, types.RelativeTo(c.ctx.Target.Pkg))),
+			HotNodes: []ast.Node{arg},
+		})
+	}
+}
+
+// isBoxingAlloc reports whether arg conversion to an interface may allocate.
+func (c *loopBoxingChecker) isBoxingAlloc(arg ast.Expr) bool {
+	tv, ok := c.ctx.Target.Types.Types[arg]
+	if !ok || tv.Value != nil || tv.IsNil() || tv.Type == nil {
+		return false
+	}
+	if types.IsInterface(tv.Type) {
+		return false
+	}
+	switch typ := tv.Type.Underlying().(type) {
+	case *types.Pointer, *types.Map, *types.Chan, *types.Signature:
+		return false
+	case *types.Basic:
+		if typ.Kind() == types.UnsafePointer {
+			return false
+		}
+	}
+	return c.ctx.Target.Sizes.Sizeof(tv.Type) > 1
+}
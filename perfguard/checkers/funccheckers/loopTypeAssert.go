@@ -0,0 +1,178 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "loopTypeAssert",
+		Score:    2,
+		OptLevel: 2,
+		Impact:   "cpu",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &loopTypeAssertChecker{
+			reported: make(map[*ast.TypeAssertExpr]struct{}),
+		}
+	})
+}
+
+// loopTypeAssertChecker finds `x.(T)` type assertions inside a loop
+// where x is loop-invariant, so every iteration gets the same result.
+//
+// x is loop-invariant if it's a local variable (or a param) that
+// is declared outside of the loop and is never assigned inside of it,
+// including the loop header and the function literals inside the loop.
+// Variables that have their address taken anywhere in the function
+// are skipped as they can be modified through a pointer.
+//
+// If x static type already implements the T interface, the assertion
+// can only fail for a nil x, so it can be replaced by a nil check.
+// Otherwise the assertion can be moved out of the loop.
+//
+// Type switches are not checked.
+// The fix requires a code restructuring, so there is no autofix.
+type loopTypeAssertChecker struct {
+	ctx *lint.Context
+
+	body *ast.BlockStmt
+
+	// reported prevents duplicated warnings for the nested loops.
+	reported map[*ast.TypeAssertExpr]struct{}
+}
+
+func (c *loopTypeAssertChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.body = body
+	for k := range c.reported {
+		delete(c.reported, k)
+	}
+
+	ast.Inspect(body, c.walk)
+
+	return nil
+}
+
+func (c *loopTypeAssertChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		return false
+	case *ast.ForStmt:
+		c.checkLoop(n, n.Body)
+	case *ast.RangeStmt:
+		c.checkLoop(n, n.Body)
+	}
+	return true
+}
+
+func (c *loopTypeAssertChecker) checkLoop(loop ast.Stmt, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.TypeAssertExpr:
+			c.checkAssert(loop, n)
+		}
+		return true
+	})
+}
+
+func (c *loopTypeAssertChecker) checkAssert(loop ast.Stmt, e *ast.TypeAssertExpr) {
+	if e.Type == nil {
+		return // A type switch
+	}
+	if _, ok := c.reported[e]; ok {
+		return
+	}
+	id, ok := e.X.(*ast.Ident)
+	if !ok {
+		return
+	}
+	obj, ok := c.ctx.ObjectOf(id).(*types.Var)
+	if !ok || obj.Parent() == nil || obj.Parent() == c.ctx.Target.Pkg.Scope() {
+		return
+	}
+	if obj.Pos() >= loop.Pos() && obj.Pos() < loop.End() {
+		return
+	}
+	if c.isModified(loop, obj) || c.isAddressTaken(obj) {
+		return
+	}
+
+	c.reported[e] = struct{}{}
+
+	var message string
+	if c.staticallyImplements(obj.Type(), c.ctx.TypeOf(e.Type)) {
+		message = fmt.Sprintf("%s type already implements %s, the %s assertion only fails if %s is nil",
+			id.Name, c.ctx.NodeText(e.Type), c.ctx.NodeText(e), id.Name)
+	} else {
+		message = fmt.Sprintf("%s is not modified inside the loop, move the %s assertion out of it",
+			id.Name, c.ctx.NodeText(e))
+	}
+	c.ctx.Report(lint.ReportParams{
+		PosNode:  e,
+		Message:  message,
+		HotNodes: []ast.Node{e},
+	})
+}
+
+func (c *loopTypeAssertChecker) staticallyImplements(typ, assertType types.Type) bool {
+	if typ == nil || assertType == nil {
+		return false
+	}
+	iface, ok := assertType.Underlying().(*types.Interface)
+	return ok && types.Implements(typ, iface)
+}
+
+// isModified reports whether obj is assigned anywhere inside the loop.
+func (c *loopTypeAssertChecker) isModified(loop ast.Stmt, obj types.Object) bool {
+	isObj := func(e ast.Expr) bool {
+		id, ok := e.(*ast.Ident)
+		return ok && c.ctx.ObjectOf(id) == obj
+	}
+	modified := false
+	ast.Inspect(loop, func(n ast.Node) bool {
+		if modified {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if isObj(lhs) {
+					modified = true
+				}
+			}
+		case *ast.IncDecStmt:
+			modified = isObj(n.X)
+		case *ast.RangeStmt:
+			if n.Tok == token.ASSIGN {
+				modified = (n.Key != nil && isObj(n.Key)) || (n.Value != nil && isObj(n.Value))
+			}
+		}
+		return true
+	})
+	return modified
+}
+
+// isAddressTaken reports whether there is a &obj expression in the function.
+func (c *loopTypeAssertChecker) isAddressTaken(obj types.Object) bool {
+	found := false
+	ast.Inspect(c.body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if e, ok := n.(*ast.UnaryExpr); ok && e.Op == token.AND {
+			id, ok := e.X.(*ast.Ident)
+			found = ok && c.ctx.ObjectOf(id) == obj
+		}
+		return true
+	})
+	return found
+}
@@ -0,0 +1,170 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "lostCancel",
+		Score:    3,
+		LintOnly: true,
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &lostCancelChecker{}
+	})
+}
+
+// lostCancelChecker finds context cancel functions that are never called.
+//
+// A cancel func is considered to be used if it's referenced anywhere
+// inside the function body: a direct call, a deferred call, or passing
+// it somewhere else (we assume that the receiver calls it).
+// Being assigned to and blank assignments like `_ = cancel` are not uses.
+type lostCancelChecker struct {
+	ctx *lint.Context
+
+	nestedFunc bool
+
+	candidates []lostCancelCandidate
+
+	// ignoredIdents are the identifiers that are not counted as cancel func usages.
+	ignoredIdents map[*ast.Ident]struct{}
+}
+
+type lostCancelCandidate struct {
+	id       *ast.Ident
+	obj      types.Object
+	funcName string
+	used     bool
+}
+
+func (c *lostCancelChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.candidates = c.candidates[:0]
+	c.nestedFunc = false
+	if c.ignoredIdents == nil {
+		c.ignoredIdents = make(map[*ast.Ident]struct{})
+	} else {
+		for id := range c.ignoredIdents {
+			delete(c.ignoredIdents, id)
+		}
+	}
+
+	ast.Inspect(body, c.walk)
+	if len(c.candidates) == 0 {
+		return nil
+	}
+
+	ast.Inspect(body, c.walkUsages)
+
+	for _, candidate := range c.candidates {
+		if candidate.used {
+			continue
+		}
+		ctx.Report(lint.ReportParams{
+			PosNode: candidate.id,
+			Message: fmt.Sprintf("the cancel function returned by context.%s is never called, consider a defer cancel() after the context creation",
+				candidate.funcName),
+		})
+	}
+
+	return nil
+}
+
+func (c *lostCancelChecker) walkUsages(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.AssignStmt:
+		for _, lhs := range n.Lhs {
+			if id, ok := lhs.(*ast.Ident); ok {
+				c.ignoredIdents[id] = struct{}{}
+			}
+		}
+		if len(n.Lhs) == len(n.Rhs) {
+			for i, lhs := range n.Lhs {
+				id, ok := n.Rhs[i].(*ast.Ident)
+				if ok && isBlankIdent(lhs) {
+					c.ignoredIdents[id] = struct{}{}
+				}
+			}
+		}
+
+	case *ast.Ident:
+		if _, ok := c.ignoredIdents[n]; ok {
+			return true
+		}
+		obj := c.ctx.Target.Types.Uses[n]
+		if obj == nil {
+			return true
+		}
+		for i := range c.candidates {
+			if c.candidates[i].obj == obj {
+				c.candidates[i].used = true
+			}
+		}
+	}
+
+	return true
+}
+
+func (c *lostCancelChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		nestedFunc := c.nestedFunc
+		c.nestedFunc = true
+		ast.Inspect(n.Body, c.walk)
+		c.nestedFunc = nestedFunc
+		return false
+
+	case *ast.AssignStmt:
+		if c.nestedFunc {
+			return true
+		}
+		if len(n.Lhs) != 2 || len(n.Rhs) != 1 {
+			return true
+		}
+		call, ok := n.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sym := resolve.Call(c.ctx.Target.Types, call)
+		if sym.PkgPath != "context" {
+			return true
+		}
+		switch sym.FuncName {
+		case "WithCancel", "WithTimeout", "WithDeadline":
+			// OK.
+		default:
+			return true
+		}
+		id, ok := n.Lhs[1].(*ast.Ident)
+		if !ok {
+			return true
+		}
+		if isBlankIdent(id) {
+			c.ctx.Report(lint.ReportParams{
+				PosNode: id,
+				Message: fmt.Sprintf("the cancel function returned by context.%s should be called, not discarded", sym.FuncName),
+			})
+			return true
+		}
+		c.candidates = append(c.candidates, lostCancelCandidate{
+			id:       id,
+			obj:      c.ctx.ObjectOf(id),
+			funcName: sym.FuncName,
+		})
+	}
+
+	return true
+}
+
+func isBlankIdent(e ast.Expr) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && id.Name == "_"
+}
@@ -0,0 +1,120 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/typep"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "manualSwap",
+		Score:    1,
+		LintOnly: true,
+		Impact:   "readability",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &manualSwapChecker{}
+	})
+}
+
+// manualSwapChecker finds the two values swap done through a temporary var:
+//
+//	tmp := a
+//	a = b
+//	b = tmp
+//
+// It's replaced with `a, b = b, a` parallel assignment.
+//
+// a and b are matched syntactically: the same expressions should be used
+// in all 3 statements. They can be any side effect free lvalues,
+// like xs[i] and xs[j] or p.x and p.y.
+// The temporary var should not be used in the rest of the block.
+//
+// One side should not be a part of the other one, like p and p.next:
+// the parallel assignment evaluates p.next operands before p is assigned,
+// while the last statement of the manual swap uses the new p value.
+type manualSwapChecker struct {
+	ctx *lint.Context
+}
+
+func (c *manualSwapChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	walkStmtLists(body, c.checkStmtList)
+
+	return nil
+}
+
+func (c *manualSwapChecker) checkStmtList(list []ast.Stmt) {
+	for i := 0; i+2 < len(list); i++ {
+		decl := c.matchAssign(list[i], token.DEFINE)
+		assignA := c.matchAssign(list[i+1], token.ASSIGN)
+		assignB := c.matchAssign(list[i+2], token.ASSIGN)
+		if decl == nil || assignA == nil || assignB == nil {
+			continue
+		}
+		tmp, ok := decl.Lhs[0].(*ast.Ident)
+		if !ok || isBlankIdent(tmp) {
+			continue
+		}
+		a := decl.Rhs[0]
+		b := assignA.Rhs[0]
+		if !astequal.Expr(assignA.Lhs[0], a) || !astequal.Expr(assignB.Lhs[0], b) {
+			continue
+		}
+		tmpUse, ok := assignB.Rhs[0].(*ast.Ident)
+		if !ok || c.ctx.ObjectOf(tmpUse) != c.ctx.ObjectOf(tmp) {
+			continue
+		}
+		if astequal.Expr(a, b) || c.containsExpr(a, b) || c.containsExpr(b, a) {
+			continue
+		}
+		if !typep.SideEffectFree(c.ctx.Target.Types, a) || !typep.SideEffectFree(c.ctx.Target.Types, b) {
+			continue
+		}
+		if refersToList(c.ctx, list[i+3:], c.ctx.ObjectOf(tmp)) {
+			continue
+		}
+
+		aText := c.ctx.NodeText(a)
+		bText := c.ctx.NodeText(b)
+		swap := fmt.Sprintf("%s, %s = %s, %s", aText, bText, bText, aText)
+		c.ctx.MultiChangeSuggest(lint.MultiChangeSuggestParams{
+			ReportPos:     decl.Pos(),
+			ReportMessage: "use " + swap + " to swap the values",
+			OldNodes:      []ast.Node{&nodeRange{from: decl.Pos(), to: assignB.End()}},
+			NewNodes:      []lint.NodeReplacement{{Text: []byte(swap)}},
+		})
+		i += 2
+	}
+}
+
+func (c *manualSwapChecker) matchAssign(stmt ast.Stmt, tok token.Token) *ast.AssignStmt {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != tok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil
+	}
+	return assign
+}
+
+// containsExpr reports whether e has a sub-expression equal to x.
+func (c *manualSwapChecker) containsExpr(e, x ast.Expr) bool {
+	found := false
+	ast.Inspect(e, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if e, ok := n.(ast.Expr); ok && astequal.Expr(e, x) {
+			found = true
+		}
+		return true
+	})
+	return found
+}
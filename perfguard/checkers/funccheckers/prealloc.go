@@ -15,8 +15,9 @@ import (
 
 func init() {
 	doc := checkers.Doc{
-		Name:  "prealloc",
-		Score: 4,
+		Name:   "prealloc",
+		Score:  4,
+		Impact: "alloc",
 	}
 	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
 		return &preallocChecker{allowedUsages: make(map[*ast.Ident]struct{})}
@@ -0,0 +1,163 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/internal/typeis"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "recoverPayload",
+		Score:    1,
+		LintOnly: true,
+		OptIn:    true,
+		Impact:   "cpu",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &recoverPayloadChecker{}
+	})
+}
+
+// recoverPayloadChecker finds recovered values that are
+// type-checked to extract a non-error payload:
+//
+//	defer func() {
+//		if r := recover(); r != nil {
+//			if res, ok := r.(parseResult); ok {
+//				result = res
+//			}
+//		}
+//	}()
+//
+// This is a sign of panic/recover being used as a control flow,
+// for example, to return from a deep recursion.
+// A panic unwinds the stack and runs all deferred calls,
+// it's much slower than a normal return.
+//
+// The recovered values are the vars that are assigned from a recover() call
+// and the recover() calls themselves.
+// Both type switches and type assertions on them are checked.
+// A payload type should not be an interface (this includes error),
+// it should not implement an error and it should not be a string:
+// the error and message payloads are the exceptional panics.
+//
+// The matching panic calls are not traced, so the checker
+// needs to be enabled with -enable flag.
+type recoverPayloadChecker struct {
+	ctx *lint.Context
+
+	recovered map[types.Object]struct{}
+}
+
+func (c *recoverPayloadChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.recovered = make(map[types.Object]struct{})
+
+	ast.Inspect(body, c.walk)
+
+	return nil
+}
+
+func (c *recoverPayloadChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		return false
+
+	case *ast.AssignStmt:
+		if len(n.Lhs) != 1 || len(n.Rhs) != 1 || !c.isRecoverCall(n.Rhs[0]) {
+			return true
+		}
+		if id, ok := n.Lhs[0].(*ast.Ident); ok {
+			if obj := c.ctx.ObjectOf(id); obj != nil {
+				c.recovered[obj] = struct{}{}
+			}
+		}
+
+	case *ast.TypeSwitchStmt:
+		c.checkTypeSwitch(n)
+
+	case *ast.TypeAssertExpr:
+		// Type switches are handled above, their Type is nil.
+		if n.Type != nil && c.isRecovered(n.X) && c.isPayloadType(c.ctx.TypeOf(n.Type)) {
+			c.report(n, n.X, n.Type)
+		}
+	}
+
+	return true
+}
+
+func (c *recoverPayloadChecker) checkTypeSwitch(n *ast.TypeSwitchStmt) {
+	var assert *ast.TypeAssertExpr
+	switch stmt := n.Assign.(type) {
+	case *ast.AssignStmt:
+		assert, _ = stmt.Rhs[0].(*ast.TypeAssertExpr)
+	case *ast.ExprStmt:
+		assert, _ = stmt.X.(*ast.TypeAssertExpr)
+	}
+	if assert == nil || !c.isRecovered(assert.X) {
+		return
+	}
+	for _, stmt := range n.Body.List {
+		for _, typeExpr := range stmt.(*ast.CaseClause).List {
+			if c.isPayloadType(c.ctx.TypeOf(typeExpr)) {
+				c.report(n, assert.X, typeExpr)
+				return
+			}
+		}
+	}
+}
+
+func (c *recoverPayloadChecker) report(n ast.Node, x, typeExpr ast.Expr) {
+	c.ctx.Report(lint.ReportParams{
+		PosNode: n,
+		Message: fmt.Sprintf("%s is recovered to extract a %s payload, panic/recover is slow for a control flow; return the value explicitly",
+			c.ctx.NodeText(x), c.ctx.NodeText(typeExpr)),
+	})
+}
+
+func (c *recoverPayloadChecker) isRecovered(x ast.Expr) bool {
+	if c.isRecoverCall(x) {
+		return true
+	}
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = c.recovered[c.ctx.ObjectOf(id)]
+	return ok
+}
+
+func (c *recoverPayloadChecker) isRecoverCall(x ast.Expr) bool {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+	fn, ok := call.Fun.(*ast.Ident)
+	if !ok || fn.Name != "recover" {
+		return false
+	}
+	_, ok = c.ctx.ObjectOf(fn).(*types.Builtin)
+	return ok
+}
+
+func (c *recoverPayloadChecker) isPayloadType(typ types.Type) bool {
+	if typ == nil || types.IsInterface(typ) || typeis.String(typ) {
+		return false
+	}
+	if basic, ok := typ.(*types.Basic); ok && basic.Kind() == types.UntypedNil {
+		return false // A `case nil` clause
+	}
+	errorIface := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	if types.Implements(typ, errorIface) {
+		return false
+	}
+	if _, ok := typ.(*types.Pointer); !ok && types.Implements(types.NewPointer(typ), errorIface) {
+		return false
+	}
+	return true
+}
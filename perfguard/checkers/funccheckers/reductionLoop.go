@@ -0,0 +1,205 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-toolsmith/astequal"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "reductionLoop",
+		Score:    1,
+		LintOnly: true,
+		OptIn:    true,
+		Impact:   "readability",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &reductionLoopChecker{}
+	})
+}
+
+// reductionLoopChecker finds manual reductions over slices and arrays:
+//
+//	sum := 0
+//	for _, x := range xs {
+//		sum += x
+//	}
+//
+// The accumulator should be initialized right before the range loop:
+// to 0 for sums and counts, to 1 for products.
+// The loop body should be a single statement that updates it:
+//
+//	acc += x           // a sum
+//	acc *= x           // a product
+//	if cond { acc++ }  // a count
+//
+// The x should be the range value variable or xs[i] with i being
+// the range key variable. The count condition must not refer to acc.
+//
+// These loops are correct and there is not much to gain
+// performance-wise, so there is no autofix. The report only
+// marks the reduction as a candidate for a reduce helper function,
+// like a generic Sum or CountFunc, if the project has one.
+// The checker needs to be enabled with -enable flag.
+type reductionLoopChecker struct {
+	ctx *lint.Context
+}
+
+func (c *reductionLoopChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	walkStmtLists(body, c.checkStmtList)
+
+	return nil
+}
+
+func (c *reductionLoopChecker) checkStmtList(list []ast.Stmt) {
+	for i := 0; i+1 < len(list); i++ {
+		acc, init := c.matchInit(list[i])
+		if acc == nil {
+			continue
+		}
+		loop, ok := list[i+1].(*ast.RangeStmt)
+		if !ok || len(loop.Body.List) != 1 || !c.isSliceOrArray(loop.X) {
+			continue
+		}
+		kind := c.reductionKind(loop, acc, init)
+		if kind == "" {
+			continue
+		}
+		c.ctx.Report(lint.ReportParams{
+			PosNode: loop,
+			Message: fmt.Sprintf("%s is a manual %s reduction of %s, consider a reduce helper",
+				acc.Name(), kind, c.ctx.NodeText(loop.X)),
+		})
+		i++
+	}
+}
+
+// matchInit matches `acc := lit` and `var acc = lit` declarations.
+// It returns the acc var and its initial value.
+func (c *reductionLoopChecker) matchInit(stmt ast.Stmt) (*types.Var, string) {
+	var id *ast.Ident
+	var init ast.Expr
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		if stmt.Tok != token.DEFINE || len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 {
+			return nil, ""
+		}
+		id, _ = stmt.Lhs[0].(*ast.Ident)
+		init = stmt.Rhs[0]
+	case *ast.DeclStmt:
+		decl, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.VAR || len(decl.Specs) != 1 {
+			return nil, ""
+		}
+		spec := decl.Specs[0].(*ast.ValueSpec)
+		if len(spec.Names) != 1 || len(spec.Values) != 1 {
+			return nil, ""
+		}
+		id = spec.Names[0]
+		init = spec.Values[0]
+	default:
+		return nil, ""
+	}
+	if id == nil {
+		return nil, ""
+	}
+	lit, ok := init.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return nil, ""
+	}
+	acc, ok := c.ctx.ObjectOf(id).(*types.Var)
+	if !ok {
+		return nil, ""
+	}
+	if basic, ok := acc.Type().Underlying().(*types.Basic); !ok || basic.Info()&types.IsNumeric == 0 {
+		return nil, ""
+	}
+	return acc, lit.Value
+}
+
+// reductionKind returns a sum, product or count if the loop
+// body is a reduction into acc; otherwise it returns "".
+func (c *reductionLoopChecker) reductionKind(loop *ast.RangeStmt, acc *types.Var, init string) string {
+	switch stmt := loop.Body.List[0].(type) {
+	case *ast.AssignStmt:
+		if len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 || !isVar(c.ctx, stmt.Lhs[0], acc) {
+			return ""
+		}
+		if !c.isRangeElem(loop, stmt.Rhs[0]) {
+			return ""
+		}
+		switch {
+		case stmt.Tok == token.ADD_ASSIGN && init == "0":
+			return "sum"
+		case stmt.Tok == token.MUL_ASSIGN && init == "1":
+			return "product"
+		}
+	case *ast.IfStmt:
+		if init != "0" || stmt.Init != nil || stmt.Else != nil || len(stmt.Body.List) != 1 {
+			return ""
+		}
+		if !c.isIncrement(stmt.Body.List[0], acc) || refersTo(c.ctx, stmt.Cond, acc) {
+			return ""
+		}
+		return "count"
+	}
+	return ""
+}
+
+// isIncrement reports whether stmt is `acc++` or `acc += 1`.
+func (c *reductionLoopChecker) isIncrement(stmt ast.Stmt, acc *types.Var) bool {
+	switch stmt := stmt.(type) {
+	case *ast.IncDecStmt:
+		return stmt.Tok == token.INC && isVar(c.ctx, stmt.X, acc)
+	case *ast.AssignStmt:
+		if stmt.Tok != token.ADD_ASSIGN || len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 {
+			return false
+		}
+		lit, ok := stmt.Rhs[0].(*ast.BasicLit)
+		return ok && lit.Value == "1" && isVar(c.ctx, stmt.Lhs[0], acc)
+	}
+	return false
+}
+
+// isRangeElem reports whether e is the loop value var or xs[key].
+func (c *reductionLoopChecker) isRangeElem(loop *ast.RangeStmt, e ast.Expr) bool {
+	if loop.Value != nil {
+		if value, ok := loop.Value.(*ast.Ident); ok && !isBlankIdent(value) {
+			return isVar(c.ctx, e, c.ctx.ObjectOf(value))
+		}
+	}
+	key, ok := loop.Key.(*ast.Ident)
+	if !ok || isBlankIdent(key) {
+		return false
+	}
+	index, ok := e.(*ast.IndexExpr)
+	if !ok {
+		return false
+	}
+	return isVar(c.ctx, index.Index, c.ctx.ObjectOf(key)) && astequal.Expr(index.X, loop.X)
+}
+
+func (c *reductionLoopChecker) isSliceOrArray(e ast.Expr) bool {
+	typ := c.ctx.TypeOf(e)
+	if typ == nil {
+		return false
+	}
+	switch typ := typ.Underlying().(type) {
+	case *types.Slice, *types.Array:
+		return true
+	case *types.Pointer:
+		_, isArray := typ.Elem().Underlying().(*types.Array)
+		return isArray
+	default:
+		return false
+	}
+}
@@ -0,0 +1,156 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:         "regexpHoist",
+		Score:        4,
+		OptLevel:     1,
+		NeedsProfile: true,
+		Impact:       "cpu",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &regexpHoistChecker{}
+	})
+}
+
+// regexpHoistChecker finds regexp compilations of a constant pattern
+// inside the hot functions.
+// The compiled regexp can be created once as a package-level var.
+//
+// Every function body line with a non-zero heat level is a candidate,
+// so it covers both the loops and the functions that are called often.
+// The init functions run only once, they're skipped.
+//
+// The suggested var name is derived from the local var the result
+// is assigned to (re, emailRe) or from the enclosing function name.
+// A "re" prefix is added unless the name already has a "re" prefix
+// (the name is re or the prefix is followed by an uppercase letter)
+// or a "Re" suffix.
+// If that name is taken at the package level, a number suffix is added.
+//
+// Patterns that are not constant can't be hoisted,
+// they're reported by the regexpCompile rule.
+type regexpHoistChecker struct {
+	ctx *lint.Context
+
+	// assigned are the compile calls that were visited as a part of assignment.
+	assigned map[*ast.CallExpr]struct{}
+}
+
+func (c *regexpHoistChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	if ctx.TypeName == "" && ctx.FuncName == "init" {
+		return nil
+	}
+
+	c.ctx = ctx
+	c.assigned = make(map[*ast.CallExpr]struct{})
+
+	ast.Inspect(body, c.walk)
+
+	return nil
+}
+
+func (c *regexpHoistChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		return false
+
+	case *ast.AssignStmt:
+		if len(n.Rhs) != 1 {
+			return true
+		}
+		call, ok := n.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := n.Lhs[0].(*ast.Ident); ok && !isBlankIdent(id) {
+			c.assigned[call] = struct{}{}
+			c.checkCall(call, id.Name)
+		}
+
+	case *ast.CallExpr:
+		if _, ok := c.assigned[n]; !ok {
+			c.checkCall(n, c.ctx.FuncName)
+		}
+	}
+
+	return true
+}
+
+func (c *regexpHoistChecker) checkCall(call *ast.CallExpr, baseName string) {
+	sym := resolve.Call(c.ctx.Target.Types, call)
+	if sym.PkgPath != "regexp" {
+		return
+	}
+	var mustFunc string
+	switch sym.FuncName {
+	case "Compile", "MustCompile":
+		mustFunc = "MustCompile"
+	case "CompilePOSIX", "MustCompilePOSIX":
+		mustFunc = "MustCompilePOSIX"
+	default:
+		return
+	}
+	if len(call.Args) != 1 {
+		return
+	}
+	if tv, ok := c.ctx.Target.Types.Types[call.Args[0]]; !ok || tv.Value == nil {
+		return // Not a constant pattern
+	}
+
+	c.ctx.Report(lint.ReportParams{
+		PosNode: call,
+		Message: fmt.Sprintf("the constant regexp is compiled on every call, move to package-level var %s = regexp.%s(...)",
+			c.varName(baseName), mustFunc),
+		HotNodes: []ast.Node{call},
+	})
+}
+
+func (c *regexpHoistChecker) varName(baseName string) string {
+	name := baseName
+	if name == "" {
+		name = "pattern" // A function literal
+	}
+	hasAffix := hasRePrefix(name) ||
+		strings.HasSuffix(name, "Re") || strings.HasSuffix(name, "Regexp")
+	if !hasAffix {
+		r, size := utf8.DecodeRuneInString(name)
+		name = "re" + string(unicode.ToUpper(r)) + name[size:]
+	}
+	scope := c.ctx.Target.Pkg.Scope()
+	if scope.Lookup(name) == nil {
+		return name
+	}
+	for i := 2; ; i++ {
+		numberedName := name + strconv.Itoa(i)
+		if scope.Lookup(numberedName) == nil {
+			return numberedName
+		}
+	}
+}
+
+// hasRePrefix reports whether name is "re" or starts with a "re" word,
+// like reEmail or ReWord; the names like result or Render don't count.
+func hasRePrefix(name string) bool {
+	if !strings.HasPrefix(name, "re") && !strings.HasPrefix(name, "Re") {
+		return false
+	}
+	if len(name) == len("re") {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(name[len("re"):])
+	return unicode.IsUpper(r)
+}
@@ -0,0 +1,282 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/internal/typeis"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:         "repeatedBytesToString",
+		Score:        3,
+		OptLevel:     2,
+		NeedsProfile: true,
+		Impact:       "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &repeatedBytesToStringChecker{
+			vars:    make(map[*types.Var]*bytesToStringVar),
+			ignored: make(map[*ast.CallExpr]struct{}),
+		}
+	})
+}
+
+// repeatedBytesToStringChecker finds several string(b) conversions
+// of the same []byte variable b. Every conversion allocates a new string,
+// so it's better to convert b once and re-use the result.
+//
+// For every local []byte var we collect its conversions and all other usages.
+// Any usage that is not string(b), len(b) or cap(b) is treated as a possible
+// mutation: the conversions before and after it are counted separately.
+// Only the conversions that are repeated without a possible mutation
+// between them are reported. The conversions should also be in the same
+// statements list: the ones from different if/else branches or switch
+// cases may never be executed together.
+//
+// Conversions inside comparisons, map index expressions and len() are not counted:
+// the compiler doesn't allocate a new string for them.
+// Conversions passed to the strings functions, fmt printf-like functions,
+// regexp methods and WriteString are not counted too: they're removed
+// by the other rules.
+type repeatedBytesToStringChecker struct {
+	ctx *lint.Context
+
+	nestedFunc bool
+
+	// block is the innermost statements list owner:
+	// a block, a case clause or a select clause.
+	block ast.Node
+
+	vars map[*types.Var]*bytesToStringVar
+
+	// ignored is a set of conversions that don't allocate
+	// or that are rewritten by the other rules.
+	ignored map[*ast.CallExpr]struct{}
+
+	varsOrder []*types.Var
+}
+
+type bytesToStringVar struct {
+	// conversions and mutations are sorted by the position as
+	// they're collected during the tree traversal.
+	conversions []bytesToStringConv
+	mutations   []token.Pos
+
+	// capturedByFunc is set if the var is used inside a function literal.
+	// We can't tell when that function will be executed, so
+	// any such var is ignored.
+	capturedByFunc bool
+}
+
+type bytesToStringConv struct {
+	call  *ast.CallExpr
+	block ast.Node
+}
+
+func (c *repeatedBytesToStringChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.nestedFunc = false
+	c.block = nil
+	for k := range c.vars {
+		delete(c.vars, k)
+	}
+	for k := range c.ignored {
+		delete(c.ignored, k)
+	}
+	c.varsOrder = c.varsOrder[:0]
+
+	ast.Inspect(body, c.walk)
+
+	for _, obj := range c.varsOrder {
+		v := c.vars[obj]
+		if v.capturedByFunc || len(v.conversions) < 2 {
+			continue
+		}
+		c.checkVar(obj, v)
+	}
+
+	return nil
+}
+
+func (c *repeatedBytesToStringChecker) checkVar(obj *types.Var, v *bytesToStringVar) {
+	// Split conversions into groups that have no mutations in between
+	// and that belong to the same statements list.
+	var group []ast.Node
+	var block ast.Node
+	mutations := v.mutations
+	flush := func() {
+		if len(group) >= 2 {
+			c.ctx.Report(lint.ReportParams{
+				PosNode:  group[0],
+				Message:  fmt.Sprintf("string(%s) conversion is repeated %d times, convert it once and re-use the result", obj.Name(), len(group)),
+				HotNodes: group,
+			})
+		}
+		group = group[:0]
+	}
+	for _, conv := range v.conversions {
+		mutated := false
+		for len(mutations) != 0 && mutations[0] < conv.call.Pos() {
+			mutated = true
+			mutations = mutations[1:]
+		}
+		if mutated || conv.block != block {
+			flush()
+		}
+		block = conv.block
+		group = append(group, conv.call)
+	}
+	flush()
+}
+
+func (c *repeatedBytesToStringChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		nestedFunc := c.nestedFunc
+		c.nestedFunc = true
+		ast.Inspect(n.Body, c.walk)
+		c.nestedFunc = nestedFunc
+		return false
+
+	case *ast.BlockStmt, *ast.CaseClause, *ast.CommClause:
+		if n == c.block {
+			return true
+		}
+		block := c.block
+		c.block = n
+		ast.Inspect(n, c.walk)
+		c.block = block
+		return false
+
+	case *ast.IndexExpr:
+		if typeis.Map(c.ctx.TypeOf(n.X).Underlying()) {
+			c.markIgnored(n.Index)
+		}
+
+	case *ast.BinaryExpr:
+		switch n.Op {
+		case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			c.markIgnored(n.X)
+			c.markIgnored(n.Y)
+		}
+
+	case *ast.CallExpr:
+		if _, ok := c.ignored[n]; ok {
+			return false
+		}
+		if c.isRewrittenCall(n) {
+			for _, arg := range n.Args {
+				c.markIgnored(arg)
+			}
+		}
+		if len(n.Args) != 1 {
+			return true
+		}
+		if isBuiltinCall(c.ctx, n, "len") {
+			c.markIgnored(n.Args[0])
+		}
+		arg, ok := n.Args[0].(*ast.Ident)
+		if !ok {
+			return true
+		}
+		v := c.getVar(arg)
+		if v == nil {
+			return true
+		}
+		if c.nestedFunc {
+			v.capturedByFunc = true
+			return false
+		}
+		if isBuiltinCall(c.ctx, n, "len") || isBuiltinCall(c.ctx, n, "cap") {
+			return false
+		}
+		conv := resolve.ConvExpr(c.ctx.Target.Types, n)
+		if conv.DstType != nil && typeis.String(conv.DstType) {
+			v.conversions = append(v.conversions, bytesToStringConv{call: n, block: c.block})
+			return false
+		}
+
+	case *ast.Ident:
+		v := c.getVar(n)
+		if v == nil {
+			return true
+		}
+		if c.nestedFunc {
+			v.capturedByFunc = true
+			return true
+		}
+		v.mutations = append(v.mutations, n.Pos())
+	}
+
+	return true
+}
+
+// markIgnored adds e to the ignored set if it's a string(b) conversion.
+// Other expressions are walked as usual, their arguments
+// may contain the var mutations.
+func (c *repeatedBytesToStringChecker) markIgnored(e ast.Expr) {
+	call, ok := e.(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	conv := resolve.ConvExpr(c.ctx.Target.Types, call)
+	if conv.DstType == nil || !typeis.String(conv.DstType) {
+		return
+	}
+	if _, ok := conv.Arg.(*ast.Ident); ok {
+		c.ignored[call] = struct{}{}
+	}
+}
+
+// isRewrittenCall reports whether string(b) arguments of the call
+// are removed by the other rules, like strings.Contains(string(b), s)
+// that is rewritten to bytes.Contains(b, []byte(s)).
+func (c *repeatedBytesToStringChecker) isRewrittenCall(call *ast.CallExpr) bool {
+	sym := resolve.Call(c.ctx.Target.Types, call)
+	switch sym.PkgPath {
+	case "strings":
+		return true
+	case "fmt":
+		return sym.FuncName == "Sprintf" || sym.FuncName == "Printf" || sym.FuncName == "Fprintf"
+	}
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	selection := c.ctx.Target.Types.Selections[selector]
+	if selection == nil || selection.Kind() != types.MethodVal {
+		return false
+	}
+	if selector.Sel.Name == "WriteString" {
+		return true
+	}
+	recv := selection.Recv()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	return typeis.Named(recv, "regexp", "Regexp")
+}
+
+func (c *repeatedBytesToStringChecker) getVar(id *ast.Ident) *bytesToStringVar {
+	obj, ok := c.ctx.Target.Types.ObjectOf(id).(*types.Var)
+	if !ok || obj.IsField() || obj.Pkg() == nil || obj.Parent() == nil || obj.Parent() == obj.Pkg().Scope() {
+		return nil
+	}
+	if !typeis.ByteSlice(obj.Type().Underlying()) {
+		return nil
+	}
+	v := c.vars[obj]
+	if v == nil {
+		v = &bytesToStringVar{}
+		c.vars[obj] = v
+		c.varsOrder = append(c.varsOrder, obj)
+	}
+	return v
+}
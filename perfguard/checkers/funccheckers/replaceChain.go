@@ -0,0 +1,146 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "replaceChain",
+		Score:    3,
+		OptLevel: 2,
+		Impact:   "cpu",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &replaceChainChecker{}
+	})
+}
+
+// replaceChainChecker finds consecutive strings.Replace calls
+// that rewrite the same string variable.
+// Every call makes a full pass over the string (and allocates a new one),
+// while strings.Replacer does all replacements in one pass.
+//
+// A chain starts with `s = strings.Replace(x, old, new, -1)` or
+// `s := strings.Replace(x, old, new, -1)` and continues while the next
+// statement is `s = strings.Replace(s, old, new, -1)`.
+// strings.ReplaceAll calls are handled in the same way.
+// Calls with n other than -1 are not chained: Replacer always replaces everything.
+// The chain is reported if it has at least 2 calls.
+//
+// There is no autofix: the Replacer works differently when the replacements
+// depend on each other, so the rewrite needs a review.
+// It's also better to create the Replacer once, outside of the hot code.
+type replaceChainChecker struct {
+	ctx *lint.Context
+}
+
+type replaceChainLink struct {
+	stmt *ast.AssignStmt
+	call *ast.CallExpr
+}
+
+func (c *replaceChainChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+
+	walkStmtLists(body, c.checkStmtList)
+
+	return nil
+}
+
+func (c *replaceChainChecker) checkStmtList(list []ast.Stmt) {
+	for i := 0; i < len(list); i++ {
+		first, obj := c.matchLink(list[i], nil)
+		if first.call == nil {
+			continue
+		}
+		chain := []replaceChainLink{first}
+		for _, stmt := range list[i+1:] {
+			link, _ := c.matchLink(stmt, obj)
+			if link.call == nil {
+				break
+			}
+			chain = append(chain, link)
+		}
+		if len(chain) < 2 {
+			continue
+		}
+		i += len(chain) - 1
+
+		hotNodes := make([]ast.Node, len(chain))
+		for j := range chain {
+			hotNodes[j] = chain[j].call
+		}
+		c.ctx.Report(lint.ReportParams{
+			PosNode: first.stmt,
+			Message: fmt.Sprintf("%s is rewritten by %d replace calls in a row, consider a single strings.NewReplacer pass",
+				obj.Name(), len(chain)),
+			HotNodes: hotNodes,
+		})
+	}
+}
+
+// matchLink checks whether stmt is a chain link.
+// If obj is nil, it matches a chain start.
+// Otherwise, it matches a continuation of the obj chain.
+func (c *replaceChainChecker) matchLink(stmt ast.Stmt, obj types.Object) (replaceChainLink, types.Object) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return replaceChainLink{}, nil
+	}
+	if assign.Tok != token.ASSIGN && (assign.Tok != token.DEFINE || obj != nil) {
+		return replaceChainLink{}, nil
+	}
+	lhs, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || isBlankIdent(lhs) {
+		return replaceChainLink{}, nil
+	}
+	lhsObj := c.ctx.ObjectOf(lhs)
+	if lhsObj == nil || (obj != nil && lhsObj != obj) {
+		return replaceChainLink{}, nil
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || !c.isReplaceAll(call) {
+		return replaceChainLink{}, nil
+	}
+	if obj != nil {
+		src, ok := call.Args[0].(*ast.Ident)
+		if !ok || c.ctx.ObjectOf(src) != obj {
+			return replaceChainLink{}, nil
+		}
+	}
+	return replaceChainLink{stmt: assign, call: call}, lhsObj
+}
+
+// isReplaceAll reports whether call is strings.ReplaceAll
+// or an equivalent strings.Replace call.
+func (c *replaceChainChecker) isReplaceAll(call *ast.CallExpr) bool {
+	sym := resolve.Call(c.ctx.Target.Types, call)
+	if sym.PkgPath != "strings" {
+		return false
+	}
+	switch sym.FuncName {
+	case "ReplaceAll":
+		return len(call.Args) == 3
+	case "Replace":
+		if len(call.Args) != 4 {
+			return false
+		}
+		tv, ok := c.ctx.Target.Types.Types[call.Args[3]]
+		if !ok || tv.Value == nil {
+			return false
+		}
+		n, exact := constant.Int64Val(constant.ToInt(tv.Value))
+		return exact && n == -1
+	default:
+		return false
+	}
+}
@@ -0,0 +1,193 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "scannerBuffer",
+		Score:    2,
+		LintOnly: true,
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &scannerBufferChecker{
+			candidates: make(map[types.Object]*scannerBufferCandidate),
+		}
+	})
+}
+
+// scannerBufferChecker finds line scanners without a Buffer call.
+//
+// bufio.Scanner has a max token size of 64KB (bufio.MaxScanTokenSize).
+// When a line is longer than that, Scan returns false and Err returns
+// bufio.ErrTooLong; if the loop doesn't check Err, the rest of the
+// input is silently ignored. This is a robustness warning, not a performance one.
+//
+// A candidate is a local `s := bufio.NewScanner(r)` var that is used
+// only through its method calls and has at least one Scan call.
+// If s is passed or assigned somewhere, the Buffer may be
+// called there, so it's not reported.
+//
+// Only the line scanners are reported: the default ScanLines split func
+// or an explicit s.Split(bufio.ScanLines) call.
+// Lines come from the input data that the program usually
+// doesn't control, while words or runes rarely reach the limit.
+type scannerBufferChecker struct {
+	ctx *lint.Context
+
+	nestedFunc bool
+
+	candidates map[types.Object]*scannerBufferCandidate
+
+	candidatesOrder []types.Object
+
+	// declIdents are the candidates declaration identifiers.
+	declIdents map[*ast.Ident]struct{}
+
+	// methodIdents are the candidates identifiers that are used as the method call receivers.
+	methodIdents map[*ast.Ident]struct{}
+}
+
+type scannerBufferCandidate struct {
+	decl *ast.AssignStmt
+
+	scanned  bool
+	rejected bool
+}
+
+func (c *scannerBufferChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.nestedFunc = false
+	for k := range c.candidates {
+		delete(c.candidates, k)
+	}
+	c.candidatesOrder = c.candidatesOrder[:0]
+	c.declIdents = make(map[*ast.Ident]struct{})
+	c.methodIdents = make(map[*ast.Ident]struct{})
+
+	ast.Inspect(body, c.walk)
+	if len(c.candidates) == 0 {
+		return nil
+	}
+
+	ast.Inspect(body, c.walkUsages)
+
+	for _, obj := range c.candidatesOrder {
+		candidate := c.candidates[obj]
+		if candidate.rejected || !candidate.scanned {
+			continue
+		}
+		ctx.Report(lint.ReportParams{
+			PosNode: candidate.decl,
+			Message: fmt.Sprintf("%s fails on lines longer than bufio.MaxScanTokenSize (64KB), consider a %s.Buffer() call to raise the limit",
+				obj.Name(), obj.Name()),
+		})
+	}
+
+	return nil
+}
+
+func (c *scannerBufferChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		nestedFunc := c.nestedFunc
+		c.nestedFunc = true
+		ast.Inspect(n.Body, c.walk)
+		c.nestedFunc = nestedFunc
+		return false
+
+	case *ast.AssignStmt:
+		if c.nestedFunc || n.Tok != token.DEFINE || len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+			return true
+		}
+		id, ok := n.Lhs[0].(*ast.Ident)
+		if !ok || isBlankIdent(id) {
+			return true
+		}
+		call, ok := n.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sym := resolve.Call(c.ctx.Target.Types, call)
+		if sym.PkgPath != "bufio" || sym.FuncName != "NewScanner" {
+			return true
+		}
+		obj := c.ctx.ObjectOf(id)
+		c.candidates[obj] = &scannerBufferCandidate{decl: n}
+		c.candidatesOrder = append(c.candidatesOrder, obj)
+		c.declIdents[id] = struct{}{}
+
+	case *ast.CallExpr:
+		c.visitMethodCall(n)
+	}
+
+	return true
+}
+
+func (c *scannerBufferChecker) visitMethodCall(call *ast.CallExpr) {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	id, ok := selector.X.(*ast.Ident)
+	if !ok {
+		return
+	}
+	candidate := c.candidates[c.ctx.ObjectOf(id)]
+	if candidate == nil {
+		return
+	}
+	c.methodIdents[id] = struct{}{}
+	switch selector.Sel.Name {
+	case "Scan":
+		candidate.scanned = true
+	case "Buffer":
+		candidate.rejected = true
+	case "Split":
+		if len(call.Args) != 1 || !c.isScanLines(call.Args[0]) {
+			candidate.rejected = true
+		}
+	}
+}
+
+// walkUsages rejects the candidates that are used in any other way
+// than a method call receiver.
+func (c *scannerBufferChecker) walkUsages(n ast.Node) bool {
+	id, ok := n.(*ast.Ident)
+	if !ok {
+		return true
+	}
+	candidate := c.candidates[c.ctx.ObjectOf(id)]
+	if candidate == nil {
+		return true
+	}
+	if _, ok := c.declIdents[id]; ok {
+		return true
+	}
+	if _, ok := c.methodIdents[id]; ok {
+		return true
+	}
+	candidate.rejected = true
+	return true
+}
+
+func (c *scannerBufferChecker) isScanLines(e ast.Expr) bool {
+	selector, ok := e.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != "ScanLines" {
+		return false
+	}
+	pkg, ok := selector.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pkgName, ok := c.ctx.ObjectOf(pkg).(*types.PkgName)
+	return ok && pkgName.Imported().Path() == "bufio"
+}
@@ -0,0 +1,172 @@
+package funccheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "splitN",
+		Score:    2,
+		OptLevel: 2,
+		Impact:   "alloc",
+	}
+	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
+		return &splitNChecker{
+			candidates: make(map[types.Object]*splitNCandidate),
+		}
+	})
+}
+
+// splitNChecker finds strings.Split and bytes.Split results that are
+// only accessed by small constant indexes.
+// SplitN can stop splitting after the last part that is needed.
+//
+// The result var is a candidate if it's defined as `parts := strings.Split(s, sep)`.
+// Every reference to it must be an index expression with a constant index,
+// anything else (len(parts), range, passing it somewhere, re-assignment)
+// makes the result length observable and the candidate is discarded.
+//
+// Note that the last part returned by SplitN contains the unsplit remainder,
+// so to keep parts[k] intact the N bound is k+2, not k+1.
+type splitNChecker struct {
+	ctx *lint.Context
+
+	nestedFunc bool
+
+	candidates map[types.Object]*splitNCandidate
+
+	candidatesOrder []types.Object
+}
+
+type splitNCandidate struct {
+	call    *ast.CallExpr
+	pkgName string
+
+	maxIndex int64
+	rejected bool
+}
+
+func (c *splitNChecker) CheckFunc(ctx *lint.Context, body *ast.BlockStmt) error {
+	c.ctx = ctx
+	c.nestedFunc = false
+	for k := range c.candidates {
+		delete(c.candidates, k)
+	}
+	c.candidatesOrder = c.candidatesOrder[:0]
+
+	ast.Inspect(body, c.walk)
+	if len(c.candidates) == 0 {
+		return nil
+	}
+
+	ast.Inspect(body, c.walkUsages)
+
+	for _, obj := range c.candidatesOrder {
+		candidate := c.candidates[obj]
+		if candidate.rejected || candidate.maxIndex < 0 {
+			continue
+		}
+		ctx.Report(lint.ReportParams{
+			PosNode: candidate.call,
+			Message: fmt.Sprintf("%s result is only indexed up to %d, use %s.SplitN(%s, %s, %d)",
+				ctx.NodeText(candidate.call), candidate.maxIndex, candidate.pkgName,
+				ctx.NodeText(candidate.call.Args[0]), ctx.NodeText(candidate.call.Args[1]),
+				candidate.maxIndex+2),
+		})
+	}
+
+	return nil
+}
+
+func (c *splitNChecker) walkUsages(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.IndexExpr:
+		id, ok := n.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		candidate := c.candidates[c.ctx.Target.Types.Uses[id]]
+		if candidate == nil {
+			return true
+		}
+		index, ok := c.constIndex(n.Index)
+		if !ok {
+			candidate.rejected = true
+			return true
+		}
+		if index > candidate.maxIndex {
+			candidate.maxIndex = index
+		}
+		// Don't visit n.X as it would be counted as a non-index usage.
+		ast.Inspect(n.Index, c.walkUsages)
+		return false
+
+	case *ast.Ident:
+		candidate := c.candidates[c.ctx.Target.Types.Uses[n]]
+		if candidate != nil {
+			candidate.rejected = true
+		}
+	}
+
+	return true
+}
+
+func (c *splitNChecker) walk(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		nestedFunc := c.nestedFunc
+		c.nestedFunc = true
+		ast.Inspect(n.Body, c.walk)
+		c.nestedFunc = nestedFunc
+		return false
+
+	case *ast.AssignStmt:
+		if c.nestedFunc || n.Tok != token.DEFINE {
+			return true
+		}
+		if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+			return true
+		}
+		id, ok := n.Lhs[0].(*ast.Ident)
+		if !ok || isBlankIdent(id) {
+			return true
+		}
+		call, ok := n.Rhs[0].(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 || call.Ellipsis.IsValid() {
+			return true
+		}
+		sym := resolve.Call(c.ctx.Target.Types, call)
+		if sym.FuncName != "Split" || (sym.PkgPath != "strings" && sym.PkgPath != "bytes") {
+			return true
+		}
+		obj := c.ctx.ObjectOf(id)
+		if obj == nil {
+			return true
+		}
+		c.candidates[obj] = &splitNCandidate{
+			call:     call,
+			pkgName:  sym.PkgName,
+			maxIndex: -1,
+		}
+		c.candidatesOrder = append(c.candidatesOrder, obj)
+	}
+
+	return true
+}
+
+func (c *splitNChecker) constIndex(e ast.Expr) (int64, bool) {
+	tv, ok := c.ctx.Target.Types.Types[e]
+	if !ok || tv.Value == nil {
+		return 0, false
+	}
+	return constant.Int64Val(constant.ToInt(tv.Value))
+}
@@ -12,8 +12,9 @@ import (
 
 func init() {
 	doc := checkers.Doc{
-		Name:  "stringsBuilder",
-		Score: 4,
+		Name:   "stringsBuilder",
+		Score:  4,
+		Impact: "alloc",
 	}
 	checkers.RegisterFuncChecker(doc, func() checkers.FuncChecker {
 		return &stringsBuilderChecker{
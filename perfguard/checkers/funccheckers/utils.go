@@ -3,9 +3,165 @@ package funccheckers
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/internal/typeis"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
 )
 
 var zeroLitNode = &ast.BasicLit{
 	Kind:  token.INT,
 	Value: `0`,
 }
+
+// nodeRange is a pseudo node that covers several nodes,
+// like a sequence of statements that is replaced as a whole.
+type nodeRange struct {
+	from token.Pos
+	to   token.Pos
+}
+
+func (n *nodeRange) Pos() token.Pos { return n.from }
+func (n *nodeRange) End() token.Pos { return n.to }
+
+// walkStmtLists calls visit for every statements list inside the body:
+// the blocks, the case clauses and the select clauses.
+// The nested function literals are not visited.
+func walkStmtLists(body *ast.BlockStmt, visit func(list []ast.Stmt)) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.BlockStmt:
+			visit(n.List)
+		case *ast.CaseClause:
+			visit(n.Body)
+		case *ast.CommClause:
+			visit(n.Body)
+		}
+		return true
+	})
+}
+
+func isBuiltinCall(ctx *lint.Context, call *ast.CallExpr, name string) bool {
+	fn, ok := call.Fun.(*ast.Ident)
+	if !ok || fn.Name != name {
+		return false
+	}
+	_, ok = ctx.ObjectOf(fn).(*types.Builtin)
+	return ok
+}
+
+// isVar reports whether e is an identifier that refers to obj.
+func isVar(ctx *lint.Context, e ast.Expr, obj types.Object) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && obj != nil && ctx.ObjectOf(id) == obj
+}
+
+// refersTo reports whether n contains an identifier that refers to obj.
+func refersTo(ctx *lint.Context, n ast.Node, obj types.Object) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if id, ok := n.(*ast.Ident); ok && ctx.ObjectOf(id) == obj {
+			found = true
+		}
+		return true
+	})
+	return found
+}
+
+// refersToList is like refersTo, but it checks all statements of the list.
+func refersToList(ctx *lint.Context, list []ast.Stmt, obj types.Object) bool {
+	for _, stmt := range list {
+		if refersTo(ctx, stmt, obj) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkLoopAssigns calls visit for every assignment inside the body
+// that is nested into a for or range loop; loop is the innermost one.
+// The nested function literals are not visited.
+func walkLoopAssigns(body *ast.BlockStmt, visit func(loop ast.Node, assign *ast.AssignStmt)) {
+	var loop ast.Node
+	var walk func(n ast.Node) bool
+	walkLoop := func(n ast.Node, body *ast.BlockStmt) {
+		outer := loop
+		loop = n
+		ast.Inspect(body, walk)
+		loop = outer
+	}
+	walk = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ForStmt:
+			walkLoop(n, n.Body)
+			return false
+		case *ast.RangeStmt:
+			walkLoop(n, n.Body)
+			return false
+		case *ast.AssignStmt:
+			if loop != nil {
+				visit(loop, n)
+			}
+		}
+		return true
+	}
+	ast.Inspect(body, walk)
+}
+
+// matchLoopConcat matches `s += x` and `s = s + x` string accumulator
+// updates inside the loop, the s var should be declared outside of it.
+// It returns the s identifier and the concatenated expression
+// (x for the first form and the entire rhs for the second one).
+// A nil identifier is returned if the assignment doesn't match.
+func matchLoopConcat(ctx *lint.Context, loop ast.Node, assign *ast.AssignStmt) (*ast.Ident, ast.Expr) {
+	if len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, nil
+	}
+	lhs, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	v, ok := ctx.ObjectOf(lhs).(*types.Var)
+	if !ok || !typeis.String(v.Type().Underlying()) {
+		return nil, nil
+	}
+	if v.Pos() >= loop.Pos() && v.Pos() < loop.End() {
+		return nil, nil // Declared inside the loop, it's not an accumulator
+	}
+
+	switch assign.Tok {
+	case token.ADD_ASSIGN:
+		return lhs, assign.Rhs[0]
+	case token.ASSIGN:
+		rhs, ok := assign.Rhs[0].(*ast.BinaryExpr)
+		if !ok || rhs.Op != token.ADD {
+			return nil, nil
+		}
+		x, ok := concatHead(rhs).(*ast.Ident)
+		if !ok || ctx.ObjectOf(x) != v {
+			return nil, nil
+		}
+		return lhs, rhs
+	default:
+		return nil, nil
+	}
+}
+
+// concatHead returns the leftmost operand of a concatenation chain.
+func concatHead(e *ast.BinaryExpr) ast.Expr {
+	x := e.X
+	for {
+		bin, ok := x.(*ast.BinaryExpr)
+		if !ok || bin.Op != token.ADD {
+			return x
+		}
+		x = bin.X
+	}
+}
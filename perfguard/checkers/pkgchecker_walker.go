@@ -0,0 +1,34 @@
+package checkers
+
+import (
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+type pkgcheckerWithContext struct {
+	ctx lint.Context
+	obj PkgChecker
+}
+
+type pkgcheckerWalker struct {
+	checkers []pkgcheckerWithContext
+}
+
+func (w *pkgcheckerWalker) CheckPackage(ctx *lint.SharedContext, files []lint.SourceFile) error {
+	for i := range w.checkers {
+		w.checkers[i].ctx.SharedContext = ctx
+	}
+
+	var checkError error
+
+	// There is no current file or function for the package checkers.
+	ctx.Filename = ""
+	ctx.TypeName = ""
+	ctx.FuncName = ""
+	for _, c := range w.checkers {
+		if err := c.obj.CheckPkg(&c.ctx, files); err != nil {
+			checkError = err
+		}
+	}
+
+	return checkError
+}
@@ -0,0 +1,261 @@
+package pkgcheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "redundantMutex",
+		Score:    1,
+		LintOnly: true,
+		OptIn:    true,
+		Impact:   "cpu",
+	}
+	checkers.RegisterPkgChecker(doc, func() checkers.PkgChecker {
+		return &redundantMutexChecker{}
+	})
+}
+
+// redundantMutexChecker finds mutex fields that are likely to be
+// used from a single goroutine only.
+// An uncontended Lock+Unlock pair is cheap, but it's not free.
+//
+// Proving that some data is never accessed concurrently is
+// not possible without a whole program analysis, so the
+// checker uses a very conservative heuristic:
+//   - the field is an unexported sync.Mutex or sync.RWMutex (not embedded)
+//   - the field is only used as x.mu.Lock(), x.mu.Unlock() and their R-variants
+//   - all these calls are located inside one unexported method
+//     and not inside any function literal
+//   - this method is only called directly, never used as a method value
+//   - the package doesn't start any goroutines
+//
+// It still can't know whether the package clients call the
+// exported API from several goroutines concurrently,
+// so the checker needs to be enabled with -enable flag.
+type redundantMutexChecker struct {
+	ctx *lint.Context
+
+	hasGoStmt bool
+
+	// funcDecl is a currently walked function declaration.
+	funcDecl *ast.FuncDecl
+
+	// candidates maps the field to its usages state.
+	candidates map[*types.Var]*redundantMutexCandidate
+
+	candidatesOrder []*types.Var
+
+	// methods are the candidates lock methods.
+	methods map[*types.Func]struct{}
+}
+
+type redundantMutexCandidate struct {
+	ident *ast.Ident
+
+	// method is a function that contains all lock sites.
+	method *ast.FuncDecl
+
+	rejected bool
+}
+
+func (c *redundantMutexChecker) CheckPkg(ctx *lint.Context, files []lint.SourceFile) error {
+	c.ctx = ctx
+	c.hasGoStmt = false
+	c.candidates = make(map[*types.Var]*redundantMutexCandidate)
+	c.candidatesOrder = c.candidatesOrder[:0]
+	c.methods = make(map[*types.Func]struct{})
+
+	for _, f := range files {
+		for _, decl := range f.Syntax.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				c.collectFields(spec.(*ast.TypeSpec))
+			}
+		}
+	}
+	if len(c.candidates) == 0 {
+		return nil
+	}
+
+	for _, f := range files {
+		for _, decl := range f.Syntax.Decls {
+			c.funcDecl, _ = decl.(*ast.FuncDecl)
+			c.walk(decl, c.visitSelector)
+		}
+	}
+	if c.hasGoStmt {
+		return nil
+	}
+
+	for _, field := range c.candidatesOrder {
+		candidate := c.candidates[field]
+		if candidate.rejected || candidate.method == nil {
+			continue
+		}
+		c.methods[ctx.Target.Types.Defs[candidate.method.Name].(*types.Func)] = struct{}{}
+	}
+	for _, f := range files {
+		c.walk(f.Syntax, c.visitMethodUsage)
+	}
+
+	for _, field := range c.candidatesOrder {
+		candidate := c.candidates[field]
+		if candidate.rejected || candidate.method == nil {
+			continue
+		}
+		ctx.Report(lint.ReportParams{
+			PosNode: candidate.ident,
+			Message: fmt.Sprintf("%s is only locked inside the %s method and the package starts no goroutines, the mutex may be redundant",
+				field.Name(), candidate.method.Name.Name),
+		})
+	}
+
+	return nil
+}
+
+func (c *redundantMutexChecker) collectFields(spec *ast.TypeSpec) {
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return
+	}
+	for _, field := range st.Fields.List {
+		for _, name := range field.Names {
+			obj, ok := c.ctx.Target.Types.Defs[name].(*types.Var)
+			if !ok || obj.Exported() || !c.isMutex(obj.Type()) {
+				continue
+			}
+			c.candidates[obj] = &redundantMutexCandidate{ident: name}
+			c.candidatesOrder = append(c.candidatesOrder, obj)
+		}
+	}
+}
+
+// walk calls visit for every root node with a stack of its parents.
+// The closest parent is the last one.
+func (c *redundantMutexChecker) walk(root ast.Node, visit func(n ast.Node, stack []ast.Node)) {
+	var stack []ast.Node
+	ast.Inspect(root, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		if _, ok := n.(*ast.GoStmt); ok {
+			c.hasGoStmt = true
+		}
+		visit(n, stack)
+		stack = append(stack, n)
+		return true
+	})
+}
+
+func (c *redundantMutexChecker) visitSelector(n ast.Node, stack []ast.Node) {
+	sel, ok := n.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	selection := c.ctx.Target.Types.Selections[sel]
+	if selection == nil || selection.Kind() != types.FieldVal {
+		return
+	}
+	field, ok := selection.Obj().(*types.Var)
+	if !ok {
+		return
+	}
+	candidate := c.candidates[field]
+	if candidate == nil {
+		return
+	}
+	if !c.isLockCall(sel, stack) || !c.isLockMethod(c.funcDecl) || c.insideFuncLit(stack) {
+		candidate.rejected = true
+		return
+	}
+	if candidate.method != nil && candidate.method != c.funcDecl {
+		candidate.rejected = true
+		return
+	}
+	candidate.method = c.funcDecl
+}
+
+// visitMethodUsage rejects the candidates which lock method
+// is used in any other way than a direct call.
+func (c *redundantMutexChecker) visitMethodUsage(n ast.Node, stack []ast.Node) {
+	sel, ok := n.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	fn, ok := c.ctx.ObjectOf(sel.Sel).(*types.Func)
+	if !ok {
+		return
+	}
+	if _, ok := c.methods[fn]; !ok {
+		return
+	}
+	if len(stack) != 0 {
+		if call, ok := stack[len(stack)-1].(*ast.CallExpr); ok && call.Fun == sel {
+			return
+		}
+	}
+	for _, field := range c.candidatesOrder {
+		candidate := c.candidates[field]
+		if candidate.method != nil && c.ctx.Target.Types.Defs[candidate.method.Name] == fn {
+			candidate.rejected = true
+		}
+	}
+}
+
+// isLockCall reports whether the e field is used as x.mu.Lock()-like call receiver.
+func (c *redundantMutexChecker) isLockCall(e ast.Expr, stack []ast.Node) bool {
+	if len(stack) < 2 {
+		return false
+	}
+	sel, ok := stack[len(stack)-1].(*ast.SelectorExpr)
+	if !ok || sel.X != e {
+		return false
+	}
+	call, ok := stack[len(stack)-2].(*ast.CallExpr)
+	if !ok || call.Fun != sel {
+		return false
+	}
+	switch sel.Sel.Name {
+	case "Lock", "Unlock", "RLock", "RUnlock":
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *redundantMutexChecker) isLockMethod(fn *ast.FuncDecl) bool {
+	return fn != nil && fn.Recv != nil && !fn.Name.IsExported()
+}
+
+func (c *redundantMutexChecker) insideFuncLit(stack []ast.Node) bool {
+	for _, n := range stack {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *redundantMutexChecker) isMutex(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != "sync" {
+		return false
+	}
+	return obj.Name() == "Mutex" || obj.Name() == "RWMutex"
+}
@@ -0,0 +1,247 @@
+package pkgcheckers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/quasilyte/go-perfguard/internal/resolve"
+	"github.com/quasilyte/go-perfguard/perfguard/checkers"
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+func init() {
+	doc := checkers.Doc{
+		Name:     "timeFields",
+		Score:    1,
+		LintOnly: true,
+		OptIn:    true,
+		Impact:   "alloc",
+	}
+	checkers.RegisterPkgChecker(doc, func() checkers.PkgChecker {
+		return &timeFieldsChecker{}
+	})
+}
+
+// timeFieldsChecker finds struct types with several time.Time fields
+// that are only used as points in time: compared, subtracted or
+// converted to Unix timestamps.
+// An int64 Unix timestamp is 3 times smaller than time.Time,
+// which matters for the large slices of records.
+//
+// All field usages inside the package are classified:
+//   - assignments to the field are OK
+//   - x.f.Before(), x.f.After(), x.f.Equal(), x.f.Compare() are OK
+//   - x.f.Sub(), x.f.IsZero() and x.f.Unix*() are OK
+//   - x.f passed to the methods above, time.Since() or time.Until() is OK
+//   - x.f compared with == or != is OK
+//   - anything else (Format, In, Year, passing it elsewhere, &x.f) is not
+//
+// Every time.Time field of the struct should be used at least once
+// and all usages should be OK.
+// Fields with struct tags are skipped as they're likely to be serialized.
+// Exported fields can be used outside of the package, so they're skipped too.
+//
+// This is a highly heuristic design hint that doesn't know about
+// the package clients, so the checker needs to be enabled with -enable flag.
+type timeFieldsChecker struct {
+	ctx *lint.Context
+
+	// candidates maps the field to its usages state.
+	candidates map[*types.Var]*timeFieldCandidate
+}
+
+type timeFieldCandidate struct {
+	used     bool
+	rejected bool
+}
+
+type timeFieldsStruct struct {
+	spec   *ast.TypeSpec
+	fields []*types.Var
+}
+
+func (c *timeFieldsChecker) CheckPkg(ctx *lint.Context, files []lint.SourceFile) error {
+	c.ctx = ctx
+	c.candidates = make(map[*types.Var]*timeFieldCandidate)
+
+	var structs []timeFieldsStruct
+	for _, f := range files {
+		for _, decl := range f.Syntax.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				if s, ok := c.collectStruct(spec.(*ast.TypeSpec)); ok {
+					structs = append(structs, s)
+				}
+			}
+		}
+	}
+	if len(structs) == 0 {
+		return nil
+	}
+
+	for _, f := range files {
+		c.walk(f.Syntax)
+	}
+
+	timeSize := ctx.Target.Sizes.Sizeof(structs[0].fields[0].Type())
+	for _, s := range structs {
+		names := make([]string, 0, len(s.fields))
+		for _, field := range s.fields {
+			candidate := c.candidates[field]
+			if candidate.rejected || !candidate.used {
+				break
+			}
+			names = append(names, field.Name())
+		}
+		if len(names) != len(s.fields) {
+			continue
+		}
+		ctx.Report(lint.ReportParams{
+			PosNode: s.spec.Name,
+			Message: fmt.Sprintf("%s time.Time fields (%s) are only compared or subtracted, int64 Unix timestamps would save %d bytes per field",
+				s.spec.Name.Name, strings.Join(names, ", "), timeSize-8),
+		})
+	}
+
+	return nil
+}
+
+func (c *timeFieldsChecker) collectStruct(spec *ast.TypeSpec) (timeFieldsStruct, bool) {
+	result := timeFieldsStruct{spec: spec}
+	obj := c.ctx.Target.Types.Defs[spec.Name]
+	if obj == nil {
+		return result, false
+	}
+	st, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return result, false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if !c.isTime(field.Type()) {
+			continue
+		}
+		if field.Exported() || field.Embedded() || st.Tag(i) != "" {
+			return result, false
+		}
+		result.fields = append(result.fields, field)
+	}
+	if len(result.fields) < 2 {
+		return result, false
+	}
+	for _, field := range result.fields {
+		c.candidates[field] = &timeFieldCandidate{}
+	}
+	return result, true
+}
+
+func (c *timeFieldsChecker) walk(root ast.Node) {
+	var stack []ast.Node
+	ast.Inspect(root, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			c.visitSelector(sel, stack)
+		}
+		stack = append(stack, n)
+		return true
+	})
+}
+
+func (c *timeFieldsChecker) visitSelector(sel *ast.SelectorExpr, stack []ast.Node) {
+	selection := c.ctx.Target.Types.Selections[sel]
+	if selection == nil || selection.Kind() != types.FieldVal {
+		return
+	}
+	field, ok := selection.Obj().(*types.Var)
+	if !ok {
+		return
+	}
+	candidate := c.candidates[field]
+	if candidate == nil {
+		return
+	}
+	candidate.used = true
+	if !c.isOKUsage(sel, stack) {
+		candidate.rejected = true
+	}
+}
+
+// isOKUsage reports whether the e field usage allows it to be an int64.
+// stack contains all e parents, the closest parent is the last one.
+func (c *timeFieldsChecker) isOKUsage(e ast.Expr, stack []ast.Node) bool {
+	if len(stack) == 0 {
+		return false
+	}
+	parent := stack[len(stack)-1]
+	if paren, ok := parent.(*ast.ParenExpr); ok {
+		return c.isOKUsage(paren, stack[:len(stack)-1])
+	}
+
+	switch parent := parent.(type) {
+	case *ast.AssignStmt:
+		for _, lhs := range parent.Lhs {
+			if lhs == e {
+				return true
+			}
+		}
+		return false
+
+	case *ast.BinaryExpr:
+		return parent.Op == token.EQL || parent.Op == token.NEQ
+
+	case *ast.SelectorExpr:
+		// A method call: x.f.Method().
+		if len(stack) < 2 {
+			return false
+		}
+		call, ok := stack[len(stack)-2].(*ast.CallExpr)
+		if !ok || call.Fun != parent {
+			return false
+		}
+		switch parent.Sel.Name {
+		case "Before", "After", "Equal", "Compare", "Sub", "IsZero", "Unix", "UnixNano", "UnixMilli", "UnixMicro":
+			return true
+		}
+		return false
+
+	case *ast.CallExpr:
+		// An argument: t.Before(x.f) or time.Since(x.f).
+		if parent.Fun == e {
+			return false
+		}
+		sym := resolve.Call(c.ctx.Target.Types, parent)
+		if sym.PkgPath == "time" {
+			return sym.FuncName == "Since" || sym.FuncName == "Until"
+		}
+		method, ok := parent.Fun.(*ast.SelectorExpr)
+		if !ok || !c.isTime(c.ctx.TypeOf(method.X)) {
+			return false
+		}
+		switch method.Sel.Name {
+		case "Before", "After", "Equal", "Compare", "Sub":
+			return true
+		}
+		return false
+
+	default:
+		return false
+	}
+}
+
+func (c *timeFieldsChecker) isTime(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time"
+}
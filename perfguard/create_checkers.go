@@ -6,6 +6,7 @@ import (
 
 	_ "github.com/quasilyte/go-perfguard/perfguard/checkers/callcheckers" // for init()
 	_ "github.com/quasilyte/go-perfguard/perfguard/checkers/funccheckers" // for init()
+	_ "github.com/quasilyte/go-perfguard/perfguard/checkers/pkgcheckers"  // for init()
 )
 
 type targetChecker struct {
@@ -18,13 +19,28 @@ func (c *targetChecker) CheckTarget(target *lint.Target) error {
 	return c.impl.CheckPackage(&c.ctx, target.Files)
 }
 
-func createCheckers(config *Config) []*targetChecker {
-	packageCheckers := checkers.Create(func(doc checkers.Doc) bool {
+// checkersFilter returns a predicate that selects the checkers to run.
+// If onlyEnabled is set, only the checkers from config.EnableRules are selected.
+func checkersFilter(config *Config, onlyEnabled bool) func(doc checkers.Doc) bool {
+	return func(doc checkers.Doc) bool {
 		if doc.NeedsProfile && config.Heatmap == nil {
 			return false
 		}
+		if doc.LintOnly && !config.LoadLintRules {
+			return false
+		}
+		if !config.ruleEnabled(doc.Name, doc.OptIn, onlyEnabled) {
+			return false
+		}
+		if !config.impactEnabled(doc.Impact) {
+			return false
+		}
 		return true
-	})
+	}
+}
+
+func createCheckers(config *Config, onlyEnabled bool) []*targetChecker {
+	packageCheckers := checkers.Create(checkersFilter(config, onlyEnabled))
 
 	targetCheckers := make([]*targetChecker, len(packageCheckers))
 	for i := range packageCheckers {
@@ -32,6 +48,7 @@ func createCheckers(config *Config) []*targetChecker {
 			impl: packageCheckers[i],
 		}
 		c.ctx.Heatmap = config.Heatmap
+		c.ctx.LintRules = config.LoadLintRules
 		c.ctx.Warn = config.Warn
 		targetCheckers[i] = c
 	}
@@ -15,6 +15,8 @@ type Context struct {
 
 	tag          string
 	minHeatLevel int
+	impact       string
+	docTags      []string
 }
 
 type NodeReplacement struct {
@@ -22,10 +24,12 @@ type NodeReplacement struct {
 	Syntax ast.Node
 }
 
-func NewContext(tag string, minHeatLevel int) Context {
+func NewContext(tag string, minHeatLevel int, impact string, docTags []string) Context {
 	return Context{
 		tag:          tag,
 		minHeatLevel: minHeatLevel,
+		impact:       impact,
+		docTags:      docTags,
 	}
 }
 
@@ -45,12 +49,18 @@ func (ctx *Context) MultiChangeSuggest(params MultiChangeSuggestParams) {
 	if len(hotNodes) == 0 {
 		hotNodes = params.OldNodes
 	}
-	samplesValue, matched := ctx.listMatchesHeatmap(hotNodes, params.UseFlatSamples)
+	samplesValue, heatLevel, matched := ctx.listMatchesHeatmap(hotNodes, params.UseFlatSamples)
 	if !matched {
 		return
 	}
 
 	reportPos := ctx.Target.Fset.Position(params.ReportPos)
+	endPos := reportPos
+	for _, oldNode := range params.OldNodes {
+		if pos := ctx.Target.Fset.Position(oldNode.End()); pos.Offset > endPos.Offset {
+			endPos = pos
+		}
+	}
 	message := params.ReportMessage
 
 	textEdits := make([]TextEdit, 0, len(params.OldNodes))
@@ -70,10 +80,16 @@ func (ctx *Context) MultiChangeSuggest(params MultiChangeSuggestParams) {
 	ctx.Warn(Warning{
 		Filename:    reportPos.Filename,
 		Line:        reportPos.Line,
+		Column:      reportPos.Column,
+		EndLine:     endPos.Line,
+		EndColumn:   endPos.Column,
 		Tag:         ctx.tag,
+		DocTags:     ctx.docTags,
+		Impact:      ctx.impact,
 		Text:        message,
 		Fixes:       textEdits,
 		SamplesTime: time.Duration(samplesValue),
+		HeatLevel:   heatLevel,
 	})
 }
 
@@ -96,12 +112,13 @@ func (ctx *Context) SuggestNode(params SuggestParams) {
 	if len(hotNodes) == 0 {
 		hotNodes = []ast.Node{oldNode}
 	}
-	samplesValue, matched := ctx.listMatchesHeatmap(hotNodes, params.UseFlatSamples)
+	samplesValue, heatLevel, matched := ctx.listMatchesHeatmap(hotNodes, params.UseFlatSamples)
 	if !matched {
 		return
 	}
 
 	startPos := ctx.Target.Fset.Position(oldNode.Pos())
+	endPos := ctx.Target.Fset.Position(oldNode.End())
 
 	message := params.Message
 	replacement := ctx.NodeText(newNode)
@@ -121,10 +138,16 @@ func (ctx *Context) SuggestNode(params SuggestParams) {
 	ctx.Warn(Warning{
 		Filename:    startPos.Filename,
 		Line:        startPos.Line,
+		Column:      startPos.Column,
+		EndLine:     endPos.Line,
+		EndColumn:   endPos.Column,
 		Tag:         ctx.tag,
+		DocTags:     ctx.docTags,
+		Impact:      ctx.impact,
 		Text:        message,
 		Fixes:       []TextEdit{textEdit},
 		SamplesTime: time.Duration(samplesValue),
+		HeatLevel:   heatLevel,
 	})
 }
 
@@ -140,12 +163,13 @@ type ReportParams struct {
 
 func (ctx *Context) Report(params ReportParams) {
 	startPos := ctx.Target.Fset.Position(params.PosNode.Pos())
+	endPos := ctx.Target.Fset.Position(params.PosNode.End())
 
 	var hotNodes = params.HotNodes
 	if len(hotNodes) == 0 {
 		hotNodes = []ast.Node{params.PosNode}
 	}
-	samplesValue, matched := ctx.listMatchesHeatmap(hotNodes, params.UseFlatSamples)
+	samplesValue, heatLevel, matched := ctx.listMatchesHeatmap(hotNodes, params.UseFlatSamples)
 	if !matched {
 		return
 	}
@@ -155,32 +179,40 @@ func (ctx *Context) Report(params ReportParams) {
 	ctx.Warn(Warning{
 		Filename:    startPos.Filename,
 		Line:        startPos.Line,
+		Column:      startPos.Column,
+		EndLine:     endPos.Line,
+		EndColumn:   endPos.Column,
 		Tag:         ctx.tag,
+		DocTags:     ctx.docTags,
+		Impact:      ctx.impact,
 		Text:        message,
 		SamplesTime: time.Duration(samplesValue),
+		HeatLevel:   heatLevel,
 	})
 }
 
-func (ctx *Context) listMatchesHeatmap(nodes []ast.Node, flat bool) (int64, bool) {
+// listMatchesHeatmap returns the nodes samples value and their max heat level.
+func (ctx *Context) listMatchesHeatmap(nodes []ast.Node, flat bool) (int64, int, bool) {
 	samplesValue := int64(0)
 	flatSamplesValue := int64(0)
+	heatLevel := 0
 	matched := false
 	for _, heatNode := range nodes {
-		if ctx.matchesHeatmap(heatNode, &flatSamplesValue, &samplesValue) {
+		if ctx.matchesHeatmap(heatNode, &flatSamplesValue, &samplesValue, &heatLevel) {
 			matched = true
 		}
 	}
 	value := samplesValue
 	if flat {
 		if flatSamplesValue == 0 && ctx.Heatmap != nil {
-			return 0, false
+			return 0, 0, false
 		}
 		value = flatSamplesValue
 	}
-	return value, matched
+	return value, heatLevel, matched
 }
 
-func (ctx *Context) matchesHeatmap(n ast.Node, flat, cumulative *int64) bool {
+func (ctx *Context) matchesHeatmap(n ast.Node, flat, cumulative *int64, level *int) bool {
 	if ctx.Heatmap == nil {
 		return true
 	}
@@ -201,10 +233,14 @@ func (ctx *Context) matchesHeatmap(n ast.Node, flat, cumulative *int64) bool {
 	}
 	flatValueTotal := int64(0)
 	cumulativeValueTotal := int64(0)
+	maxLevel := 0
 	ctx.Heatmap.QueryLineRange(key, lineFrom, lineTo, func(l heatmap.LineStats) bool {
 		if l.GlobalHeatLevel >= minLevel {
 			isHot = true
 		}
+		if l.GlobalHeatLevel > maxLevel {
+			maxLevel = l.GlobalHeatLevel
+		}
 		cumulativeValueTotal += l.Value
 		flatValueTotal += l.FlatValue
 		return true
@@ -212,6 +248,9 @@ func (ctx *Context) matchesHeatmap(n ast.Node, flat, cumulative *int64) bool {
 	if isHot {
 		*cumulative += cumulativeValueTotal
 		*flat += flatValueTotal
+		if maxLevel > *level {
+			*level = maxLevel
+		}
 	}
 	return isHot
 }
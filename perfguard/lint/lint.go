@@ -10,12 +10,28 @@ import (
 type Warning struct {
 	Filename string
 	Line     int
+	Column   int
 	Tag      string
 	Text     string
 
+	// EndLine and EndColumn are the reported node end position.
+	EndLine   int
+	EndColumn int
+
+	// DocTags are the rule doc tags, like o1 and score2.
+	DocTags []string
+
 	Fixes []TextEdit
 
+	// Impact is a kind of win the fix gives: alloc, cpu or readability.
+	// It's empty if the rule doesn't specify it.
+	Impact string
+
 	SamplesTime time.Duration
+
+	// HeatLevel is a max heat level of the reported lines.
+	// It's 0 if there is no heatmap or the rule has no heat level requirement.
+	HeatLevel int
 }
 
 type TextEdit struct {
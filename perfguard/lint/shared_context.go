@@ -17,6 +17,9 @@ type SharedContext struct {
 
 	Heatmap *heatmap.Index
 
+	// LintRules reports whether the LintOnly checkers are enabled.
+	LintRules bool
+
 	Filename string // Filename is a name of file that is being analyzed
 	TypeName string // TypeName is a receiver name of the current func
 	FuncName string // FuncName is a current func/method name
@@ -28,13 +28,63 @@ type Config struct {
 	LoadLintRules      bool
 	LoadUniversalRules bool
 
+	// EnableRules is a set of rule and checker names to run.
+	// The opt-in checkers are only executed if they're listed here.
+	// If the set has any other names, only the listed rules and checkers run;
+	// a set of opt-in checkers alone doesn't disable the default rules.
+	EnableRules map[string]struct{}
+
+	// DisableRules is a set of rule and checker names that never run.
+	DisableRules map[string]struct{}
+
+	// Impacts is a set of impact kinds, like "alloc" and "cpu",
+	// the rules and checkers with other impacts are not loaded.
+	// A nil set enables all rules, including the ones without an impact.
+	Impacts map[string]struct{}
+
 	Warn func(lint.Warning)
+
+	// DebugRule is a rule group name to print the match context for.
+	// Both accepted and rejected matches are printed via DebugPrint.
+	DebugRule  string
+	DebugPrint func(string)
+}
+
+func (config *Config) impactEnabled(impact string) bool {
+	if config.Impacts == nil {
+		return true
+	}
+	_, ok := config.Impacts[impact]
+	return ok
+}
+
+func (config *Config) ruleEnabled(name string, optIn, onlyEnabled bool) bool {
+	if _, ok := config.DisableRules[name]; ok {
+		return false
+	}
+	if optIn || onlyEnabled {
+		_, ok := config.EnableRules[name]
+		return ok
+	}
+	return true
+}
+
+// RegisteredRules returns the sorted names of all rules and checkers,
+// including the ones that are not enabled by default.
+func RegisteredRules() []string {
+	return registeredRules()
 }
 
 func (a *Analyzer) Init(config *Config) error {
 	return a.impl.Init(config)
 }
 
+// RuleNames returns the sorted names of the rules and checkers
+// that are enabled by the Init config.
+func (a *Analyzer) RuleNames() []string {
+	return a.impl.ruleNames
+}
+
 func (a *Analyzer) CheckPackage(target *lint.Target) error {
 	return a.impl.CheckPackage(target)
 }
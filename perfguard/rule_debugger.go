@@ -0,0 +1,147 @@
+package perfguard
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/quasilyte/go-ruleguard/ruleguard"
+	"github.com/quasilyte/go-ruleguard/ruleguard/ir"
+	"github.com/quasilyte/gogrep"
+
+	"github.com/quasilyte/go-perfguard/perfguard/lint"
+)
+
+// ruleDebugger prints the match context for a single rule group.
+//
+// ruleguard reports only the rejected matches in the debug mode,
+// we want to see the accepted ones as well.
+// Since the report data has no captures, we re-run the matching
+// pattern on the reported node to recover the $var bindings.
+type ruleDebugger struct {
+	ruleName string
+
+	print func(string)
+
+	// Several patterns can be defined on the same line.
+	patterns map[ruleDebuggerKey][]*gogrep.Pattern
+
+	state gogrep.MatcherState
+}
+
+type ruleDebuggerKey struct {
+	filename string
+	line     int
+}
+
+func newRuleDebugger(ruleName string, print func(string)) *ruleDebugger {
+	return &ruleDebugger{
+		ruleName: ruleName,
+		print:    print,
+		patterns: make(map[ruleDebuggerKey][]*gogrep.Pattern),
+		state:    gogrep.NewMatcherState(),
+	}
+}
+
+func (d *ruleDebugger) AddFile(filename string, f *ir.File) error {
+	fset := token.NewFileSet()
+	for i := range f.RuleGroups {
+		g := &f.RuleGroups[i]
+		if g.Name != d.ruleName {
+			continue
+		}
+		var imports map[string]string
+		if len(g.Imports) != 0 {
+			imports = make(map[string]string)
+			for _, imported := range g.Imports {
+				imports[imported.Name] = imported.Path
+			}
+		}
+		for _, rule := range g.Rules {
+			for _, pat := range rule.SyntaxPatterns {
+				compiled, _, err := gogrep.Compile(gogrep.CompileConfig{
+					Fset:      fset,
+					Src:       pat.Value,
+					WithTypes: true,
+					Imports:   imports,
+				})
+				if err != nil {
+					return fmt.Errorf("%s: compile %s pattern: %w", filename, g.Name, err)
+				}
+				key := ruleDebuggerKey{filename: filename, line: pat.Line}
+				d.patterns[key] = append(d.patterns[key], compiled)
+			}
+		}
+	}
+	return nil
+}
+
+func (d *ruleDebugger) PrintMatch(target *lint.Target, f *ast.File, data *ruleguard.ReportData) {
+	info := &data.RuleInfo
+	pos := target.Fset.Position(data.Node.Pos())
+	d.print(fmt.Sprintf("%s:%d: [%s:%d] matched: %s",
+		pos.Filename, pos.Line, filepath.Base(info.Group.Filename), info.Line,
+		d.sprintNode(target.Fset, data.Node)))
+
+	patterns := d.patterns[ruleDebuggerKey{filename: filepath.Base(info.Group.Filename), line: info.Line}]
+
+	// If rule has At() location, the reported node is not the matched
+	// node itself, but one of its captures.
+	var capture []gogrep.CapturedNode
+	d.state.Types = target.Types
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n == nil || capture != nil {
+			return false
+		}
+		if n.Pos() > data.Node.Pos() || n.End() < data.Node.End() {
+			return false
+		}
+		for _, pat := range patterns {
+			pat.MatchNode(&d.state, n, func(m gogrep.MatchData) {
+				if capture != nil {
+					return
+				}
+				if m.Node == data.Node || capturedNode(m.Capture, data.Node) {
+					capture = make([]gogrep.CapturedNode, len(m.Capture))
+					copy(capture, m.Capture)
+				}
+			})
+		}
+		return true
+	})
+
+	sort.Slice(capture, func(i, j int) bool {
+		return capture[i].Name < capture[j].Name
+	})
+	for _, c := range capture {
+		typeString := "<unknown>"
+		if expr, ok := c.Node.(ast.Expr); ok {
+			if typ := target.Types.TypeOf(expr); typ != nil {
+				typeString = typ.String()
+			}
+		}
+		d.print(fmt.Sprintf("  $%s %s: %s", c.Name, typeString, d.sprintNode(target.Fset, c.Node)))
+	}
+}
+
+func (d *ruleDebugger) sprintNode(fset *token.FileSet, n ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, n); err != nil {
+		return "<?>"
+	}
+	return strings.ReplaceAll(buf.String(), "\n", `\n`)
+}
+
+func capturedNode(capture []gogrep.CapturedNode, n ast.Node) bool {
+	for _, c := range capture {
+		if c.Node == n {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,2168 @@
+// Code generated by "precompile.go". DO NOT EDIT.
+
+package rulesdata
+
+import "github.com/quasilyte/go-ruleguard/ruleguard/ir"
+
+var Lint = &ir.File{
+	PkgPath:       "gorules",
+	CustomDecls:   []string{},
+	BundleImports: []ir.BundleImport{},
+	RuleGroups: []ir.RuleGroup{
+		{
+			Line:        18,
+			Name:        "lenSignCheck",
+			MatcherName: "m",
+			DocTags:     []string{"score1"},
+			DocSummary:  "Detects len comparisons that can be written as a sign check",
+			DocBefore:   "len(s) >= 1",
+			DocAfter:    "len(s) > 0",
+			Rules: []ir.Rule{
+				{
+					Line: 19,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 19, Value: "len($x) >= 1"},
+						{Line: 19, Value: "1 <= len($x)"},
+					},
+					ReportTemplate:  "$$ => len($x) > 0",
+					SuggestTemplate: "len($x) > 0",
+				},
+				{
+					Line: 20,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 20, Value: "len($x) < 1"},
+						{Line: 20, Value: "1 > len($x)"},
+					},
+					ReportTemplate:  "$$ => len($x) == 0",
+					SuggestTemplate: "len($x) == 0",
+				},
+				{
+					Line: 23,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 23, Value: "len($x) > -1"},
+						{Line: 23, Value: "len($x) >= 0"},
+						{Line: 23, Value: "-1 < len($x)"},
+						{Line: 23, Value: "0 <= len($x)"},
+					},
+					ReportTemplate: "len($x) is never negative, the condition is always true",
+				},
+				{
+					Line: 25,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 25, Value: "len($x) < 0"},
+						{Line: 25, Value: "0 > len($x)"},
+					},
+					ReportTemplate: "len($x) is never negative, the condition is always false",
+				},
+			},
+		},
+		{
+			Line:        35,
+			Name:        "sortFuncCmpCompare",
+			MatcherName: "m",
+			DocTags:     []string{"score2"},
+			DocSummary:  "Detects slices.SortFunc comparators that can use cmp.Compare",
+			DocBefore:   "slices.SortFunc(xs, func(a, b T) int { if a.X < b.X { return -1 } else if a.X > b.X { return 1 }; return 0 })",
+			DocAfter:    "slices.SortFunc(xs, func(a, b T) int { return cmp.Compare(a.X, b.X) })",
+			DocNote:     "float types are not reported as cmp.Compare orders NaN values differently",
+			Rules: []ir.Rule{
+				{
+					Line: 40,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 41, Value: "slices.SortFunc($s, func($*params) int { if $x < $y { return -1 } else if $x > $y { return 1 }; return 0 })"},
+						{Line: 42, Value: "slices.SortFunc($s, func($*params) int { if $x < $y { return -1 } else if $x > $y { return 1 } else { return 0 } })"},
+						{Line: 43, Value: "slices.SortFunc($s, func($*params) int { if $x < $y { return -1 }; if $x > $y { return 1 }; return 0 })"},
+					},
+					ReportTemplate:  "use cmp.Compare($x, $y) in the comparator",
+					SuggestTemplate: "slices.SortFunc($s, func$params int { return cmp.Compare($x, $y) })",
+					WhereExpr: ir.FilterExpr{
+						Line: 45,
+						Op:   ir.FilterAndOp,
+						Src:  "m.GoVersion().GreaterEqThan(\"1.21\") &&\n\tisOrdered(m[\"x\"]) && m[\"x\"].Type.IdenticalTo(m[\"y\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 45,
+								Op:   ir.FilterAndOp,
+								Src:  "m.GoVersion().GreaterEqThan(\"1.21\") &&\n\tisOrdered(m[\"x\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line:  45,
+										Op:    ir.FilterGoVersionGreaterEqThanOp,
+										Src:   "m.GoVersion().GreaterEqThan(\"1.21\")",
+										Value: "1.21",
+									},
+									{
+										Line: 46,
+										Op:   ir.FilterOrOp,
+										Src:  "isOrdered(m[\"x\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  46,
+												Op:    ir.FilterVarTypeOfKindOp,
+												Src:   "m[\"x\"].Type.OfKind(\"integer\")",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 37, Op: ir.FilterStringOp, Src: "\"integer\"", Value: "integer"}},
+											},
+											{
+												Line:  46,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 37, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+										},
+									},
+								},
+							},
+							{
+								Line:  46,
+								Op:    ir.FilterVarTypeIdenticalToOp,
+								Src:   "m[\"x\"].Type.IdenticalTo(m[\"y\"])",
+								Value: "x",
+								Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "y"}},
+							},
+						},
+					},
+				},
+				{
+					Line: 50,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 51, Value: "slices.SortFunc($s, func($*params) int { if $x > $y { return -1 } else if $x < $y { return 1 }; return 0 })"},
+						{Line: 52, Value: "slices.SortFunc($s, func($*params) int { if $x > $y { return -1 } else if $x < $y { return 1 } else { return 0 } })"},
+						{Line: 53, Value: "slices.SortFunc($s, func($*params) int { if $x > $y { return -1 }; if $x < $y { return 1 }; return 0 })"},
+					},
+					ReportTemplate:  "use cmp.Compare($y, $x) in the comparator",
+					SuggestTemplate: "slices.SortFunc($s, func$params int { return cmp.Compare($y, $x) })",
+					WhereExpr: ir.FilterExpr{
+						Line: 55,
+						Op:   ir.FilterAndOp,
+						Src:  "m.GoVersion().GreaterEqThan(\"1.21\") &&\n\tisOrdered(m[\"x\"]) && m[\"x\"].Type.IdenticalTo(m[\"y\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 55,
+								Op:   ir.FilterAndOp,
+								Src:  "m.GoVersion().GreaterEqThan(\"1.21\") &&\n\tisOrdered(m[\"x\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line:  55,
+										Op:    ir.FilterGoVersionGreaterEqThanOp,
+										Src:   "m.GoVersion().GreaterEqThan(\"1.21\")",
+										Value: "1.21",
+									},
+									{
+										Line: 56,
+										Op:   ir.FilterOrOp,
+										Src:  "isOrdered(m[\"x\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  56,
+												Op:    ir.FilterVarTypeOfKindOp,
+												Src:   "m[\"x\"].Type.OfKind(\"integer\")",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 37, Op: ir.FilterStringOp, Src: "\"integer\"", Value: "integer"}},
+											},
+											{
+												Line:  56,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 37, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+										},
+									},
+								},
+							},
+							{
+								Line:  56,
+								Op:    ir.FilterVarTypeIdenticalToOp,
+								Src:   "m[\"x\"].Type.IdenticalTo(m[\"y\"])",
+								Value: "x",
+								Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "y"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        66,
+			Name:        "subsliceAppendAlias",
+			MatcherName: "m",
+			DocTags:     []string{"score3"},
+			DocSummary:  "Detects appends of a slice to its own subslice",
+			DocBefore:   "dst := src[:k]; dst = append(dst, src...)",
+			DocAfter:    "dst := make([]T, k, k+len(src)); copy(dst, src[:k]); dst = append(dst, src...)",
+			DocNote:     "there is no autofix: the proper solution depends on the intended semantics",
+			Rules: []ir.Rule{
+				{
+					Line: 70,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 70, Value: "append($src[:$_], $src...)"},
+						{Line: 70, Value: "append($src[$_:$_], $src...)"},
+					},
+					ReportTemplate: "append to a subslice of $src overwrites the $src elements, copy the subslice before appending",
+				},
+				{
+					Line: 73,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 74, Value: "$dst := $src[:$_]; $*_; $dst = append($dst, $src...)"},
+						{Line: 75, Value: "$dst := $src[$_:$_]; $*_; $dst = append($dst, $src...)"},
+						{Line: 76, Value: "$dst := $src[:$_]; $*_; $_ := append($dst, $src...)"},
+						{Line: 77, Value: "$dst := $src[$_:$_]; $*_; $_ := append($dst, $src...)"},
+					},
+					ReportTemplate: "$dst is a subslice of $src, a later append($dst, $src...) overwrites the $src elements",
+				},
+			},
+		},
+		{
+			Line:        87,
+			Name:        "unixNanoElapsed",
+			MatcherName: "m",
+			DocTags:     []string{"score2"},
+			DocSummary:  "Detects elapsed time measurements that use Unix timestamps",
+			DocBefore:   "start := time.Now().UnixNano(); f(); elapsed := time.Now().UnixNano() - start",
+			DocAfter:    "start := time.Now(); f(); elapsed := time.Since(start)",
+			DocNote:     "there is no autofix: the start var type changes from int64 to time.Time",
+			Rules: []ir.Rule{{
+				Line: 94,
+				SyntaxPatterns: []ir.PatternString{
+					{Line: 95, Value: "$start := time.Now().$method(); $*_; $_ := time.Now().$method() - $start"},
+					{Line: 96, Value: "$start := time.Now().$method(); $*_; $_ = time.Now().$method() - $start"},
+				},
+				ReportTemplate: "$start is used to measure the elapsed time, use time.Since($start) with $start := time.Now() instead",
+				WhereExpr: ir.FilterExpr{
+					Line:  98,
+					Op:    ir.FilterVarTextMatchesOp,
+					Src:   "m[\"method\"].Text.Matches(`^Unix(Nano|Micro|Milli)?$`)",
+					Value: "method",
+					Args:  []ir.FilterExpr{{Line: 98, Op: ir.FilterStringOp, Src: "`^Unix(Nano|Micro|Milli)?$`", Value: "^Unix(Nano|Micro|Milli)?$"}},
+				},
+			}},
+		},
+		{
+			Line:        108,
+			Name:        "rangeValueUnused",
+			MatcherName: "m",
+			DocTags:     []string{"score1"},
+			DocSummary:  "Detects range loops with a redundant blank value variable",
+			DocBefore:   "for i, _ := range xs {}",
+			DocAfter:    "for i := range xs {}",
+			DocNote:     "named value vars that are never used are already rejected by the compiler",
+			Rules: []ir.Rule{
+				{
+					Line:            112,
+					SyntaxPatterns:  []ir.PatternString{{Line: 112, Value: "for $i, _ := range $xs { $*body }"}},
+					ReportTemplate:  "for $i, _ := range $xs => for $i := range $xs, the value is unused (a value var would copy every element)",
+					SuggestTemplate: "for $i := range $xs { $body }",
+					WhereExpr: ir.FilterExpr{
+						Line: 113,
+						Op:   ir.FilterNeqOp,
+						Src:  "m[\"i\"].Text != `_`",
+						Args: []ir.FilterExpr{
+							{Line: 113, Op: ir.FilterVarTextOp, Src: "m[\"i\"].Text", Value: "i"},
+							{Line: 113, Op: ir.FilterStringOp, Src: "`_`", Value: "_"},
+						},
+					},
+				},
+				{
+					Line:            116,
+					SyntaxPatterns:  []ir.PatternString{{Line: 116, Value: "for $i, _ = range $xs { $*body }"}},
+					ReportTemplate:  "for $i, _ = range $xs => for $i = range $xs, the value is unused (a value var would copy every element)",
+					SuggestTemplate: "for $i = range $xs { $body }",
+					WhereExpr: ir.FilterExpr{
+						Line: 117,
+						Op:   ir.FilterNeqOp,
+						Src:  "m[\"i\"].Text != `_`",
+						Args: []ir.FilterExpr{
+							{Line: 117, Op: ir.FilterVarTextOp, Src: "m[\"i\"].Text", Value: "i"},
+							{Line: 117, Op: ir.FilterStringOp, Src: "`_`", Value: "_"},
+						},
+					},
+				},
+				{
+					Line: 120,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 120, Value: "for _, _ = range $xs { $*body }"},
+						{Line: 120, Value: "for _ = range $xs { $*body }"},
+					},
+					ReportTemplate:  "use for range $xs, the range vars are unused",
+					SuggestTemplate: "for range $xs { $body }",
+				},
+			},
+		},
+		{
+			Line:        130,
+			Name:        "deferEagerArgs",
+			MatcherName: "m",
+			DocTags:     []string{"score1"},
+			DocSummary:  "Detects deferred calls with arguments that call other functions",
+			DocBefore:   "defer log.Printf(\"%v\", expensiveToString())",
+			DocAfter:    "defer func() { log.Printf(\"%v\", expensiveToString()) }()",
+			DocNote:     "there is no autofix: wrapping the call into a closure changes the semantics",
+			Rules: []ir.Rule{{
+				Line: 144,
+				SyntaxPatterns: []ir.PatternString{
+					{Line: 145, Value: "defer $f($arg, $*_)"},
+					{Line: 146, Value: "defer $f($_, $arg, $*_)"},
+					{Line: 147, Value: "defer $f($_, $_, $arg, $*_)"},
+					{Line: 148, Value: "defer $f($_, $_, $_, $arg, $*_)"},
+				},
+				ReportTemplate: "$arg is evaluated at the defer statement, not when $f is called",
+				WhereExpr: ir.FilterExpr{
+					Line: 150,
+					Op:   ir.FilterAndOp,
+					Src:  "m[\"arg\"].Contains(`$_($*_)`) &&\n\t!m[\"arg\"].Node.Is(`FuncLit`) &&\n\t!m[\"arg\"].Text.Matches(`^time\\.Now\\(\\)$`) &&\n\t!m[\"arg\"].Text.Matches(`^(len|cap)\\([^()]*\\)$`)",
+					Args: []ir.FilterExpr{
+						{
+							Line: 150,
+							Op:   ir.FilterAndOp,
+							Src:  "m[\"arg\"].Contains(`$_($*_)`) &&\n\t!m[\"arg\"].Node.Is(`FuncLit`) &&\n\t!m[\"arg\"].Text.Matches(`^time\\.Now\\(\\)$`)",
+							Args: []ir.FilterExpr{
+								{
+									Line: 150,
+									Op:   ir.FilterAndOp,
+									Src:  "m[\"arg\"].Contains(`$_($*_)`) &&\n\t!m[\"arg\"].Node.Is(`FuncLit`)",
+									Args: []ir.FilterExpr{
+										{
+											Line:  150,
+											Op:    ir.FilterVarContainsOp,
+											Src:   "m[\"arg\"].Contains(`$_($*_)`)",
+											Value: "arg",
+											Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "$_($*_)"}},
+										},
+										{
+											Line: 151,
+											Op:   ir.FilterNotOp,
+											Src:  "!m[\"arg\"].Node.Is(`FuncLit`)",
+											Args: []ir.FilterExpr{{
+												Line:  151,
+												Op:    ir.FilterVarNodeIsOp,
+												Src:   "m[\"arg\"].Node.Is(`FuncLit`)",
+												Value: "arg",
+												Args:  []ir.FilterExpr{{Line: 151, Op: ir.FilterStringOp, Src: "`FuncLit`", Value: "FuncLit"}},
+											}},
+										},
+									},
+								},
+								{
+									Line: 152,
+									Op:   ir.FilterNotOp,
+									Src:  "!m[\"arg\"].Text.Matches(`^time\\.Now\\(\\)$`)",
+									Args: []ir.FilterExpr{{
+										Line:  152,
+										Op:    ir.FilterVarTextMatchesOp,
+										Src:   "m[\"arg\"].Text.Matches(`^time\\.Now\\(\\)$`)",
+										Value: "arg",
+										Args:  []ir.FilterExpr{{Line: 152, Op: ir.FilterStringOp, Src: "`^time\\.Now\\(\\)$`", Value: "^time\\.Now\\(\\)$"}},
+									}},
+								},
+							},
+						},
+						{
+							Line: 153,
+							Op:   ir.FilterNotOp,
+							Src:  "!m[\"arg\"].Text.Matches(`^(len|cap)\\([^()]*\\)$`)",
+							Args: []ir.FilterExpr{{
+								Line:  153,
+								Op:    ir.FilterVarTextMatchesOp,
+								Src:   "m[\"arg\"].Text.Matches(`^(len|cap)\\([^()]*\\)$`)",
+								Value: "arg",
+								Args:  []ir.FilterExpr{{Line: 153, Op: ir.FilterStringOp, Src: "`^(len|cap)\\([^()]*\\)$`", Value: "^(len|cap)\\([^()]*\\)$"}},
+							}},
+						},
+					},
+				},
+				LocationVar: "arg",
+			}},
+		},
+		{
+			Line:        164,
+			Name:        "redundantConstConv",
+			MatcherName: "m",
+			DocTags:     []string{"score1"},
+			DocSummary:  "Detects comparisons with a constant that is converted to the other operand type",
+			DocBefore:   "d == time.Duration(0)",
+			DocAfter:    "d == 0",
+			DocNote:     "only literal constants are matched as typed constants don't convert implicitly",
+			Rules: []ir.Rule{
+				{
+					Line:            175,
+					SyntaxPatterns:  []ir.PatternString{{Line: 175, Value: "$x == $t($c)"}},
+					ReportTemplate:  "$$ => $x == $c",
+					SuggestTemplate: "$x == $c",
+					WhereExpr: ir.FilterExpr{
+						Line: 175,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 175,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 175,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  175,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  175,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  175,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  175,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  175,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  175,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  175,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            176,
+					SyntaxPatterns:  []ir.PatternString{{Line: 176, Value: "$t($c) == $x"}},
+					ReportTemplate:  "$$ => $c == $x",
+					SuggestTemplate: "$c == $x",
+					WhereExpr: ir.FilterExpr{
+						Line: 176,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 176,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 176,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  176,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  176,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  176,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  176,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  176,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  176,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  176,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            177,
+					SyntaxPatterns:  []ir.PatternString{{Line: 177, Value: "$x != $t($c)"}},
+					ReportTemplate:  "$$ => $x != $c",
+					SuggestTemplate: "$x != $c",
+					WhereExpr: ir.FilterExpr{
+						Line: 177,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 177,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 177,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  177,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  177,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  177,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  177,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  177,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  177,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  177,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            178,
+					SyntaxPatterns:  []ir.PatternString{{Line: 178, Value: "$t($c) != $x"}},
+					ReportTemplate:  "$$ => $c != $x",
+					SuggestTemplate: "$c != $x",
+					WhereExpr: ir.FilterExpr{
+						Line: 178,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 178,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 178,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  178,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  178,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  178,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  178,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  178,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  178,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  178,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            179,
+					SyntaxPatterns:  []ir.PatternString{{Line: 179, Value: "$x < $t($c)"}},
+					ReportTemplate:  "$$ => $x < $c",
+					SuggestTemplate: "$x < $c",
+					WhereExpr: ir.FilterExpr{
+						Line: 179,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 179,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 179,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  179,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  179,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  179,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  179,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  179,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  179,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  179,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            180,
+					SyntaxPatterns:  []ir.PatternString{{Line: 180, Value: "$t($c) < $x"}},
+					ReportTemplate:  "$$ => $c < $x",
+					SuggestTemplate: "$c < $x",
+					WhereExpr: ir.FilterExpr{
+						Line: 180,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 180,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 180,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  180,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  180,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  180,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  180,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  180,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  180,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  180,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            181,
+					SyntaxPatterns:  []ir.PatternString{{Line: 181, Value: "$x <= $t($c)"}},
+					ReportTemplate:  "$$ => $x <= $c",
+					SuggestTemplate: "$x <= $c",
+					WhereExpr: ir.FilterExpr{
+						Line: 181,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 181,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 181,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  181,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  181,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  181,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  181,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  181,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  181,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  181,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            182,
+					SyntaxPatterns:  []ir.PatternString{{Line: 182, Value: "$t($c) <= $x"}},
+					ReportTemplate:  "$$ => $c <= $x",
+					SuggestTemplate: "$c <= $x",
+					WhereExpr: ir.FilterExpr{
+						Line: 182,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 182,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 182,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  182,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  182,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  182,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  182,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  182,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  182,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  182,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            183,
+					SyntaxPatterns:  []ir.PatternString{{Line: 183, Value: "$x > $t($c)"}},
+					ReportTemplate:  "$$ => $x > $c",
+					SuggestTemplate: "$x > $c",
+					WhereExpr: ir.FilterExpr{
+						Line: 183,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 183,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 183,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  183,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  183,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  183,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  183,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  183,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  183,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  183,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            184,
+					SyntaxPatterns:  []ir.PatternString{{Line: 184, Value: "$t($c) > $x"}},
+					ReportTemplate:  "$$ => $c > $x",
+					SuggestTemplate: "$c > $x",
+					WhereExpr: ir.FilterExpr{
+						Line: 184,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 184,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 184,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  184,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  184,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  184,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  184,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  184,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  184,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  184,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            185,
+					SyntaxPatterns:  []ir.PatternString{{Line: 185, Value: "$x >= $t($c)"}},
+					ReportTemplate:  "$$ => $x >= $c",
+					SuggestTemplate: "$x >= $c",
+					WhereExpr: ir.FilterExpr{
+						Line: 185,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 185,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 185,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  185,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  185,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  185,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  185,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  185,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  185,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  185,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:            186,
+					SyntaxPatterns:  []ir.PatternString{{Line: 186, Value: "$t($c) >= $x"}},
+					ReportTemplate:  "$$ => $c >= $x",
+					SuggestTemplate: "$c >= $x",
+					WhereExpr: ir.FilterExpr{
+						Line: 186,
+						Op:   ir.FilterAndOp,
+						Src:  "isImplicitConv(m[\"x\"], m[\"t\"], m[\"c\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 186,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"]) &&\n\n\tm[\"c\"].Const",
+								Args: []ir.FilterExpr{
+									{
+										Line: 186,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"t\"].Object.Is(`TypeName`) &&\n\n\tm[\"x\"].Type.IdenticalTo(\n\n\t\tm[\"t\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  186,
+												Op:    ir.FilterVarObjectIsOp,
+												Src:   "m[\"t\"].Object.Is(`TypeName`)",
+												Value: "t",
+												Args:  []ir.FilterExpr{{Line: 170, Op: ir.FilterStringOp, Src: "`TypeName`", Value: "TypeName"}},
+											},
+											{
+												Line:  186,
+												Op:    ir.FilterVarTypeIdenticalToOp,
+												Src:   "m[\"x\"].Type.IdenticalTo(\n\n\tm[\"t\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "t"}},
+											},
+										},
+									},
+									{
+										Line:  186,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"c\"].Const",
+										Value: "c",
+									},
+								},
+							},
+							{
+								Line: 171,
+								Op:   ir.FilterOrOp,
+								Src:  "((m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`)) ||\n\t(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\t\tm[\"c\"].Text.Matches(\"^[\\\"`]\")))",
+								Args: []ir.FilterExpr{
+									{
+										Line: 171,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().OfKind(`numeric`) &&\n\n\tm[\"c\"].Text.Matches(`^-?[0-9.]`))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  186,
+												Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+												Src:   "m[\"x\"].Type.Underlying().OfKind(`numeric`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+											},
+											{
+												Line:  186,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(`^-?[0-9.]`)",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 171, Op: ir.FilterStringOp, Src: "`^-?[0-9.]`", Value: "^-?[0-9.]"}},
+											},
+										},
+									},
+									{
+										Line: 172,
+										Op:   ir.FilterAndOp,
+										Src:  "(m[\"x\"].Type.Underlying().Is(`string`) &&\n\n\tm[\"c\"].Text.Matches(\"^[\\\"`]\"))",
+										Args: []ir.FilterExpr{
+											{
+												Line:  186,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"x\"].Type.Underlying().Is(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line:  186,
+												Op:    ir.FilterVarTextMatchesOp,
+												Src:   "m[\"c\"].Text.Matches(\"^[\\\"`]\")",
+												Value: "c",
+												Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "\"^[\\\"`]\"", Value: "^[\"`]"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        195,
+			Name:        "durationLiteral",
+			MatcherName: "m",
+			DocTags:     []string{"score1"},
+			DocSummary:  "Detects constant durations that are converted to time.Duration before a multiplication",
+			DocBefore:   "time.Duration(5) * time.Second",
+			DocAfter:    "5 * time.Second",
+			DocNote:     "only literal constants are matched as typed constants don't convert implicitly",
+			Rules: []ir.Rule{
+				{
+					Line:            203,
+					SyntaxPatterns:  []ir.PatternString{{Line: 203, Value: "time.Duration($n) * $d"}},
+					ReportTemplate:  "$$ => $n * $d",
+					SuggestTemplate: "$n * $d",
+					WhereExpr: ir.FilterExpr{
+						Line: 204,
+						Op:   ir.FilterAndOp,
+						Src:  "isDurationLiteral(m[\"n\"], m[\"d\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 204,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"n\"].Const &&\n\n\tm[\"n\"].Text.Matches(`^-?[0-9]`)",
+								Args: []ir.FilterExpr{
+									{
+										Line:  204,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"n\"].Const",
+										Value: "n",
+									},
+									{
+										Line:  204,
+										Op:    ir.FilterVarTextMatchesOp,
+										Src:   "m[\"n\"].Text.Matches(`^-?[0-9]`)",
+										Value: "n",
+										Args:  []ir.FilterExpr{{Line: 200, Op: ir.FilterStringOp, Src: "`^-?[0-9]`", Value: "^-?[0-9]"}},
+									},
+								},
+							},
+							{
+								Line:  204,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"d\"].Type.Is(`time.Duration`)",
+								Value: "d",
+								Args:  []ir.FilterExpr{{Line: 200, Op: ir.FilterStringOp, Src: "`time.Duration`", Value: "time.Duration"}},
+							},
+						},
+					},
+				},
+				{
+					Line:            206,
+					SyntaxPatterns:  []ir.PatternString{{Line: 206, Value: "$d * time.Duration($n)"}},
+					ReportTemplate:  "$$ => $d * $n",
+					SuggestTemplate: "$d * $n",
+					WhereExpr: ir.FilterExpr{
+						Line: 207,
+						Op:   ir.FilterAndOp,
+						Src:  "isDurationLiteral(m[\"n\"], m[\"d\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 207,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"n\"].Const &&\n\n\tm[\"n\"].Text.Matches(`^-?[0-9]`)",
+								Args: []ir.FilterExpr{
+									{
+										Line:  207,
+										Op:    ir.FilterVarConstOp,
+										Src:   "m[\"n\"].Const",
+										Value: "n",
+									},
+									{
+										Line:  207,
+										Op:    ir.FilterVarTextMatchesOp,
+										Src:   "m[\"n\"].Text.Matches(`^-?[0-9]`)",
+										Value: "n",
+										Args:  []ir.FilterExpr{{Line: 200, Op: ir.FilterStringOp, Src: "`^-?[0-9]`", Value: "^-?[0-9]"}},
+									},
+								},
+							},
+							{
+								Line:  207,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"d\"].Type.Is(`time.Duration`)",
+								Value: "d",
+								Args:  []ir.FilterExpr{{Line: 200, Op: ir.FilterStringOp, Src: "`time.Duration`", Value: "time.Duration"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        216,
+			Name:        "makeLenAppend",
+			MatcherName: "m",
+			DocTags:     []string{"score3"},
+			DocSummary:  "Detects appends to a slice that was made with a non-zero length",
+			DocBefore:   "s := make([]T, n); s = append(s, x)",
+			DocAfter:    "s := make([]T, 0, n); s = append(s, x)",
+			DocNote:     "there is no autofix: a make length may be intended if the slice is indexed later",
+			Rules: []ir.Rule{{
+				Line: 225,
+				SyntaxPatterns: []ir.PatternString{
+					{Line: 226, Value: "$s := make($_, $n); $s = append($s, $*_)"},
+					{Line: 227, Value: "$s := make($_, $n, $_); $s = append($s, $*_)"},
+					{Line: 228, Value: "$s = make($_, $n); $s = append($s, $*_)"},
+					{Line: 229, Value: "$s = make($_, $n, $_); $s = append($s, $*_)"},
+					{Line: 230, Value: "$s := make($_, $n); for $_, $_ := range $_ { $*_; $s = append($s, $*_); $*_ }"},
+					{Line: 231, Value: "$s := make($_, $n, $_); for $_, $_ := range $_ { $*_; $s = append($s, $*_); $*_ }"},
+					{Line: 232, Value: "$s := make($_, $n); for $_ := range $_ { $*_; $s = append($s, $*_); $*_ }"},
+					{Line: 233, Value: "$s := make($_, $n, $_); for $_ := range $_ { $*_; $s = append($s, $*_); $*_ }"},
+				},
+				ReportTemplate: "append adds elements after the $n zero values of $s, use make with a 0 length and a $n capacity instead",
+				WhereExpr: ir.FilterExpr{
+					Line: 235,
+					Op:   ir.FilterNotOp,
+					Src:  "!(m[\"n\"].Const && m[\"n\"].Value.Int() == 0)",
+					Args: []ir.FilterExpr{{
+						Line: 235,
+						Op:   ir.FilterAndOp,
+						Src:  "(m[\"n\"].Const && m[\"n\"].Value.Int() == 0)",
+						Args: []ir.FilterExpr{
+							{
+								Line:  235,
+								Op:    ir.FilterVarConstOp,
+								Src:   "m[\"n\"].Const",
+								Value: "n",
+							},
+							{
+								Line: 235,
+								Op:   ir.FilterEqOp,
+								Src:  "m[\"n\"].Value.Int() == 0",
+								Args: []ir.FilterExpr{
+									{
+										Line:  235,
+										Op:    ir.FilterVarValueIntOp,
+										Src:   "m[\"n\"].Value.Int()",
+										Value: "n",
+									},
+									{
+										Line:  235,
+										Op:    ir.FilterIntOp,
+										Src:   "0",
+										Value: int64(0),
+									},
+								},
+							},
+						},
+					}},
+				},
+			}},
+		},
+		{
+			Line:        244,
+			Name:        "chanLenCheck",
+			MatcherName: "m",
+			DocTags:     []string{"score3", "concurrency"},
+			DocSummary:  "Detects channel operations that are guarded by a len(ch) check",
+			DocBefore:   "for len(ch) > 0 { <-ch }",
+			DocAfter:    "for { select { case <-ch: default: return } }",
+			DocNote:     "a single receiver can rely on len(ch), it's reported anyway as the invariant is fragile",
+			Rules: []ir.Rule{
+				{
+					Line: 259,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 260, Value: "for len($ch) > 0 { $*_; <-$ch; $*_ }"},
+						{Line: 261, Value: "for len($ch) > 0 { $*_; $_ = <-$ch; $*_ }"},
+						{Line: 262, Value: "for len($ch) > 0 { $*_; $_ := <-$ch; $*_ }"},
+						{Line: 263, Value: "for len($ch) != 0 { $*_; <-$ch; $*_ }"},
+						{Line: 264, Value: "for len($ch) != 0 { $*_; $_ = <-$ch; $*_ }"},
+						{Line: 265, Value: "for len($ch) != 0 { $*_; $_ := <-$ch; $*_ }"},
+						{Line: 266, Value: "if len($ch) > 0 { $*_; <-$ch; $*_ }"},
+						{Line: 267, Value: "if len($ch) > 0 { $*_; $_ = <-$ch; $*_ }"},
+						{Line: 268, Value: "if len($ch) > 0 { $*_; $_ := <-$ch; $*_ }"},
+						{Line: 269, Value: "if len($ch) != 0 { $*_; <-$ch; $*_ }"},
+						{Line: 270, Value: "if len($ch) != 0 { $*_; $_ = <-$ch; $*_ }"},
+						{Line: 271, Value: "if len($ch) != 0 { $*_; $_ := <-$ch; $*_ }"},
+					},
+					ReportTemplate: "len($ch) can change before the receive, use a select with a default case instead",
+					WhereExpr: ir.FilterExpr{
+						Line: 273,
+						Op:   ir.FilterOrOp,
+						Src:  "isChan(m[\"ch\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 273,
+								Op:   ir.FilterOrOp,
+								Src:  "m[\"ch\"].Type.Underlying().Is(`chan $_`) ||\n\n\tm[\"ch\"].Type.Underlying().Is(`<-chan $_`)",
+								Args: []ir.FilterExpr{
+									{
+										Line:  273,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"ch\"].Type.Underlying().Is(`chan $_`)",
+										Value: "ch",
+										Args:  []ir.FilterExpr{{Line: 254, Op: ir.FilterStringOp, Src: "`chan $_`", Value: "chan $_"}},
+									},
+									{
+										Line:  273,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"ch\"].Type.Underlying().Is(`<-chan $_`)",
+										Value: "ch",
+										Args:  []ir.FilterExpr{{Line: 255, Op: ir.FilterStringOp, Src: "`<-chan $_`", Value: "<-chan $_"}},
+									},
+								},
+							},
+							{
+								Line:  273,
+								Op:    ir.FilterVarTypeUnderlyingIsOp,
+								Src:   "m[\"ch\"].Type.Underlying().Is(`chan<- $_`)",
+								Value: "ch",
+								Args:  []ir.FilterExpr{{Line: 256, Op: ir.FilterStringOp, Src: "`chan<- $_`", Value: "chan<- $_"}},
+							},
+						},
+					},
+					LocationVar: "ch",
+				},
+				{
+					Line: 277,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 278, Value: "for len($ch) < cap($ch) { $*_; $ch <- $_; $*_ }"},
+						{Line: 279, Value: "if len($ch) < cap($ch) { $*_; $ch <- $_; $*_ }"},
+					},
+					ReportTemplate: "len($ch) can change before the send, use a select with a default case instead",
+					WhereExpr: ir.FilterExpr{
+						Line: 281,
+						Op:   ir.FilterOrOp,
+						Src:  "isChan(m[\"ch\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 281,
+								Op:   ir.FilterOrOp,
+								Src:  "m[\"ch\"].Type.Underlying().Is(`chan $_`) ||\n\n\tm[\"ch\"].Type.Underlying().Is(`<-chan $_`)",
+								Args: []ir.FilterExpr{
+									{
+										Line:  281,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"ch\"].Type.Underlying().Is(`chan $_`)",
+										Value: "ch",
+										Args:  []ir.FilterExpr{{Line: 254, Op: ir.FilterStringOp, Src: "`chan $_`", Value: "chan $_"}},
+									},
+									{
+										Line:  281,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"ch\"].Type.Underlying().Is(`<-chan $_`)",
+										Value: "ch",
+										Args:  []ir.FilterExpr{{Line: 255, Op: ir.FilterStringOp, Src: "`<-chan $_`", Value: "<-chan $_"}},
+									},
+								},
+							},
+							{
+								Line:  281,
+								Op:    ir.FilterVarTypeUnderlyingIsOp,
+								Src:   "m[\"ch\"].Type.Underlying().Is(`chan<- $_`)",
+								Value: "ch",
+								Args:  []ir.FilterExpr{{Line: 256, Op: ir.FilterStringOp, Src: "`chan<- $_`", Value: "chan<- $_"}},
+							},
+						},
+					},
+					LocationVar: "ch",
+				},
+			},
+		},
+		{
+			Line:        292,
+			Name:        "singleCaseTypeSwitch",
+			MatcherName: "m",
+			DocTags:     []string{"score1"},
+			DocSummary:  "Detects type switches with a single case that can be a type assertion",
+			DocBefore:   "switch v := x.(type) { case T: use(v) }",
+			DocAfter:    "if v, ok := x.(T); ok { use(v) }",
+			DocNote:     "there is no autofix: the case body is re-indented and may need an else branch",
+			Rules: []ir.Rule{
+				{
+					Line: 306,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 307, Value: "switch $v := $x.(type) { case $t: $*body }"},
+						{Line: 308, Value: "switch $v := $x.(type) { case $t: $*body; default: }"},
+					},
+					ReportTemplate: "use if $v, ok := $x.($t); ok { … } instead of a single-case type switch",
+					WhereExpr: ir.FilterExpr{
+						Line: 310,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"t\"].Text != `nil` && !m[\"body\"].Contains(`break`)",
+						Args: []ir.FilterExpr{
+							{
+								Line: 310,
+								Op:   ir.FilterNeqOp,
+								Src:  "m[\"t\"].Text != `nil`",
+								Args: []ir.FilterExpr{
+									{Line: 310, Op: ir.FilterVarTextOp, Src: "m[\"t\"].Text", Value: "t"},
+									{Line: 310, Op: ir.FilterStringOp, Src: "`nil`", Value: "nil"},
+								},
+							},
+							{
+								Line: 310,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"body\"].Contains(`break`)",
+								Args: []ir.FilterExpr{{
+									Line:  310,
+									Op:    ir.FilterVarContainsOp,
+									Src:   "m[\"body\"].Contains(`break`)",
+									Value: "body",
+									Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "break"}},
+								}},
+							},
+						},
+					},
+				},
+				{
+					Line: 312,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 313, Value: "switch $x.(type) { case $t: $*body }"},
+						{Line: 314, Value: "switch $x.(type) { case $t: $*body; default: }"},
+					},
+					ReportTemplate: "use if _, ok := $x.($t); ok { … } instead of a single-case type switch",
+					WhereExpr: ir.FilterExpr{
+						Line: 316,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"t\"].Text != `nil` && !m[\"body\"].Contains(`break`)",
+						Args: []ir.FilterExpr{
+							{
+								Line: 316,
+								Op:   ir.FilterNeqOp,
+								Src:  "m[\"t\"].Text != `nil`",
+								Args: []ir.FilterExpr{
+									{Line: 316, Op: ir.FilterVarTextOp, Src: "m[\"t\"].Text", Value: "t"},
+									{Line: 316, Op: ir.FilterStringOp, Src: "`nil`", Value: "nil"},
+								},
+							},
+							{
+								Line: 316,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"body\"].Contains(`break`)",
+								Args: []ir.FilterExpr{{
+									Line:  316,
+									Op:    ir.FilterVarContainsOp,
+									Src:   "m[\"body\"].Contains(`break`)",
+									Value: "body",
+									Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "break"}},
+								}},
+							},
+						},
+					},
+				},
+				{
+					Line:           319,
+					SyntaxPatterns: []ir.PatternString{{Line: 319, Value: "switch $v := $x.(type) { case $t: $*body; default: $*_ }"}},
+					ReportTemplate: "use if $v, ok := $x.($t); ok { … } else { … } instead of a type switch with a single case and a default, use $x in the else branch",
+					WhereExpr: ir.FilterExpr{
+						Line: 320,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"t\"].Text != `nil` && !m[\"body\"].Contains(`break`)",
+						Args: []ir.FilterExpr{
+							{
+								Line: 320,
+								Op:   ir.FilterNeqOp,
+								Src:  "m[\"t\"].Text != `nil`",
+								Args: []ir.FilterExpr{
+									{Line: 320, Op: ir.FilterVarTextOp, Src: "m[\"t\"].Text", Value: "t"},
+									{Line: 320, Op: ir.FilterStringOp, Src: "`nil`", Value: "nil"},
+								},
+							},
+							{
+								Line: 320,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"body\"].Contains(`break`)",
+								Args: []ir.FilterExpr{{
+									Line:  320,
+									Op:    ir.FilterVarContainsOp,
+									Src:   "m[\"body\"].Contains(`break`)",
+									Value: "body",
+									Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "break"}},
+								}},
+							},
+						},
+					},
+				},
+				{
+					Line:           322,
+					SyntaxPatterns: []ir.PatternString{{Line: 322, Value: "switch $x.(type) { case $t: $*body; default: $*_ }"}},
+					ReportTemplate: "use if _, ok := $x.($t); ok { … } else { … } instead of a type switch with a single case and a default",
+					WhereExpr: ir.FilterExpr{
+						Line: 323,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"t\"].Text != `nil` && !m[\"body\"].Contains(`break`)",
+						Args: []ir.FilterExpr{
+							{
+								Line: 323,
+								Op:   ir.FilterNeqOp,
+								Src:  "m[\"t\"].Text != `nil`",
+								Args: []ir.FilterExpr{
+									{Line: 323, Op: ir.FilterVarTextOp, Src: "m[\"t\"].Text", Value: "t"},
+									{Line: 323, Op: ir.FilterStringOp, Src: "`nil`", Value: "nil"},
+								},
+							},
+							{
+								Line: 323,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"body\"].Contains(`break`)",
+								Args: []ir.FilterExpr{{
+									Line:  323,
+									Op:    ir.FilterVarContainsOp,
+									Src:   "m[\"body\"].Contains(`break`)",
+									Value: "body",
+									Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "break"}},
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        332,
+			Name:        "nilCheckBeforeRange",
+			MatcherName: "m",
+			DocTags:     []string{"score1"},
+			DocSummary:  "Detects redundant nil checks around range loops over slices and maps",
+			DocBefore:   "if xs != nil { for _, x := range xs { use(x) } }",
+			DocAfter:    "for _, x := range xs { use(x) }",
+			Rules: []ir.Rule{
+				{
+					Line: 340,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 341, Value: "if $s != nil { for $k, $v := range $s { $*body } }"},
+						{Line: 342, Value: "if nil != $s { for $k, $v := range $s { $*body } }"},
+					},
+					ReportTemplate:  "the $s != nil check is redundant, a range over a nil slice or map is a no-op",
+					SuggestTemplate: "for $k, $v := range $s { $body }",
+					WhereExpr: ir.FilterExpr{
+						Line: 344,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"s\"].Pure && isSliceOrMap(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{Line: 344, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
+							{
+								Line: 344,
+								Op:   ir.FilterOrOp,
+								Src:  "isSliceOrMap(m[\"s\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line:  344,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`[]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+									},
+									{
+										Line:  344,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`map[$_]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line: 347,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 348, Value: "if $s != nil { for $k, $v = range $s { $*body } }"},
+						{Line: 349, Value: "if nil != $s { for $k, $v = range $s { $*body } }"},
+					},
+					ReportTemplate:  "the $s != nil check is redundant, a range over a nil slice or map is a no-op",
+					SuggestTemplate: "for $k, $v = range $s { $body }",
+					WhereExpr: ir.FilterExpr{
+						Line: 351,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"s\"].Pure && isSliceOrMap(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{Line: 351, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
+							{
+								Line: 351,
+								Op:   ir.FilterOrOp,
+								Src:  "isSliceOrMap(m[\"s\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line:  351,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`[]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+									},
+									{
+										Line:  351,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`map[$_]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line: 354,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 355, Value: "if $s != nil { for $k := range $s { $*body } }"},
+						{Line: 356, Value: "if nil != $s { for $k := range $s { $*body } }"},
+					},
+					ReportTemplate:  "the $s != nil check is redundant, a range over a nil slice or map is a no-op",
+					SuggestTemplate: "for $k := range $s { $body }",
+					WhereExpr: ir.FilterExpr{
+						Line: 358,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"s\"].Pure && isSliceOrMap(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{Line: 358, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
+							{
+								Line: 358,
+								Op:   ir.FilterOrOp,
+								Src:  "isSliceOrMap(m[\"s\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line:  358,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`[]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+									},
+									{
+										Line:  358,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`map[$_]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line: 361,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 362, Value: "if $s != nil { for $k = range $s { $*body } }"},
+						{Line: 363, Value: "if nil != $s { for $k = range $s { $*body } }"},
+					},
+					ReportTemplate:  "the $s != nil check is redundant, a range over a nil slice or map is a no-op",
+					SuggestTemplate: "for $k = range $s { $body }",
+					WhereExpr: ir.FilterExpr{
+						Line: 365,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"s\"].Pure && isSliceOrMap(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{Line: 365, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
+							{
+								Line: 365,
+								Op:   ir.FilterOrOp,
+								Src:  "isSliceOrMap(m[\"s\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line:  365,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`[]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+									},
+									{
+										Line:  365,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`map[$_]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line: 368,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 369, Value: "if $s != nil { for range $s { $*body } }"},
+						{Line: 370, Value: "if nil != $s { for range $s { $*body } }"},
+					},
+					ReportTemplate:  "the $s != nil check is redundant, a range over a nil slice or map is a no-op",
+					SuggestTemplate: "for range $s { $body }",
+					WhereExpr: ir.FilterExpr{
+						Line: 372,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"s\"].Pure && isSliceOrMap(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{Line: 372, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
+							{
+								Line: 372,
+								Op:   ir.FilterOrOp,
+								Src:  "isSliceOrMap(m[\"s\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line:  372,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`[]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+									},
+									{
+										Line:  372,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "m[\"s\"].Type.Underlying().Is(`map[$_]$_`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 337, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// LintImpact maps a rule group name to its doc:impact value.
+var LintImpact = map[string]string{
+	"durationLiteral": "readability",
+	"lenSignCheck": "readability",
+	"nilCheckBeforeRange": "readability",
+	"rangeValueUnused": "readability",
+	"redundantConstConv": "readability",
+	"singleCaseTypeSwitch": "readability",
+	"sortFuncCmpCompare": "readability",
+}
@@ -10,182 +10,201 @@ var Opt = &ir.File{
 	BundleImports: []ir.BundleImport{},
 	RuleGroups: []ir.RuleGroup{
 		{
-			Line:        9,
+			Line:        10,
 			Name:        "stringConcatAssign",
 			MatcherName: "m",
 			DocTags:     []string{"o2", "score5"},
 			DocSummary:  "Detects string concat in hot paths",
 			Rules: []ir.Rule{{
-				Line:           10,
-				SyntaxPatterns: []ir.PatternString{{Line: 10, Value: "$s += $_"}},
+				Line:           11,
+				SyntaxPatterns: []ir.PatternString{{Line: 11, Value: "$s += $_"}},
 				ReportTemplate: "string concat on the hot path",
 				WhereExpr: ir.FilterExpr{
-					Line:  11,
+					Line:  12,
 					Op:    ir.FilterVarTypeIsOp,
 					Src:   "m[\"s\"].Type.Is(`string`)",
 					Value: "s",
-					Args:  []ir.FilterExpr{{Line: 11, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+					Args:  []ir.FilterExpr{{Line: 12, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 				},
 			}},
 		},
 		{
-			Line:        17,
+			Line:        19,
 			Name:        "regexpCompile",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score4"},
 			DocSummary:  "Detects regexp compilation on hot execution paths",
-			Rules: []ir.Rule{{
-				Line: 22,
-				SyntaxPatterns: []ir.PatternString{
-					{Line: 24, Value: "regexp.Compile($*_)"},
-					{Line: 25, Value: "regexp.MustCompile($*_)"},
-					{Line: 26, Value: "regexp.CompilePOSIX($*_)"},
-					{Line: 27, Value: "regexp.MustCompilePOSIX($*_)"},
-					{Line: 29, Value: "regexp.Match($*_)"},
-					{Line: 30, Value: "regexp.MatchString($*_)"},
-					{Line: 31, Value: "regexp.MatchReader($*_)"},
+			Rules: []ir.Rule{
+				{
+					Line: 24,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 25, Value: "regexp.Compile($pattern)"},
+						{Line: 26, Value: "regexp.MustCompile($pattern)"},
+						{Line: 27, Value: "regexp.CompilePOSIX($pattern)"},
+						{Line: 28, Value: "regexp.MustCompilePOSIX($pattern)"},
+					},
+					ReportTemplate: "regexp compilation should be avoided on the hot paths",
+					WhereExpr: ir.FilterExpr{
+						Line: 30,
+						Op:   ir.FilterNotOp,
+						Src:  "!m[\"pattern\"].Const",
+						Args: []ir.FilterExpr{{
+							Line:  30,
+							Op:    ir.FilterVarConstOp,
+							Src:   "m[\"pattern\"].Const",
+							Value: "pattern",
+						}},
+					},
 				},
-				ReportTemplate: "regexp compilation should be avoided on the hot paths",
-			}},
+				{
+					Line: 34,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 35, Value: "regexp.Match($*_)"},
+						{Line: 36, Value: "regexp.MatchString($*_)"},
+						{Line: 37, Value: "regexp.MatchReader($*_)"},
+					},
+					ReportTemplate: "regexp compilation should be avoided on the hot paths",
+				},
+			},
 		},
 		{
-			Line:        37,
+			Line:        44,
 			Name:        "sprintfConcat2",
 			MatcherName: "m",
 			DocTags:     []string{"o2", "score2"},
 			DocSummary:  "Detects sprint calls that can be rewritten as a string concat",
 			Rules: []ir.Rule{
 				{
-					Line:            42,
-					SyntaxPatterns:  []ir.PatternString{{Line: 42, Value: "fmt.Sprintf(\"%s=%s\", $x, $y)"}},
+					Line:            49,
+					SyntaxPatterns:  []ir.PatternString{{Line: 49, Value: "fmt.Sprintf(\"%s=%s\", $x, $y)"}},
 					ReportTemplate:  "$$ => $x + \"=\" + $y",
 					SuggestTemplate: "$x + \"=\" + $y",
 					WhereExpr: ir.FilterExpr{
-						Line: 43,
+						Line: 50,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Is(`string`) && m[\"y\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  43,
+								Line:  50,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 43, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 50, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  43,
+								Line:  50,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"y\"].Type.Is(`string`)",
 								Value: "y",
-								Args:  []ir.FilterExpr{{Line: 43, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 50, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            46,
-					SyntaxPatterns:  []ir.PatternString{{Line: 46, Value: "fmt.Sprintf(\"%s.%s\", $x, $y)"}},
+					Line:            53,
+					SyntaxPatterns:  []ir.PatternString{{Line: 53, Value: "fmt.Sprintf(\"%s.%s\", $x, $y)"}},
 					ReportTemplate:  "$$ => $x + \".\" + $y",
 					SuggestTemplate: "$x + \".\" + $y",
 					WhereExpr: ir.FilterExpr{
-						Line: 47,
+						Line: 54,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Is(`string`) && m[\"y\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  47,
+								Line:  54,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 47, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 54, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  47,
+								Line:  54,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"y\"].Type.Is(`string`)",
 								Value: "y",
-								Args:  []ir.FilterExpr{{Line: 47, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 54, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            50,
-					SyntaxPatterns:  []ir.PatternString{{Line: 50, Value: "fmt.Sprintf(\"%s/%s\", $x, $y)"}},
+					Line:            57,
+					SyntaxPatterns:  []ir.PatternString{{Line: 57, Value: "fmt.Sprintf(\"%s/%s\", $x, $y)"}},
 					ReportTemplate:  "$$ => $x + \"/\" + $y",
 					SuggestTemplate: "$x + \"/\" + $y",
 					WhereExpr: ir.FilterExpr{
-						Line: 51,
+						Line: 58,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Is(`string`) && m[\"y\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  51,
+								Line:  58,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 51, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 58, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  51,
+								Line:  58,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"y\"].Type.Is(`string`)",
 								Value: "y",
-								Args:  []ir.FilterExpr{{Line: 51, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 58, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            54,
-					SyntaxPatterns:  []ir.PatternString{{Line: 54, Value: "fmt.Sprintf(\"%s:%s\", $x, $y)"}},
+					Line:            61,
+					SyntaxPatterns:  []ir.PatternString{{Line: 61, Value: "fmt.Sprintf(\"%s:%s\", $x, $y)"}},
 					ReportTemplate:  "$$ => $x + \":\" + $y",
 					SuggestTemplate: "$x + \":\" + $y",
 					WhereExpr: ir.FilterExpr{
-						Line: 55,
+						Line: 62,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Is(`string`) && m[\"y\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  55,
+								Line:  62,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 55, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 62, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  55,
+								Line:  62,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"y\"].Type.Is(`string`)",
 								Value: "y",
-								Args:  []ir.FilterExpr{{Line: 55, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 62, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            58,
-					SyntaxPatterns:  []ir.PatternString{{Line: 58, Value: "fmt.Sprintf(\"%s: %s\", $x, $y)"}},
+					Line:            65,
+					SyntaxPatterns:  []ir.PatternString{{Line: 65, Value: "fmt.Sprintf(\"%s: %s\", $x, $y)"}},
 					ReportTemplate:  "$$ => $x + \": \" + $y",
 					SuggestTemplate: "$x + \": \" + $y",
 					WhereExpr: ir.FilterExpr{
-						Line: 59,
+						Line: 66,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Is(`string`) && m[\"y\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  59,
+								Line:  66,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 59, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 66, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  59,
+								Line:  66,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"y\"].Type.Is(`string`)",
 								Value: "y",
-								Args:  []ir.FilterExpr{{Line: 59, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 66, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
@@ -193,7 +212,7 @@ var Opt = &ir.File{
 			},
 		},
 		{
-			Line:        67,
+			Line:        75,
 			Name:        "writeString2",
 			MatcherName: "m",
 			DocTags:     []string{"o2", "score3"},
@@ -201,38 +220,38 @@ var Opt = &ir.File{
 			DocBefore:   "w.Write([]byte(s))",
 			DocAfter:    "io.WriteString(w, s)",
 			Rules: []ir.Rule{{
-				Line:            68,
-				SyntaxPatterns:  []ir.PatternString{{Line: 68, Value: "$w.Write([]byte($s))"}},
+				Line:            76,
+				SyntaxPatterns:  []ir.PatternString{{Line: 76, Value: "$w.Write([]byte($s))"}},
 				ReportTemplate:  "$$ => io.WriteString($w, $s)",
 				SuggestTemplate: "io.WriteString($w, $s)",
 				WhereExpr: ir.FilterExpr{
-					Line: 69,
+					Line: 77,
 					Op:   ir.FilterAndOp,
 					Src:  "m[\"w\"].Type.Is(\"io.Writer\") && m[\"s\"].Type.Is(`string`) && m[\"s\"].Const",
 					Args: []ir.FilterExpr{
 						{
-							Line: 69,
+							Line: 77,
 							Op:   ir.FilterAndOp,
 							Src:  "m[\"w\"].Type.Is(\"io.Writer\") && m[\"s\"].Type.Is(`string`)",
 							Args: []ir.FilterExpr{
 								{
-									Line:  69,
+									Line:  77,
 									Op:    ir.FilterVarTypeIsOp,
 									Src:   "m[\"w\"].Type.Is(\"io.Writer\")",
 									Value: "w",
-									Args:  []ir.FilterExpr{{Line: 69, Op: ir.FilterStringOp, Src: "\"io.Writer\"", Value: "io.Writer"}},
+									Args:  []ir.FilterExpr{{Line: 77, Op: ir.FilterStringOp, Src: "\"io.Writer\"", Value: "io.Writer"}},
 								},
 								{
-									Line:  69,
+									Line:  77,
 									Op:    ir.FilterVarTypeIsOp,
 									Src:   "m[\"s\"].Type.Is(`string`)",
 									Value: "s",
-									Args:  []ir.FilterExpr{{Line: 69, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+									Args:  []ir.FilterExpr{{Line: 77, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 								},
 							},
 						},
 						{
-							Line:  69,
+							Line:  77,
 							Op:    ir.FilterVarConstOp,
 							Src:   "m[\"s\"].Const",
 							Value: "s",
@@ -242,31 +261,31 @@ var Opt = &ir.File{
 			}},
 		},
 		{
-			Line:        75,
+			Line:        84,
 			Name:        "rangeValueCopy",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
 			DocSummary:  "Detects range loops that copy large value on every iteration",
 			Rules: []ir.Rule{{
-				Line: 77,
+				Line: 86,
 				SyntaxPatterns: []ir.PatternString{
-					{Line: 77, Value: "for $_, $v := range $_"},
-					{Line: 77, Value: "for $_, $v = range $_"},
+					{Line: 86, Value: "for $_, $v := range $_"},
+					{Line: 86, Value: "for $_, $v = range $_"},
 				},
 				ReportTemplate: "every iteration copies a large object into $v",
 				WhereExpr: ir.FilterExpr{
-					Line: 78,
+					Line: 87,
 					Op:   ir.FilterGtOp,
 					Src:  "m[\"v\"].Type.Size > 128",
 					Args: []ir.FilterExpr{
 						{
-							Line:  78,
+							Line:  87,
 							Op:    ir.FilterVarTypeSizeOp,
 							Src:   "m[\"v\"].Type.Size",
 							Value: "v",
 						},
 						{
-							Line:  78,
+							Line:  87,
 							Op:    ir.FilterIntOp,
 							Src:   "128",
 							Value: int64(128),
@@ -276,23 +295,129 @@ var Opt = &ir.File{
 			}},
 		},
 		{
-			Line:        84,
+			Line:        94,
 			Name:        "constErrorNew",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
 			DocSummary:  "Detects errors.New that can be allocated exactly once",
 			Rules: []ir.Rule{{
-				Line:           85,
-				SyntaxPatterns: []ir.PatternString{{Line: 85, Value: "errors.New($x)"}},
+				Line:           95,
+				SyntaxPatterns: []ir.PatternString{{Line: 95, Value: "errors.New($x)"}},
 				ReportTemplate: "errors with const message can be a global var, allocated only once",
 				WhereExpr: ir.FilterExpr{
-					Line:  86,
+					Line:  96,
 					Op:    ir.FilterVarConstOp,
 					Src:   "m[\"x\"].Const",
 					Value: "x",
 				},
 			}},
 		},
+		{
+			Line:        103,
+			Name:        "hotDebugFormat",
+			MatcherName: "m",
+			DocTags:     []string{"o2", "score2"},
+			DocSummary:  "Detects hot formatting of slices, maps and structs that looks like a debug leftover",
+			Rules: []ir.Rule{{
+				Line: 113,
+				SyntaxPatterns: []ir.PatternString{
+					{Line: 113, Value: "fmt.Sprintf(\"%v\", $x)"},
+					{Line: 113, Value: "fmt.Sprintf(\"%+v\", $x)"},
+					{Line: 113, Value: "fmt.Sprint($x)"},
+				},
+				ReportTemplate: "formatting $x on a hot path is expensive, remove it or guard it behind a debug flag",
+				WhereExpr: ir.FilterExpr{
+					Line: 114,
+					Op:   ir.FilterAndOp,
+					Src:  "isComposite(m[\"x\"]) && !m[\"x\"].Type.Implements(`fmt.Stringer`)",
+					Args: []ir.FilterExpr{
+						{
+							Line: 114,
+							Op:   ir.FilterOrOp,
+							Src:  "isComposite(m[\"x\"])",
+							Args: []ir.FilterExpr{
+								{
+									Line: 114,
+									Op:   ir.FilterOrOp,
+									Src:  "m[\"x\"].Type.Underlying().Is(`[]$_`) ||\n\n\tm[\"x\"].Type.Underlying().Is(`map[$_]$_`)",
+									Args: []ir.FilterExpr{
+										{
+											Line:  114,
+											Op:    ir.FilterVarTypeUnderlyingIsOp,
+											Src:   "m[\"x\"].Type.Underlying().Is(`[]$_`)",
+											Value: "x",
+											Args:  []ir.FilterExpr{{Line: 108, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+										},
+										{
+											Line:  114,
+											Op:    ir.FilterVarTypeUnderlyingIsOp,
+											Src:   "m[\"x\"].Type.Underlying().Is(`map[$_]$_`)",
+											Value: "x",
+											Args:  []ir.FilterExpr{{Line: 109, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+										},
+									},
+								},
+								{
+									Line:  114,
+									Op:    ir.FilterVarTypeUnderlyingIsOp,
+									Src:   "m[\"x\"].Type.Underlying().Is(`struct{$*_}`)",
+									Value: "x",
+									Args:  []ir.FilterExpr{{Line: 110, Op: ir.FilterStringOp, Src: "`struct{$*_}`", Value: "struct{$*_}"}},
+								},
+							},
+						},
+						{
+							Line: 114,
+							Op:   ir.FilterNotOp,
+							Src:  "!m[\"x\"].Type.Implements(`fmt.Stringer`)",
+							Args: []ir.FilterExpr{{
+								Line:  114,
+								Op:    ir.FilterVarTypeImplementsOp,
+								Src:   "m[\"x\"].Type.Implements(`fmt.Stringer`)",
+								Value: "x",
+								Args:  []ir.FilterExpr{{Line: 114, Op: ir.FilterStringOp, Src: "`fmt.Stringer`", Value: "fmt.Stringer"}},
+							}},
+						},
+					},
+				},
+			}},
+		},
+		{
+			Line:        124,
+			Name:        "caseFoldMapKey",
+			MatcherName: "m",
+			DocTags:     []string{"o2", "score2"},
+			DocSummary:  "Detects map accesses with a case-normalized key on hot paths",
+			DocBefore:   "v := m[strings.ToLower(k)]",
+			DocAfter:    "v := m[k] // k is normalized once, when it enters the program",
+			DocNote:     "there is no autofix: the keys normalization point depends on the program",
+			Rules: []ir.Rule{{
+				Line: 132,
+				SyntaxPatterns: []ir.PatternString{
+					{Line: 132, Value: "$m[strings.ToLower($k)]"},
+					{Line: 132, Value: "$m[strings.ToUpper($k)]"},
+				},
+				ReportTemplate: "case-insensitive $m key lookups allocate, normalize the keys once instead of on every access",
+				WhereExpr: ir.FilterExpr{
+					Line:  133,
+					Op:    ir.FilterVarTypeUnderlyingIsOp,
+					Src:   "m[\"m\"].Type.Underlying().Is(`map[string]$_`)",
+					Value: "m",
+					Args:  []ir.FilterExpr{{Line: 133, Op: ir.FilterStringOp, Src: "`map[string]$_`", Value: "map[string]$_"}},
+				},
+			}},
+		},
 	},
 }
 
+// OptImpact maps a rule group name to its doc:impact value.
+var OptImpact = map[string]string{
+	"caseFoldMapKey": "alloc",
+	"constErrorNew": "alloc",
+	"hotDebugFormat": "alloc",
+	"rangeValueCopy": "cpu",
+	"regexpCompile": "cpu",
+	"sprintfConcat2": "alloc",
+	"stringConcatAssign": "alloc",
+	"writeString2": "alloc",
+}
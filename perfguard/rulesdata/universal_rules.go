@@ -10,7 +10,7 @@ var Universal = &ir.File{
 	BundleImports: []ir.BundleImport{},
 	RuleGroups: []ir.RuleGroup{
 		{
-			Line:        46,
+			Line:        47,
 			Name:        "stringsCut",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
@@ -19,33 +19,62 @@ var Universal = &ir.File{
 			DocAfter:    "email, _, _ := strings.Cut(s, \"@\")",
 			Rules: []ir.Rule{
 				{
-					Line:            47,
-					SyntaxPatterns:  []ir.PatternString{{Line: 47, Value: "$dst := strings.Split($s, $sep)[0]"}},
+					Line:            48,
+					SyntaxPatterns:  []ir.PatternString{{Line: 48, Value: "$dst := strings.Split($s, $sep)[0]"}},
 					ReportTemplate:  "$$ => $dst, _, _ := strings.Cut($s, $sep)",
 					SuggestTemplate: "$dst, _, _ := strings.Cut($s, $sep)",
 					WhereExpr: ir.FilterExpr{
-						Line:  48,
+						Line:  49,
 						Op:    ir.FilterGoVersionGreaterEqThanOp,
 						Src:   "m.GoVersion().GreaterEqThan(\"1.18\")",
 						Value: "1.18",
 					},
 				},
 				{
-					Line:            50,
-					SyntaxPatterns:  []ir.PatternString{{Line: 50, Value: "$dst = strings.Split($s, $sep)[0]"}},
+					Line:            51,
+					SyntaxPatterns:  []ir.PatternString{{Line: 51, Value: "$dst = strings.Split($s, $sep)[0]"}},
 					ReportTemplate:  "$$ => $dst, _, _ = strings.Cut($s, $sep)",
 					SuggestTemplate: "$dst, _, _ = strings.Cut($s, $sep)",
 					WhereExpr: ir.FilterExpr{
-						Line:  51,
+						Line:  52,
 						Op:    ir.FilterGoVersionGreaterEqThanOp,
 						Src:   "m.GoVersion().GreaterEqThan(\"1.18\")",
 						Value: "1.18",
 					},
 				},
+				{
+					Line:           58,
+					SyntaxPatterns: []ir.PatternString{{Line: 58, Value: "strings.Split($s, $sep)[0]"}},
+					ReportTemplate: "strings.Split allocates a slice of all fields, use before, _, _ := strings.Cut($s, $sep) to get the first one",
+					WhereExpr: ir.FilterExpr{
+						Line: 59,
+						Op:   ir.FilterAndOp,
+						Src:  "m.GoVersion().GreaterEqThan(\"1.18\") && !m[\"$$\"].Node.Parent().Is(`AssignStmt`)",
+						Args: []ir.FilterExpr{
+							{
+								Line:  59,
+								Op:    ir.FilterGoVersionGreaterEqThanOp,
+								Src:   "m.GoVersion().GreaterEqThan(\"1.18\")",
+								Value: "1.18",
+							},
+							{
+								Line: 59,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"$$\"].Node.Parent().Is(`AssignStmt`)",
+								Args: []ir.FilterExpr{{
+									Line: 59,
+									Op:   ir.FilterRootNodeParentIsOp,
+									Src:  "m[\"$$\"].Node.Parent().Is(`AssignStmt`)",
+									Args: []ir.FilterExpr{{Line: 59, Op: ir.FilterStringOp, Src: "`AssignStmt`", Value: "AssignStmt"}},
+								}},
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			Line:        59,
+			Line:        68,
 			Name:        "bytesCut",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
@@ -54,24 +83,24 @@ var Universal = &ir.File{
 			DocAfter:    "email, _, _ := bytes.Cut(b, []byte(\"@\"))",
 			Rules: []ir.Rule{
 				{
-					Line:            60,
-					SyntaxPatterns:  []ir.PatternString{{Line: 60, Value: "$dst := bytes.Split($b, $sep)[0]"}},
+					Line:            69,
+					SyntaxPatterns:  []ir.PatternString{{Line: 69, Value: "$dst := bytes.Split($b, $sep)[0]"}},
 					ReportTemplate:  "$$ => $dst, _, _ := bytes.Cut($b, $sep)",
 					SuggestTemplate: "$dst, _, _ := bytes.Cut($b, $sep)",
 					WhereExpr: ir.FilterExpr{
-						Line:  61,
+						Line:  70,
 						Op:    ir.FilterGoVersionGreaterEqThanOp,
 						Src:   "m.GoVersion().GreaterEqThan(\"1.18\")",
 						Value: "1.18",
 					},
 				},
 				{
-					Line:            63,
-					SyntaxPatterns:  []ir.PatternString{{Line: 63, Value: "$dst = bytes.Split($b, $sep)[0]"}},
+					Line:            72,
+					SyntaxPatterns:  []ir.PatternString{{Line: 72, Value: "$dst = bytes.Split($b, $sep)[0]"}},
 					ReportTemplate:  "$$ => $dst, _, _ = bytes.Cut($b, $sep)",
 					SuggestTemplate: "$dst, _, _ = bytes.Cut($b, $sep)",
 					WhereExpr: ir.FilterExpr{
-						Line:  64,
+						Line:  73,
 						Op:    ir.FilterGoVersionGreaterEqThanOp,
 						Src:   "m.GoVersion().GreaterEqThan(\"1.18\")",
 						Value: "1.18",
@@ -80,7 +109,48 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        72,
+			Line:        83,
+			Name:        "stringsCount",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score3"},
+			DocSummary:  "Detects strings.Split calls that are only used to count the separators",
+			DocBefore:   "n := len(strings.Split(s, \",\")) - 1",
+			DocAfter:    "n := strings.Count(s, \",\")",
+			DocNote:     "an empty separator makes Split and Count disagree, so only non-empty constant separators are matched",
+			Rules: []ir.Rule{{
+				Line:            84,
+				SyntaxPatterns:  []ir.PatternString{{Line: 84, Value: "len(strings.Split($s, $sep)) - 1"}},
+				ReportTemplate:  "$$ => strings.Count($s, $sep)",
+				SuggestTemplate: "strings.Count($s, $sep)",
+				WhereExpr: ir.FilterExpr{
+					Line: 85,
+					Op:   ir.FilterAndOp,
+					Src:  "m[\"sep\"].Const && !m[\"sep\"].Text.Matches(\"^(\\\"\\\"|``)$\")",
+					Args: []ir.FilterExpr{
+						{
+							Line:  85,
+							Op:    ir.FilterVarConstOp,
+							Src:   "m[\"sep\"].Const",
+							Value: "sep",
+						},
+						{
+							Line: 85,
+							Op:   ir.FilterNotOp,
+							Src:  "!m[\"sep\"].Text.Matches(\"^(\\\"\\\"|``)$\")",
+							Args: []ir.FilterExpr{{
+								Line:  85,
+								Op:    ir.FilterVarTextMatchesOp,
+								Src:   "m[\"sep\"].Text.Matches(\"^(\\\"\\\"|``)$\")",
+								Value: "sep",
+								Args:  []ir.FilterExpr{{Line: 85, Op: ir.FilterStringOp, Src: "\"^(\\\"\\\"|``)$\"", Value: "^(\"\"|``)$"}},
+							}},
+						},
+					},
+				},
+			}},
+		},
+		{
+			Line:        94,
 			Name:        "stringsClone",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
@@ -88,33 +158,33 @@ var Universal = &ir.File{
 			DocBefore:   "s2 := string([]byte(s1))",
 			DocAfter:    "s2 := strings.Clone(s1)",
 			Rules: []ir.Rule{{
-				Line:            73,
-				SyntaxPatterns:  []ir.PatternString{{Line: 73, Value: "string([]byte($s))"}},
+				Line:            95,
+				SyntaxPatterns:  []ir.PatternString{{Line: 95, Value: "string([]byte($s))"}},
 				ReportTemplate:  "$$ => strings.Clone($s)",
 				SuggestTemplate: "strings.Clone($s)",
 				WhereExpr: ir.FilterExpr{
-					Line: 74,
+					Line: 96,
 					Op:   ir.FilterAndOp,
 					Src:  "m[\"s\"].Type.Is(`string`) &&\n\t!m[\"s\"].Const &&\n\tm.GoVersion().GreaterEqThan(\"1.18\")",
 					Args: []ir.FilterExpr{
 						{
-							Line: 74,
+							Line: 96,
 							Op:   ir.FilterAndOp,
 							Src:  "m[\"s\"].Type.Is(`string`) &&\n\t!m[\"s\"].Const",
 							Args: []ir.FilterExpr{
 								{
-									Line:  74,
+									Line:  96,
 									Op:    ir.FilterVarTypeIsOp,
 									Src:   "m[\"s\"].Type.Is(`string`)",
 									Value: "s",
-									Args:  []ir.FilterExpr{{Line: 74, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+									Args:  []ir.FilterExpr{{Line: 96, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 								},
 								{
-									Line: 75,
+									Line: 97,
 									Op:   ir.FilterNotOp,
 									Src:  "!m[\"s\"].Const",
 									Args: []ir.FilterExpr{{
-										Line:  75,
+										Line:  97,
 										Op:    ir.FilterVarConstOp,
 										Src:   "m[\"s\"].Const",
 										Value: "s",
@@ -123,7 +193,7 @@ var Universal = &ir.File{
 							},
 						},
 						{
-							Line:  76,
+							Line:  98,
 							Op:    ir.FilterGoVersionGreaterEqThanOp,
 							Src:   "m.GoVersion().GreaterEqThan(\"1.18\")",
 							Value: "1.18",
@@ -133,7 +203,7 @@ var Universal = &ir.File{
 			}},
 		},
 		{
-			Line:        84,
+			Line:        107,
 			Name:        "equalFold",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
@@ -142,250 +212,250 @@ var Universal = &ir.File{
 			DocAfter:    "strings.EqualFold(x, y)",
 			Rules: []ir.Rule{
 				{
-					Line: 86,
+					Line: 109,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 87, Value: "strings.ToLower($x) == $y"},
-						{Line: 88, Value: "strings.ToLower($x) == strings.ToLower($y)"},
-						{Line: 89, Value: "$x == strings.ToLower($y)"},
-						{Line: 90, Value: "strings.ToUpper($x) == $y"},
-						{Line: 91, Value: "strings.ToUpper($x) == strings.ToUpper($y)"},
-						{Line: 92, Value: "$x == strings.ToUpper($y)"},
+						{Line: 110, Value: "strings.ToLower($x) == $y"},
+						{Line: 111, Value: "strings.ToLower($x) == strings.ToLower($y)"},
+						{Line: 112, Value: "$x == strings.ToLower($y)"},
+						{Line: 113, Value: "strings.ToUpper($x) == $y"},
+						{Line: 114, Value: "strings.ToUpper($x) == strings.ToUpper($y)"},
+						{Line: 115, Value: "$x == strings.ToUpper($y)"},
 					},
 					ReportTemplate:  "$$ => strings.EqualFold($x, $y)",
 					SuggestTemplate: "strings.EqualFold($x, $y)",
 					WhereExpr: ir.FilterExpr{
-						Line: 93,
+						Line: 116,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Pure && m[\"y\"].Pure && m[\"x\"].Text != m[\"y\"].Text",
 						Args: []ir.FilterExpr{
 							{
-								Line: 93,
+								Line: 116,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure && m[\"y\"].Pure",
 								Args: []ir.FilterExpr{
-									{Line: 93, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-									{Line: 93, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+									{Line: 116, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+									{Line: 116, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 								},
 							},
 							{
-								Line: 93,
+								Line: 116,
 								Op:   ir.FilterNeqOp,
 								Src:  "m[\"x\"].Text != m[\"y\"].Text",
 								Args: []ir.FilterExpr{
-									{Line: 93, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
-									{Line: 93, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
+									{Line: 116, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
+									{Line: 116, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
 								},
 							},
 						},
 					},
 				},
 				{
-					Line: 97,
+					Line: 120,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 98, Value: "strings.ToLower($x) != $y"},
-						{Line: 99, Value: "strings.ToLower($x) != strings.ToLower($y)"},
-						{Line: 100, Value: "$x != strings.ToLower($y)"},
-						{Line: 101, Value: "strings.ToUpper($x) != $y"},
-						{Line: 102, Value: "strings.ToUpper($x) != strings.ToUpper($y)"},
-						{Line: 103, Value: "$x != strings.ToUpper($y)"},
+						{Line: 121, Value: "strings.ToLower($x) != $y"},
+						{Line: 122, Value: "strings.ToLower($x) != strings.ToLower($y)"},
+						{Line: 123, Value: "$x != strings.ToLower($y)"},
+						{Line: 124, Value: "strings.ToUpper($x) != $y"},
+						{Line: 125, Value: "strings.ToUpper($x) != strings.ToUpper($y)"},
+						{Line: 126, Value: "$x != strings.ToUpper($y)"},
 					},
 					ReportTemplate:  "$$ => !strings.EqualFold($x, $y)",
 					SuggestTemplate: "!strings.EqualFold($x, $y)",
 					WhereExpr: ir.FilterExpr{
-						Line: 104,
+						Line: 127,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Pure && m[\"y\"].Pure && m[\"x\"].Text != m[\"y\"].Text",
 						Args: []ir.FilterExpr{
 							{
-								Line: 104,
+								Line: 127,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure && m[\"y\"].Pure",
 								Args: []ir.FilterExpr{
-									{Line: 104, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-									{Line: 104, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+									{Line: 127, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+									{Line: 127, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 								},
 							},
 							{
-								Line: 104,
+								Line: 127,
 								Op:   ir.FilterNeqOp,
 								Src:  "m[\"x\"].Text != m[\"y\"].Text",
 								Args: []ir.FilterExpr{
-									{Line: 104, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
-									{Line: 104, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
+									{Line: 127, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
+									{Line: 127, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
 								},
 							},
 						},
 					},
 				},
 				{
-					Line: 108,
+					Line: 131,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 109, Value: "bytes.Equal(bytes.ToLower($x), $y)"},
-						{Line: 110, Value: "bytes.Equal(bytes.ToLower($x), bytes.ToLower($y))"},
-						{Line: 111, Value: "bytes.Equal($x, bytes.ToLower($y))"},
-						{Line: 112, Value: "bytes.Equal(bytes.ToUpper($x), $y)"},
-						{Line: 113, Value: "bytes.Equal(bytes.ToUpper($x), bytes.ToUpper($y))"},
-						{Line: 114, Value: "bytes.Equal($x, bytes.ToUpper($y))"},
+						{Line: 132, Value: "bytes.Equal(bytes.ToLower($x), $y)"},
+						{Line: 133, Value: "bytes.Equal(bytes.ToLower($x), bytes.ToLower($y))"},
+						{Line: 134, Value: "bytes.Equal($x, bytes.ToLower($y))"},
+						{Line: 135, Value: "bytes.Equal(bytes.ToUpper($x), $y)"},
+						{Line: 136, Value: "bytes.Equal(bytes.ToUpper($x), bytes.ToUpper($y))"},
+						{Line: 137, Value: "bytes.Equal($x, bytes.ToUpper($y))"},
 					},
 					ReportTemplate:  "$$ => bytes.EqualFold($x, $y)",
 					SuggestTemplate: "bytes.EqualFold($x, $y)",
 					WhereExpr: ir.FilterExpr{
-						Line: 115,
+						Line: 138,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Pure && m[\"y\"].Pure && m[\"x\"].Text != m[\"y\"].Text",
 						Args: []ir.FilterExpr{
 							{
-								Line: 115,
+								Line: 138,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure && m[\"y\"].Pure",
 								Args: []ir.FilterExpr{
-									{Line: 115, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-									{Line: 115, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+									{Line: 138, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+									{Line: 138, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 								},
 							},
 							{
-								Line: 115,
+								Line: 138,
 								Op:   ir.FilterNeqOp,
 								Src:  "m[\"x\"].Text != m[\"y\"].Text",
 								Args: []ir.FilterExpr{
-									{Line: 115, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
-									{Line: 115, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
+									{Line: 138, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
+									{Line: 138, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
 								},
 							},
 						},
 					},
 				},
 				{
-					Line: 119,
+					Line: 142,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 120, Value: "strings.HasPrefix(strings.ToLower($x), $y)"},
-						{Line: 121, Value: "strings.HasPrefix(strings.ToUpper($x), $y)"},
+						{Line: 143, Value: "strings.HasPrefix(strings.ToLower($x), $y)"},
+						{Line: 144, Value: "strings.HasPrefix(strings.ToUpper($x), $y)"},
 					},
 					ReportTemplate:  "$$ => (len($x) >= len($y) && strings.EqualFold($x[:len($y)], $y))",
 					SuggestTemplate: "(len($x) >= len($y) && strings.EqualFold($x[:len($y)], $y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 122,
+						Line: 145,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Pure && m[\"y\"].Pure && m[\"x\"].Text != m[\"y\"].Text",
 						Args: []ir.FilterExpr{
 							{
-								Line: 122,
+								Line: 145,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure && m[\"y\"].Pure",
 								Args: []ir.FilterExpr{
-									{Line: 122, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-									{Line: 122, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+									{Line: 145, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+									{Line: 145, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 								},
 							},
 							{
-								Line: 122,
+								Line: 145,
 								Op:   ir.FilterNeqOp,
 								Src:  "m[\"x\"].Text != m[\"y\"].Text",
 								Args: []ir.FilterExpr{
-									{Line: 122, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
-									{Line: 122, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
+									{Line: 145, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
+									{Line: 145, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
 								},
 							},
 						},
 					},
 				},
 				{
-					Line: 124,
+					Line: 147,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 125, Value: "strings.HasSuffix(strings.ToLower($x), $y)"},
-						{Line: 126, Value: "strings.HasSuffix(strings.ToUpper($x), $y)"},
+						{Line: 148, Value: "strings.HasSuffix(strings.ToLower($x), $y)"},
+						{Line: 149, Value: "strings.HasSuffix(strings.ToUpper($x), $y)"},
 					},
 					ReportTemplate:  "$$ => (len($x) >= len($y) && strings.EqualFold($x[len($x)-len($y):], $y))",
 					SuggestTemplate: "(len($x) >= len($y) && strings.EqualFold($x[len($x)-len($y):], $y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 127,
+						Line: 150,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Pure && m[\"y\"].Pure && m[\"x\"].Text != m[\"y\"].Text",
 						Args: []ir.FilterExpr{
 							{
-								Line: 127,
+								Line: 150,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure && m[\"y\"].Pure",
 								Args: []ir.FilterExpr{
-									{Line: 127, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-									{Line: 127, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+									{Line: 150, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+									{Line: 150, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 								},
 							},
 							{
-								Line: 127,
+								Line: 150,
 								Op:   ir.FilterNeqOp,
 								Src:  "m[\"x\"].Text != m[\"y\"].Text",
 								Args: []ir.FilterExpr{
-									{Line: 127, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
-									{Line: 127, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
+									{Line: 150, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
+									{Line: 150, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
 								},
 							},
 						},
 					},
 				},
 				{
-					Line: 131,
+					Line: 154,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 132, Value: "bytes.HasPrefix(bytes.ToLower($x), $y)"},
-						{Line: 133, Value: "bytes.HasPrefix(bytes.ToUpper($x), $y)"},
+						{Line: 155, Value: "bytes.HasPrefix(bytes.ToLower($x), $y)"},
+						{Line: 156, Value: "bytes.HasPrefix(bytes.ToUpper($x), $y)"},
 					},
 					ReportTemplate:  "$$ => (len($x) >= len($y) && bytes.EqualFold($x[:len($y)], $y))",
 					SuggestTemplate: "(len($x) >= len($y) && bytes.EqualFold($x[:len($y)], $y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 134,
+						Line: 157,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Pure && m[\"y\"].Pure && m[\"x\"].Text != m[\"y\"].Text",
 						Args: []ir.FilterExpr{
 							{
-								Line: 134,
+								Line: 157,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure && m[\"y\"].Pure",
 								Args: []ir.FilterExpr{
-									{Line: 134, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-									{Line: 134, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+									{Line: 157, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+									{Line: 157, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 								},
 							},
 							{
-								Line: 134,
+								Line: 157,
 								Op:   ir.FilterNeqOp,
 								Src:  "m[\"x\"].Text != m[\"y\"].Text",
 								Args: []ir.FilterExpr{
-									{Line: 134, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
-									{Line: 134, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
+									{Line: 157, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
+									{Line: 157, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
 								},
 							},
 						},
 					},
 				},
 				{
-					Line: 136,
+					Line: 159,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 137, Value: "bytes.HasSuffix(bytes.ToLower($x), $y)"},
-						{Line: 138, Value: "bytes.HasSuffix(bytes.ToUpper($x), $y)"},
+						{Line: 160, Value: "bytes.HasSuffix(bytes.ToLower($x), $y)"},
+						{Line: 161, Value: "bytes.HasSuffix(bytes.ToUpper($x), $y)"},
 					},
 					ReportTemplate:  "$$ => (len($x) >= len($y) && bytes.EqualFold($x[len($x)-len($y):], $y))",
 					SuggestTemplate: "(len($x) >= len($y) && bytes.EqualFold($x[len($x)-len($y):], $y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 139,
+						Line: 162,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Pure && m[\"y\"].Pure && m[\"x\"].Text != m[\"y\"].Text",
 						Args: []ir.FilterExpr{
 							{
-								Line: 139,
+								Line: 162,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure && m[\"y\"].Pure",
 								Args: []ir.FilterExpr{
-									{Line: 139, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-									{Line: 139, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+									{Line: 162, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+									{Line: 162, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 								},
 							},
 							{
-								Line: 139,
+								Line: 162,
 								Op:   ir.FilterNeqOp,
 								Src:  "m[\"x\"].Text != m[\"y\"].Text",
 								Args: []ir.FilterExpr{
-									{Line: 139, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
-									{Line: 139, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
+									{Line: 162, Op: ir.FilterVarTextOp, Src: "m[\"x\"].Text", Value: "x"},
+									{Line: 162, Op: ir.FilterVarTextOp, Src: "m[\"y\"].Text", Value: "y"},
 								},
 							},
 						},
@@ -394,113 +464,146 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        145,
+			Line:        170,
 			Name:        "redundantSprint",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
 			DocSummary:  "Detects redundant fmt.Sprint calls",
+			DocNote:     "a []byte is only rewritten for %s, fmt.Sprint and %v print its byte values",
 			Rules: []ir.Rule{
 				{
-					Line: 146,
+					Line: 171,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 146, Value: "fmt.Sprint($x)"},
-						{Line: 146, Value: "fmt.Sprintf(\"%s\", $x)"},
-						{Line: 146, Value: "fmt.Sprintf(\"%v\", $x)"},
+						{Line: 171, Value: "fmt.Sprint($x)"},
+						{Line: 171, Value: "fmt.Sprintf(\"%s\", $x)"},
+						{Line: 171, Value: "fmt.Sprintf(\"%v\", $x)"},
 					},
 					ReportTemplate:  "$$ => $x.String()",
 					SuggestTemplate: "$x.String()",
 					WhereExpr: ir.FilterExpr{
-						Line:  147,
+						Line:  172,
 						Op:    ir.FilterVarTypeImplementsOp,
 						Src:   "m[\"x\"].Type.Implements(`fmt.Stringer`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 147, Op: ir.FilterStringOp, Src: "`fmt.Stringer`", Value: "fmt.Stringer"}},
+						Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`fmt.Stringer`", Value: "fmt.Stringer"}},
 					},
 				},
 				{
-					Line: 150,
+					Line: 175,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 150, Value: "fmt.Sprint($x)"},
-						{Line: 150, Value: "fmt.Sprintf(\"%s\", $x)"},
-						{Line: 150, Value: "fmt.Sprintf(\"%v\", $x)"},
+						{Line: 175, Value: "fmt.Sprint($x)"},
+						{Line: 175, Value: "fmt.Sprintf(\"%s\", $x)"},
+						{Line: 175, Value: "fmt.Sprintf(\"%v\", $x)"},
 					},
 					ReportTemplate:  "$$ => $x.Error()",
 					SuggestTemplate: "$x.Error()",
 					WhereExpr: ir.FilterExpr{
-						Line:  151,
+						Line:  176,
 						Op:    ir.FilterVarTypeImplementsOp,
 						Src:   "m[\"x\"].Type.Implements(`error`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 151, Op: ir.FilterStringOp, Src: "`error`", Value: "error"}},
+						Args:  []ir.FilterExpr{{Line: 176, Op: ir.FilterStringOp, Src: "`error`", Value: "error"}},
 					},
 				},
 				{
-					Line: 154,
+					Line: 179,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 154, Value: "fmt.Sprint($x)"},
-						{Line: 154, Value: "fmt.Sprintf(\"%s\", $x)"},
-						{Line: 154, Value: "fmt.Sprintf(\"%v\", $x)"},
+						{Line: 179, Value: "fmt.Sprint($x)"},
+						{Line: 179, Value: "fmt.Sprintf(\"%s\", $x)"},
+						{Line: 179, Value: "fmt.Sprintf(\"%v\", $x)"},
 					},
 					ReportTemplate:  "$$ => $x",
 					SuggestTemplate: "$x",
 					WhereExpr: ir.FilterExpr{
-						Line:  155,
+						Line:  180,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`string`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 155, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+						Args:  []ir.FilterExpr{{Line: 180, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+					},
+				},
+				{
+					Line:            185,
+					SyntaxPatterns:  []ir.PatternString{{Line: 185, Value: "fmt.Sprintf(\"%s\", $x)"}},
+					ReportTemplate:  "$$ => string($x)",
+					SuggestTemplate: "string($x)",
+					WhereExpr: ir.FilterExpr{
+						Line:  186,
+						Op:    ir.FilterVarTypeUnderlyingIsOp,
+						Src:   "m[\"x\"].Type.Underlying().Is(`[]byte`)",
+						Value: "x",
+						Args:  []ir.FilterExpr{{Line: 186, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 					},
 				},
 				{
-					Line: 158,
+					Line: 189,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 158, Value: "fmt.Sprint($x)"},
-						{Line: 158, Value: "fmt.Sprintf(\"%s\", $x)"},
-						{Line: 158, Value: "fmt.Sprintf(\"%v\", $x)"},
+						{Line: 189, Value: "fmt.Sprint($x)"},
+						{Line: 189, Value: "fmt.Sprintf(\"%s\", $x)"},
+						{Line: 189, Value: "fmt.Sprintf(\"%v\", $x)"},
 					},
 					ReportTemplate:  "$$ => string($x)",
 					SuggestTemplate: "string($x)",
 					WhereExpr: ir.FilterExpr{
-						Line: 160,
+						Line: 191,
 						Op:   ir.FilterAndOp,
-						Src:  "m[\"x\"].Type.ConvertibleTo(`string`) &&\n\t!m[\"x\"].Type.OfKind(\"numeric\") &&\n\t!m[\"x\"].Type.Is(`[]rune`)",
+						Src:  "m[\"x\"].Type.ConvertibleTo(`string`) &&\n\t!m[\"x\"].Type.OfKind(\"numeric\") &&\n\t!m[\"x\"].Type.Underlying().Is(`[]byte`) &&\n\t!m[\"x\"].Type.Is(`[]rune`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 160,
+								Line: 191,
 								Op:   ir.FilterAndOp,
-								Src:  "m[\"x\"].Type.ConvertibleTo(`string`) &&\n\t!m[\"x\"].Type.OfKind(\"numeric\")",
+								Src:  "m[\"x\"].Type.ConvertibleTo(`string`) &&\n\t!m[\"x\"].Type.OfKind(\"numeric\") &&\n\t!m[\"x\"].Type.Underlying().Is(`[]byte`)",
 								Args: []ir.FilterExpr{
 									{
-										Line:  160,
-										Op:    ir.FilterVarTypeConvertibleToOp,
-										Src:   "m[\"x\"].Type.ConvertibleTo(`string`)",
-										Value: "x",
-										Args:  []ir.FilterExpr{{Line: 160, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+										Line: 191,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"x\"].Type.ConvertibleTo(`string`) &&\n\t!m[\"x\"].Type.OfKind(\"numeric\")",
+										Args: []ir.FilterExpr{
+											{
+												Line:  191,
+												Op:    ir.FilterVarTypeConvertibleToOp,
+												Src:   "m[\"x\"].Type.ConvertibleTo(`string`)",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 191, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+											{
+												Line: 192,
+												Op:   ir.FilterNotOp,
+												Src:  "!m[\"x\"].Type.OfKind(\"numeric\")",
+												Args: []ir.FilterExpr{{
+													Line:  192,
+													Op:    ir.FilterVarTypeOfKindOp,
+													Src:   "m[\"x\"].Type.OfKind(\"numeric\")",
+													Value: "x",
+													Args:  []ir.FilterExpr{{Line: 192, Op: ir.FilterStringOp, Src: "\"numeric\"", Value: "numeric"}},
+												}},
+											},
+										},
 									},
 									{
-										Line: 161,
+										Line: 193,
 										Op:   ir.FilterNotOp,
-										Src:  "!m[\"x\"].Type.OfKind(\"numeric\")",
+										Src:  "!m[\"x\"].Type.Underlying().Is(`[]byte`)",
 										Args: []ir.FilterExpr{{
-											Line:  161,
-											Op:    ir.FilterVarTypeOfKindOp,
-											Src:   "m[\"x\"].Type.OfKind(\"numeric\")",
+											Line:  193,
+											Op:    ir.FilterVarTypeUnderlyingIsOp,
+											Src:   "m[\"x\"].Type.Underlying().Is(`[]byte`)",
 											Value: "x",
-											Args:  []ir.FilterExpr{{Line: 161, Op: ir.FilterStringOp, Src: "\"numeric\"", Value: "numeric"}},
+											Args:  []ir.FilterExpr{{Line: 193, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 										}},
 									},
 								},
 							},
 							{
-								Line: 162,
+								Line: 194,
 								Op:   ir.FilterNotOp,
 								Src:  "!m[\"x\"].Type.Is(`[]rune`)",
 								Args: []ir.FilterExpr{{
-									Line:  162,
+									Line:  194,
 									Op:    ir.FilterVarTypeIsOp,
 									Src:   "m[\"x\"].Type.Is(`[]rune`)",
 									Value: "x",
-									Args:  []ir.FilterExpr{{Line: 162, Op: ir.FilterStringOp, Src: "`[]rune`", Value: "[]rune"}},
+									Args:  []ir.FilterExpr{{Line: 194, Op: ir.FilterStringOp, Src: "`[]rune`", Value: "[]rune"}},
 								}},
 							},
 						},
@@ -509,7 +612,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        170,
+			Line:        203,
 			Name:        "redundantFprint",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
@@ -518,119 +621,156 @@ var Universal = &ir.File{
 			DocAfter:    "w.WriteString(data.String())",
 			Rules: []ir.Rule{
 				{
-					Line: 171,
+					Line: 204,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 171, Value: "fmt.Fprint($w, $x)"},
-						{Line: 171, Value: "fmt.Fprintf($w, \"%s\", $x)"},
-						{Line: 171, Value: "fmt.Fprintf($w, \"%v\", $x)"},
+						{Line: 204, Value: "fmt.Fprint($w, $x)"},
+						{Line: 204, Value: "fmt.Fprintf($w, \"%s\", $x)"},
+						{Line: 204, Value: "fmt.Fprintf($w, \"%v\", $x)"},
 					},
 					ReportTemplate:  "$$ => $w.WriteString($x.String())",
 					SuggestTemplate: "$w.WriteString($x.String())",
 					WhereExpr: ir.FilterExpr{
-						Line: 172,
+						Line: 205,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Implements(`fmt.Stringer`) && m[\"w\"].Type.Implements(`io.StringWriter`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  172,
+								Line:  205,
 								Op:    ir.FilterVarTypeImplementsOp,
 								Src:   "m[\"x\"].Type.Implements(`fmt.Stringer`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`fmt.Stringer`", Value: "fmt.Stringer"}},
+								Args:  []ir.FilterExpr{{Line: 205, Op: ir.FilterStringOp, Src: "`fmt.Stringer`", Value: "fmt.Stringer"}},
 							},
 							{
-								Line:  172,
+								Line:  205,
 								Op:    ir.FilterVarTypeImplementsOp,
 								Src:   "m[\"w\"].Type.Implements(`io.StringWriter`)",
 								Value: "w",
-								Args:  []ir.FilterExpr{{Line: 172, Op: ir.FilterStringOp, Src: "`io.StringWriter`", Value: "io.StringWriter"}},
+								Args:  []ir.FilterExpr{{Line: 205, Op: ir.FilterStringOp, Src: "`io.StringWriter`", Value: "io.StringWriter"}},
 							},
 						},
 					},
 				},
 				{
-					Line: 175,
+					Line: 208,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 175, Value: "fmt.Fprint($w, $x)"},
-						{Line: 175, Value: "fmt.Fprintf($w, \"%s\", $x)"},
-						{Line: 175, Value: "fmt.Fprintf($w, \"%v\", $x)"},
+						{Line: 208, Value: "fmt.Fprint($w, $x)"},
+						{Line: 208, Value: "fmt.Fprintf($w, \"%s\", $x)"},
+						{Line: 208, Value: "fmt.Fprintf($w, \"%v\", $x)"},
 					},
 					ReportTemplate:  "$$ => $w.WriteString($x.Error())",
 					SuggestTemplate: "$w.WriteString($x.Error())",
 					WhereExpr: ir.FilterExpr{
-						Line: 176,
+						Line: 209,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Implements(`error`) && m[\"w\"].Type.Implements(`io.StringWriter`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  176,
+								Line:  209,
 								Op:    ir.FilterVarTypeImplementsOp,
 								Src:   "m[\"x\"].Type.Implements(`error`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 176, Op: ir.FilterStringOp, Src: "`error`", Value: "error"}},
+								Args:  []ir.FilterExpr{{Line: 209, Op: ir.FilterStringOp, Src: "`error`", Value: "error"}},
 							},
 							{
-								Line:  176,
+								Line:  209,
 								Op:    ir.FilterVarTypeImplementsOp,
 								Src:   "m[\"w\"].Type.Implements(`io.StringWriter`)",
 								Value: "w",
-								Args:  []ir.FilterExpr{{Line: 176, Op: ir.FilterStringOp, Src: "`io.StringWriter`", Value: "io.StringWriter"}},
+								Args:  []ir.FilterExpr{{Line: 209, Op: ir.FilterStringOp, Src: "`io.StringWriter`", Value: "io.StringWriter"}},
 							},
 						},
 					},
 				},
 				{
-					Line: 179,
+					Line: 212,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 179, Value: "fmt.Fprint($w, $x)"},
-						{Line: 179, Value: "fmt.Fprintf($w, \"%s\", $x)"},
-						{Line: 179, Value: "fmt.Fprintf($w, \"%v\", $x)"},
+						{Line: 212, Value: "fmt.Fprint($w, $x)"},
+						{Line: 212, Value: "fmt.Fprintf($w, \"%s\", $x)"},
+						{Line: 212, Value: "fmt.Fprintf($w, \"%v\", $x)"},
 					},
 					ReportTemplate:  "$$ => $w.WriteString($x)",
 					SuggestTemplate: "$w.WriteString($x)",
 					WhereExpr: ir.FilterExpr{
-						Line: 180,
+						Line: 213,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Is(`string`) && m[\"w\"].Type.Implements(`io.StringWriter`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  180,
+								Line:  213,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 180, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 213, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  180,
+								Line:  213,
 								Op:    ir.FilterVarTypeImplementsOp,
 								Src:   "m[\"w\"].Type.Implements(`io.StringWriter`)",
 								Value: "w",
-								Args:  []ir.FilterExpr{{Line: 180, Op: ir.FilterStringOp, Src: "`io.StringWriter`", Value: "io.StringWriter"}},
+								Args:  []ir.FilterExpr{{Line: 213, Op: ir.FilterStringOp, Src: "`io.StringWriter`", Value: "io.StringWriter"}},
 							},
 						},
 					},
 				},
 				{
-					Line: 183,
-					SyntaxPatterns: []ir.PatternString{
-						{Line: 183, Value: "fmt.Fprint($w, $x)"},
-						{Line: 183, Value: "fmt.Fprintf($w, \"%s\", $x)"},
-						{Line: 183, Value: "fmt.Fprintf($w, \"%v\", $x)"},
-					},
+					Line:            217,
+					SyntaxPatterns:  []ir.PatternString{{Line: 217, Value: "fmt.Fprintf($w, \"%s\", $x)"}},
 					ReportTemplate:  "$$ => $w.Write($x)",
 					SuggestTemplate: "$w.Write($x)",
 					WhereExpr: ir.FilterExpr{
-						Line:  184,
-						Op:    ir.FilterVarTypeIsOp,
-						Src:   "m[\"x\"].Type.Is(`[]byte`)",
+						Line:  218,
+						Op:    ir.FilterVarTypeUnderlyingIsOp,
+						Src:   "m[\"x\"].Type.Underlying().Is(`[]byte`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 184, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+						Args:  []ir.FilterExpr{{Line: 218, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 					},
 				},
 			},
 		},
 		{
-			Line:        192,
+			Line:        228,
+			Name:        "nestedSprintfFormat",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score2"},
+			DocSummary:  "Detects fmt format strings that are built with a fmt.Sprintf concat",
+			DocBefore:   "fmt.Fprintf(w, \"id=\"+fmt.Sprintf(\"%d\", id)+\" name=%s\", name)",
+			DocAfter:    "fmt.Fprintf(w, \"id=%d name=%s\", id, name)",
+			DocNote:     "there is no autofix: the verbs and the args of both calls need to be merged",
+			Rules: []ir.Rule{{
+				Line: 239,
+				SyntaxPatterns: []ir.PatternString{
+					{Line: 239, Value: "fmt.Fprintf($_, $format, $*_)"},
+					{Line: 239, Value: "fmt.Printf($format, $*_)"},
+					{Line: 239, Value: "fmt.Sprintf($format, $*_)"},
+				},
+				ReportTemplate: "the format string is built with a fmt.Sprintf concat, merge the inner format into the outer one",
+				WhereExpr: ir.FilterExpr{
+					Line: 240,
+					Op:   ir.FilterAndOp,
+					Src:  "isSprintfConcat(m[\"format\"])",
+					Args: []ir.FilterExpr{
+						{
+							Line:  240,
+							Op:    ir.FilterVarNodeIsOp,
+							Src:   "m[\"format\"].Node.Is(`BinaryExpr`)",
+							Value: "format",
+							Args:  []ir.FilterExpr{{Line: 236, Op: ir.FilterStringOp, Src: "`BinaryExpr`", Value: "BinaryExpr"}},
+						},
+						{
+							Line:  240,
+							Op:    ir.FilterVarContainsOp,
+							Src:   "m[\"format\"].Contains(`fmt.Sprintf($*_)`)",
+							Value: "format",
+							Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "fmt.Sprintf($*_)"}},
+						},
+					},
+				},
+				LocationVar: "format",
+			}},
+		},
+		{
+			Line:        250,
 			Name:        "sliceClone",
 			MatcherName: "m",
 			DocTags:     []string{"o2", "score2"},
@@ -639,40 +779,40 @@ var Universal = &ir.File{
 			DocAfter:    "dst := make([]int, len(src)); copy(dst, src)",
 			Rules: []ir.Rule{
 				{
-					Line:            196,
-					SyntaxPatterns:  []ir.PatternString{{Line: 196, Value: "append([]byte($s), $s2...)"}},
+					Line:            254,
+					SyntaxPatterns:  []ir.PatternString{{Line: 254, Value: "append([]byte($s), $s2...)"}},
 					ReportTemplate:  "$$ => append(append(make([]byte, 0, len($s)+len($s2)), $s...), $s2...)",
 					SuggestTemplate: "append(append(make([]byte, 0, len($s)+len($s2)), $s...), $s2...)",
 					WhereExpr: ir.FilterExpr{
-						Line: 197,
+						Line: 255,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"s\"].Type.Is(`string`) && m[\"s\"].Pure",
 						Args: []ir.FilterExpr{
 							{
-								Line:  197,
+								Line:  255,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 197, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 255, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
-							{Line: 197, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
+							{Line: 255, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
 						},
 					},
 				},
 				{
-					Line: 200,
+					Line: 258,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 200, Value: "$dst = append([]$elem(nil), $src...)"},
-						{Line: 200, Value: "$dst = append([]$elem{}, $src...)"},
+						{Line: 258, Value: "$dst = append([]$elem(nil), $src...)"},
+						{Line: 258, Value: "$dst = append([]$elem{}, $src...)"},
 					},
 					ReportTemplate:  "$$ => $dst = make([]$elem, len($src)); copy($dst, $src)",
 					SuggestTemplate: "$dst = make([]$elem, len($src)); copy($dst, $src)",
 					WhereExpr: ir.FilterExpr{
-						Line: 201,
+						Line: 259,
 						Op:   ir.FilterNotOp,
 						Src:  "!m[\"elem\"].Type.HasPointers()",
 						Args: []ir.FilterExpr{{
-							Line:  201,
+							Line:  259,
 							Op:    ir.FilterVarTypeHasPointersOp,
 							Src:   "m[\"elem\"].Type.HasPointers()",
 							Value: "elem",
@@ -680,19 +820,19 @@ var Universal = &ir.File{
 					},
 				},
 				{
-					Line: 203,
+					Line: 261,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 203, Value: "$dst := append([]$elem(nil), $src...)"},
-						{Line: 203, Value: "$dst := append([]$elem{}, $src...)"},
+						{Line: 261, Value: "$dst := append([]$elem(nil), $src...)"},
+						{Line: 261, Value: "$dst := append([]$elem{}, $src...)"},
 					},
 					ReportTemplate:  "$$ => $dst := make([]$elem, len($src)); copy($dst, $src)",
 					SuggestTemplate: "$dst := make([]$elem, len($src)); copy($dst, $src)",
 					WhereExpr: ir.FilterExpr{
-						Line: 204,
+						Line: 262,
 						Op:   ir.FilterNotOp,
 						Src:  "!m[\"elem\"].Type.HasPointers()",
 						Args: []ir.FilterExpr{{
-							Line:  204,
+							Line:  262,
 							Op:    ir.FilterVarTypeHasPointersOp,
 							Src:   "m[\"elem\"].Type.HasPointers()",
 							Value: "elem",
@@ -702,39 +842,119 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        210,
+			Line:        271,
+			Name:        "makeZeroCap",
+			MatcherName: "m",
+			DocTags:     []string{"o2", "score2"},
+			DocSummary:  "Detects zero capacity slices that are filled by a spread append right away",
+			DocBefore:   "dst := make([]int, 0); dst = append(dst, src...)",
+			DocAfter:    "dst := make([]int, 0, len(src)); dst = append(dst, src...)",
+			Rules: []ir.Rule{
+				{
+					Line:            277,
+					SyntaxPatterns:  []ir.PatternString{{Line: 277, Value: "$s := make([]$t, 0); $s = append($s, $src...)"}},
+					ReportTemplate:  "$$ => $s := make([]$t, 0, len($src)); $s = append($s, $src...)",
+					SuggestTemplate: "$s := make([]$t, 0, len($src)); $s = append($s, $src...)",
+					WhereExpr:       ir.FilterExpr{Line: 278, Op: ir.FilterVarPureOp, Src: "m[\"src\"].Pure", Value: "src"},
+				},
+				{
+					Line:            280,
+					SyntaxPatterns:  []ir.PatternString{{Line: 280, Value: "$s = make([]$t, 0); $s = append($s, $src...)"}},
+					ReportTemplate:  "$$ => $s = make([]$t, 0, len($src)); $s = append($s, $src...)",
+					SuggestTemplate: "$s = make([]$t, 0, len($src)); $s = append($s, $src...)",
+					WhereExpr:       ir.FilterExpr{Line: 281, Op: ir.FilterVarPureOp, Src: "m[\"src\"].Pure", Value: "src"},
+				},
+			},
+		},
+		{
+			Line:        290,
+			Name:        "makeOverwrite",
+			MatcherName: "m",
+			DocTags:     []string{"o2", "score2"},
+			DocSummary:  "Detects byte slices that are zeroed by make and then fully overwritten",
+			DocBefore:   "b := make([]byte, len(s)); copy(b, s)",
+			DocAfter:    "b := []byte(s)",
+			Rules: []ir.Rule{
+				{
+					Line:           296,
+					SyntaxPatterns: []ir.PatternString{{Line: 296, Value: "$b := make([]byte, len($s)); copy($b, $s)"}},
+					ReportTemplate: "$b is zeroed by make and then overwritten by copy, use $b := []byte($s) instead",
+					WhereExpr: ir.FilterExpr{
+						Line:  297,
+						Op:    ir.FilterVarTypeUnderlyingIsOp,
+						Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
+						Value: "s",
+						Args:  []ir.FilterExpr{{Line: 297, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+					},
+				},
+				{
+					Line: 302,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 303, Value: "$b := make([]byte, $_); $_, $_ := io.ReadFull($_, $b)"},
+						{Line: 304, Value: "$b := make([]byte, $_); $_, $_ = io.ReadFull($_, $b)"},
+						{Line: 305, Value: "$b := make([]byte, $_); if _, $_ := io.ReadFull($_, $b); $_ { $*_ }"},
+					},
+					ReportTemplate: "$b is zeroed by make and then overwritten by io.ReadFull, consider re-using the buffer",
+				},
+			},
+		},
+		{
+			Line:        316,
+			Name:        "readAllReader",
+			MatcherName: "m",
+			DocTags:     []string{"o2", "score2"},
+			DocSummary:  "Detects io.ReadAll results that are wrapped into bytes.NewReader right away",
+			DocBefore:   "data, err := io.ReadAll(r); if err != nil { return err }; return decode(bytes.NewReader(data))",
+			DocAfter:    "return decode(r)",
+			DocNote:     "there is no autofix: io.ReadAll reports the read errors before the data is consumed",
+			Rules: []ir.Rule{{
+				Line: 326,
+				SyntaxPatterns: []ir.PatternString{
+					{Line: 327, Value: "$b, $err := io.ReadAll($r); if $err != nil { $*_ }; $x := bytes.NewReader($b)"},
+					{Line: 328, Value: "$b, $err := io.ReadAll($r); if $err != nil { $*_ }; $x = bytes.NewReader($b)"},
+					{Line: 329, Value: "$b, $err = io.ReadAll($r); if $err != nil { $*_ }; $x := bytes.NewReader($b)"},
+					{Line: 330, Value: "$b, $err = io.ReadAll($r); if $err != nil { $*_ }; $x = bytes.NewReader($b)"},
+					{Line: 331, Value: "$b, $_ := io.ReadAll($r); $x := bytes.NewReader($b)"},
+					{Line: 332, Value: "$b, $_ := io.ReadAll($r); $x = bytes.NewReader($b)"},
+				},
+				ReportTemplate: "$b is read from $r only to be wrapped into bytes.NewReader, consider passing $r directly",
+				WhereExpr:      ir.FilterExpr{Line: 334, Op: ir.FilterVarPureOp, Src: "m[\"r\"].Pure", Value: "r"},
+			}},
+		},
+		{
+			Line:        341,
 			Name:        "stringsJoinConcat",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
 			DocSummary:  "Detect strings.Join usages that can be rewritten as a string concat",
 			Rules: []ir.Rule{
 				{
-					Line:            211,
-					SyntaxPatterns:  []ir.PatternString{{Line: 211, Value: "strings.Join([]string{$x, $y}, \"\")"}},
+					Line:            342,
+					SyntaxPatterns:  []ir.PatternString{{Line: 342, Value: "strings.Join([]string{$x, $y}, \"\")"}},
 					ReportTemplate:  "$$ => $x + $y",
 					SuggestTemplate: "$x + $y",
 					WhereExpr: ir.FilterExpr{
-						Line: 212,
+						Line: 343,
 						Op:   ir.FilterAndOp,
 						Src:  "!m[\"x\"].Const && !m[\"y\"].Const",
 						Args: []ir.FilterExpr{
 							{
-								Line: 212,
+								Line: 343,
 								Op:   ir.FilterNotOp,
 								Src:  "!m[\"x\"].Const",
 								Args: []ir.FilterExpr{{
-									Line:  212,
+									Line:  343,
 									Op:    ir.FilterVarConstOp,
 									Src:   "m[\"x\"].Const",
 									Value: "x",
 								}},
 							},
 							{
-								Line: 212,
+								Line: 343,
 								Op:   ir.FilterNotOp,
 								Src:  "!m[\"y\"].Const",
 								Args: []ir.FilterExpr{{
-									Line:  212,
+									Line:  343,
 									Op:    ir.FilterVarConstOp,
 									Src:   "m[\"y\"].Const",
 									Value: "y",
@@ -744,37 +964,37 @@ var Universal = &ir.File{
 					},
 				},
 				{
-					Line:            214,
-					SyntaxPatterns:  []ir.PatternString{{Line: 214, Value: "strings.Join([]string{$x, $y, $z}, \"\")"}},
+					Line:            345,
+					SyntaxPatterns:  []ir.PatternString{{Line: 345, Value: "strings.Join([]string{$x, $y, $z}, \"\")"}},
 					ReportTemplate:  "$$ => $x + $y + $z",
 					SuggestTemplate: "$x + $y + $z",
 					WhereExpr: ir.FilterExpr{
-						Line: 215,
+						Line: 346,
 						Op:   ir.FilterAndOp,
 						Src:  "!m[\"x\"].Const && !m[\"y\"].Const && !m[\"z\"].Const",
 						Args: []ir.FilterExpr{
 							{
-								Line: 215,
+								Line: 346,
 								Op:   ir.FilterAndOp,
 								Src:  "!m[\"x\"].Const && !m[\"y\"].Const",
 								Args: []ir.FilterExpr{
 									{
-										Line: 215,
+										Line: 346,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"x\"].Const",
 										Args: []ir.FilterExpr{{
-											Line:  215,
+											Line:  346,
 											Op:    ir.FilterVarConstOp,
 											Src:   "m[\"x\"].Const",
 											Value: "x",
 										}},
 									},
 									{
-										Line: 215,
+										Line: 346,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Const",
 										Args: []ir.FilterExpr{{
-											Line:  215,
+											Line:  346,
 											Op:    ir.FilterVarConstOp,
 											Src:   "m[\"y\"].Const",
 											Value: "y",
@@ -783,11 +1003,11 @@ var Universal = &ir.File{
 								},
 							},
 							{
-								Line: 215,
+								Line: 346,
 								Op:   ir.FilterNotOp,
 								Src:  "!m[\"z\"].Const",
 								Args: []ir.FilterExpr{{
-									Line:  215,
+									Line:  346,
 									Op:    ir.FilterVarConstOp,
 									Src:   "m[\"z\"].Const",
 									Value: "z",
@@ -797,32 +1017,32 @@ var Universal = &ir.File{
 					},
 				},
 				{
-					Line:            218,
-					SyntaxPatterns:  []ir.PatternString{{Line: 218, Value: "strings.Join([]string{$x, $y}, $glue)"}},
+					Line:            349,
+					SyntaxPatterns:  []ir.PatternString{{Line: 349, Value: "strings.Join([]string{$x, $y}, $glue)"}},
 					ReportTemplate:  "$$ => $x + $glue + $y",
 					SuggestTemplate: "$x + $glue + $y",
 					WhereExpr: ir.FilterExpr{
-						Line: 219,
+						Line: 350,
 						Op:   ir.FilterAndOp,
 						Src:  "!m[\"x\"].Const && !m[\"y\"].Const",
 						Args: []ir.FilterExpr{
 							{
-								Line: 219,
+								Line: 350,
 								Op:   ir.FilterNotOp,
 								Src:  "!m[\"x\"].Const",
 								Args: []ir.FilterExpr{{
-									Line:  219,
+									Line:  350,
 									Op:    ir.FilterVarConstOp,
 									Src:   "m[\"x\"].Const",
 									Value: "x",
 								}},
 							},
 							{
-								Line: 219,
+								Line: 350,
 								Op:   ir.FilterNotOp,
 								Src:  "!m[\"y\"].Const",
 								Args: []ir.FilterExpr{{
-									Line:  219,
+									Line:  350,
 									Op:    ir.FilterVarConstOp,
 									Src:   "m[\"y\"].Const",
 									Value: "y",
@@ -832,37 +1052,37 @@ var Universal = &ir.File{
 					},
 				},
 				{
-					Line:            222,
-					SyntaxPatterns:  []ir.PatternString{{Line: 222, Value: "strings.Join([]string{$x, $y, $z}, $glue)"}},
+					Line:            353,
+					SyntaxPatterns:  []ir.PatternString{{Line: 353, Value: "strings.Join([]string{$x, $y, $z}, $glue)"}},
 					ReportTemplate:  "$$ => $x + $glue + $y + $glue + $z",
 					SuggestTemplate: "$x + $glue + $y + $glue + $z",
 					WhereExpr: ir.FilterExpr{
-						Line: 223,
+						Line: 354,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"glue\"].Const && !m[\"x\"].Const && !m[\"y\"].Const && !m[\"z\"].Const",
 						Args: []ir.FilterExpr{
 							{
-								Line: 223,
+								Line: 354,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"glue\"].Const && !m[\"x\"].Const && !m[\"y\"].Const",
 								Args: []ir.FilterExpr{
 									{
-										Line: 223,
+										Line: 354,
 										Op:   ir.FilterAndOp,
 										Src:  "m[\"glue\"].Const && !m[\"x\"].Const",
 										Args: []ir.FilterExpr{
 											{
-												Line:  223,
+												Line:  354,
 												Op:    ir.FilterVarConstOp,
 												Src:   "m[\"glue\"].Const",
 												Value: "glue",
 											},
 											{
-												Line: 223,
+												Line: 354,
 												Op:   ir.FilterNotOp,
 												Src:  "!m[\"x\"].Const",
 												Args: []ir.FilterExpr{{
-													Line:  223,
+													Line:  354,
 													Op:    ir.FilterVarConstOp,
 													Src:   "m[\"x\"].Const",
 													Value: "x",
@@ -871,11 +1091,11 @@ var Universal = &ir.File{
 										},
 									},
 									{
-										Line: 223,
+										Line: 354,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Const",
 										Args: []ir.FilterExpr{{
-											Line:  223,
+											Line:  354,
 											Op:    ir.FilterVarConstOp,
 											Src:   "m[\"y\"].Const",
 											Value: "y",
@@ -884,11 +1104,11 @@ var Universal = &ir.File{
 								},
 							},
 							{
-								Line: 223,
+								Line: 354,
 								Op:   ir.FilterNotOp,
 								Src:  "!m[\"z\"].Const",
 								Args: []ir.FilterExpr{{
-									Line:  223,
+									Line:  354,
 									Op:    ir.FilterVarConstOp,
 									Src:   "m[\"z\"].Const",
 									Value: "z",
@@ -900,7 +1120,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        231,
+			Line:        363,
 			Name:        "sprintfConcat",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
@@ -909,55 +1129,82 @@ var Universal = &ir.File{
 			DocAfter:    "x + y",
 			Rules: []ir.Rule{
 				{
-					Line:            232,
-					SyntaxPatterns:  []ir.PatternString{{Line: 232, Value: "fmt.Sprintf(\"%s%s\", $x, $y)"}},
+					Line:            364,
+					SyntaxPatterns:  []ir.PatternString{{Line: 364, Value: "fmt.Sprintf(\"%s%s\", $x, $y)"}},
 					ReportTemplate:  "$$ => $x + $y",
 					SuggestTemplate: "$x + $y",
 					WhereExpr: ir.FilterExpr{
-						Line: 233,
+						Line: 365,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Is(`string`) && m[\"y\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  233,
+								Line:  365,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 233, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 365, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  233,
+								Line:  365,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"y\"].Type.Is(`string`)",
 								Value: "y",
-								Args:  []ir.FilterExpr{{Line: 233, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 365, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            236,
-					SyntaxPatterns:  []ir.PatternString{{Line: 236, Value: "fmt.Sprintf(\"%s%s\", $x, $y)"}},
+					Line:            368,
+					SyntaxPatterns:  []ir.PatternString{{Line: 368, Value: "fmt.Sprintf(\"%s%s\", $x, $y)"}},
 					ReportTemplate:  "$$ => $x.String() + $y.String()",
 					SuggestTemplate: "$x.String() + $y.String()",
 					WhereExpr: ir.FilterExpr{
-						Line: 237,
+						Line: 369,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Implements(`fmt.Stringer`) && m[\"y\"].Type.Implements(`fmt.Stringer`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  237,
+								Line:  369,
 								Op:    ir.FilterVarTypeImplementsOp,
 								Src:   "m[\"x\"].Type.Implements(`fmt.Stringer`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 237, Op: ir.FilterStringOp, Src: "`fmt.Stringer`", Value: "fmt.Stringer"}},
+								Args:  []ir.FilterExpr{{Line: 369, Op: ir.FilterStringOp, Src: "`fmt.Stringer`", Value: "fmt.Stringer"}},
 							},
 							{
-								Line:  237,
+								Line:  369,
 								Op:    ir.FilterVarTypeImplementsOp,
 								Src:   "m[\"y\"].Type.Implements(`fmt.Stringer`)",
 								Value: "y",
-								Args:  []ir.FilterExpr{{Line: 237, Op: ir.FilterStringOp, Src: "`fmt.Stringer`", Value: "fmt.Stringer"}},
+								Args:  []ir.FilterExpr{{Line: 369, Op: ir.FilterStringOp, Src: "`fmt.Stringer`", Value: "fmt.Stringer"}},
+							},
+						},
+					},
+				},
+				{
+					Line:            374,
+					SyntaxPatterns:  []ir.PatternString{{Line: 374, Value: "fmt.Sprint($x, $y)"}},
+					ReportTemplate:  "$$ => $x + $y",
+					SuggestTemplate: "$x + $y",
+					WhereExpr: ir.FilterExpr{
+						Line: 375,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"x\"].Type.Is(`string`) && m[\"y\"].Type.Is(`string`)",
+						Args: []ir.FilterExpr{
+							{
+								Line:  375,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"x\"].Type.Is(`string`)",
+								Value: "x",
+								Args:  []ir.FilterExpr{{Line: 375, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+							},
+							{
+								Line:  375,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"y\"].Type.Is(`string`)",
+								Value: "y",
+								Args:  []ir.FilterExpr{{Line: 375, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
@@ -965,7 +1212,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        245,
+			Line:        384,
 			Name:        "sprintfError",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
@@ -973,14 +1220,14 @@ var Universal = &ir.File{
 			DocBefore:   "errors.New(fmt.Sprintf(\"%s:%d\", file, line))",
 			DocAfter:    "fmt.Errorf(\"%s:%d\", file, line)",
 			Rules: []ir.Rule{{
-				Line:            246,
-				SyntaxPatterns:  []ir.PatternString{{Line: 246, Value: "errors.New(fmt.Sprintf($format, $*args))"}},
+				Line:            385,
+				SyntaxPatterns:  []ir.PatternString{{Line: 385, Value: "errors.New(fmt.Sprintf($format, $*args))"}},
 				ReportTemplate:  "$$ => fmt.Errorf($format, $args)",
 				SuggestTemplate: "fmt.Errorf($format, $args)",
 			}},
 		},
 		{
-			Line:        254,
+			Line:        394,
 			Name:        "strconv",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
@@ -989,302 +1236,507 @@ var Universal = &ir.File{
 			DocAfter:    "strconv.Itoa(i)",
 			Rules: []ir.Rule{
 				{
-					Line: 258,
+					Line: 398,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 258, Value: "fmt.Sprintf(\"%d\", $x)"},
-						{Line: 258, Value: "fmt.Sprintf(\"%v\", $x)"},
-						{Line: 258, Value: "fmt.Sprint($x)"},
+						{Line: 398, Value: "fmt.Sprintf(\"%d\", $x)"},
+						{Line: 398, Value: "fmt.Sprintf(\"%v\", $x)"},
+						{Line: 398, Value: "fmt.Sprint($x)"},
 					},
 					ReportTemplate:  "$$ => strconv.Itoa($x)",
 					SuggestTemplate: "strconv.Itoa($x)",
 					WhereExpr: ir.FilterExpr{
-						Line:  259,
+						Line:  399,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`int`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 259, Op: ir.FilterStringOp, Src: "`int`", Value: "int"}},
+						Args:  []ir.FilterExpr{{Line: 399, Op: ir.FilterStringOp, Src: "`int`", Value: "int"}},
 					},
 				},
 				{
-					Line: 263,
+					Line: 403,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 263, Value: "fmt.Sprintf(\"%d\", $x)"},
-						{Line: 263, Value: "fmt.Sprintf(\"%v\", $x)"},
-						{Line: 263, Value: "fmt.Sprint($x)"},
+						{Line: 403, Value: "fmt.Sprintf(\"%d\", $x)"},
+						{Line: 403, Value: "fmt.Sprintf(\"%v\", $x)"},
+						{Line: 403, Value: "fmt.Sprint($x)"},
 					},
 					ReportTemplate:  "$$ => strconv.FormatInt($x, 10)",
 					SuggestTemplate: "strconv.FormatInt($x, 10)",
 					WhereExpr: ir.FilterExpr{
-						Line:  264,
+						Line:  404,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`int64`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 264, Op: ir.FilterStringOp, Src: "`int64`", Value: "int64"}},
+						Args:  []ir.FilterExpr{{Line: 404, Op: ir.FilterStringOp, Src: "`int64`", Value: "int64"}},
 					},
 				},
 				{
-					Line:            265,
-					SyntaxPatterns:  []ir.PatternString{{Line: 265, Value: "fmt.Sprintf(\"%x\", $x)"}},
+					Line:            405,
+					SyntaxPatterns:  []ir.PatternString{{Line: 405, Value: "fmt.Sprintf(\"%x\", $x)"}},
 					ReportTemplate:  "$$ => strconv.FormatInt($x, 16)",
 					SuggestTemplate: "strconv.FormatInt($x, 16)",
 					WhereExpr: ir.FilterExpr{
-						Line:  266,
+						Line:  406,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`int64`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 266, Op: ir.FilterStringOp, Src: "`int64`", Value: "int64"}},
+						Args:  []ir.FilterExpr{{Line: 406, Op: ir.FilterStringOp, Src: "`int64`", Value: "int64"}},
 					},
 				},
 				{
-					Line: 267,
+					Line: 407,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 267, Value: "fmt.Sprintf(\"%d\", $x)"},
-						{Line: 267, Value: "fmt.Sprintf(\"%v\", $x)"},
-						{Line: 267, Value: "fmt.Sprint($x)"},
+						{Line: 407, Value: "fmt.Sprintf(\"%d\", $x)"},
+						{Line: 407, Value: "fmt.Sprintf(\"%v\", $x)"},
+						{Line: 407, Value: "fmt.Sprint($x)"},
 					},
 					ReportTemplate:  "$$ => strconv.FormatUint($x, 10)",
 					SuggestTemplate: "strconv.FormatUint($x, 10)",
 					WhereExpr: ir.FilterExpr{
-						Line:  268,
+						Line:  408,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`uint64`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 268, Op: ir.FilterStringOp, Src: "`uint64`", Value: "uint64"}},
+						Args:  []ir.FilterExpr{{Line: 408, Op: ir.FilterStringOp, Src: "`uint64`", Value: "uint64"}},
 					},
 				},
 				{
-					Line:            269,
-					SyntaxPatterns:  []ir.PatternString{{Line: 269, Value: "fmt.Sprintf(\"%x\", $x)"}},
+					Line:            409,
+					SyntaxPatterns:  []ir.PatternString{{Line: 409, Value: "fmt.Sprintf(\"%x\", $x)"}},
 					ReportTemplate:  "$$ => strconv.FormatUint($x, 16)",
 					SuggestTemplate: "strconv.FormatUint($x, 16)",
 					WhereExpr: ir.FilterExpr{
-						Line:  270,
+						Line:  410,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`uint64`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 270, Op: ir.FilterStringOp, Src: "`uint64`", Value: "uint64"}},
+						Args:  []ir.FilterExpr{{Line: 410, Op: ir.FilterStringOp, Src: "`uint64`", Value: "uint64"}},
 					},
 				},
 				{
-					Line: 272,
+					Line: 412,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 272, Value: "fmt.Sprintf(\"%d\", $x)"},
-						{Line: 272, Value: "fmt.Sprintf(\"%v\", $x)"},
-						{Line: 272, Value: "fmt.Sprint($x)"},
+						{Line: 412, Value: "fmt.Sprintf(\"%d\", $x)"},
+						{Line: 412, Value: "fmt.Sprintf(\"%v\", $x)"},
+						{Line: 412, Value: "fmt.Sprint($x)"},
 					},
 					ReportTemplate:  "$$ => strconv.FormatInt(int64($x), 10)",
 					SuggestTemplate: "strconv.FormatInt(int64($x), 10)",
 					WhereExpr: ir.FilterExpr{
-						Line:  273,
+						Line:  413,
 						Op:    ir.FilterVarTypeOfKindOp,
 						Src:   "m[\"x\"].Type.OfKind(`int`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 273, Op: ir.FilterStringOp, Src: "`int`", Value: "int"}},
+						Args:  []ir.FilterExpr{{Line: 413, Op: ir.FilterStringOp, Src: "`int`", Value: "int"}},
 					},
 				},
 				{
-					Line:            274,
-					SyntaxPatterns:  []ir.PatternString{{Line: 274, Value: "fmt.Sprintf(\"%x\", $x)"}},
+					Line:            414,
+					SyntaxPatterns:  []ir.PatternString{{Line: 414, Value: "fmt.Sprintf(\"%x\", $x)"}},
 					ReportTemplate:  "$$ => strconv.FormatInt(int64($x), 16)",
 					SuggestTemplate: "strconv.FormatInt(int64($x), 16)",
 					WhereExpr: ir.FilterExpr{
-						Line:  275,
+						Line:  415,
 						Op:    ir.FilterVarTypeOfKindOp,
 						Src:   "m[\"x\"].Type.OfKind(`int`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 275, Op: ir.FilterStringOp, Src: "`int`", Value: "int"}},
+						Args:  []ir.FilterExpr{{Line: 415, Op: ir.FilterStringOp, Src: "`int`", Value: "int"}},
 					},
 				},
 				{
-					Line: 277,
+					Line: 417,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 277, Value: "fmt.Sprintf(\"%d\", $x)"},
-						{Line: 277, Value: "fmt.Sprintf(\"%v\", $x)"},
-						{Line: 277, Value: "fmt.Sprint($x)"},
+						{Line: 417, Value: "fmt.Sprintf(\"%d\", $x)"},
+						{Line: 417, Value: "fmt.Sprintf(\"%v\", $x)"},
+						{Line: 417, Value: "fmt.Sprint($x)"},
 					},
 					ReportTemplate:  "$$ => strconv.FormatUint(uint64($x), 10)",
 					SuggestTemplate: "strconv.FormatUint(uint64($x), 10)",
 					WhereExpr: ir.FilterExpr{
-						Line:  278,
+						Line:  418,
 						Op:    ir.FilterVarTypeOfKindOp,
 						Src:   "m[\"x\"].Type.OfKind(`uint`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 278, Op: ir.FilterStringOp, Src: "`uint`", Value: "uint"}},
+						Args:  []ir.FilterExpr{{Line: 418, Op: ir.FilterStringOp, Src: "`uint`", Value: "uint"}},
 					},
 				},
 				{
-					Line:            279,
-					SyntaxPatterns:  []ir.PatternString{{Line: 279, Value: "fmt.Sprintf(\"%x\", $x)"}},
+					Line:            419,
+					SyntaxPatterns:  []ir.PatternString{{Line: 419, Value: "fmt.Sprintf(\"%x\", $x)"}},
 					ReportTemplate:  "$$ => strconv.FormatUint(uint64($x), 16)",
 					SuggestTemplate: "strconv.FormatUint(uint64($x), 16)",
 					WhereExpr: ir.FilterExpr{
-						Line:  280,
+						Line:  420,
 						Op:    ir.FilterVarTypeOfKindOp,
 						Src:   "m[\"x\"].Type.OfKind(`uint`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 280, Op: ir.FilterStringOp, Src: "`uint`", Value: "uint"}},
+						Args:  []ir.FilterExpr{{Line: 420, Op: ir.FilterStringOp, Src: "`uint`", Value: "uint"}},
 					},
 				},
 			},
 		},
 		{
-			Line:        287,
-			Name:        "appendAPI",
+			Line:        429,
+			Name:        "formatIntToItoa",
 			MatcherName: "m",
-			DocTags:     []string{"o1", "score4"},
-			DocSummary:  "Detects cases that can benefit from append-friendly APIs",
-			DocBefore:   "b = append(b, strconv.Itoa(v)...)",
-			DocAfter:    "b = strconv.AppendInt(b, v, 10)",
-			Rules: []ir.Rule{
-				{
-					Line:            295,
-					SyntaxPatterns:  []ir.PatternString{{Line: 295, Value: "$b = append($b, strconv.Itoa($x)...)"}},
-					ReportTemplate:  "$$ => $b = strconv.AppendInt($b, int64($x), 10)",
-					SuggestTemplate: "$b = strconv.AppendInt($b, int64($x), 10)",
-				},
-				{
-					Line:            297,
-					SyntaxPatterns:  []ir.PatternString{{Line: 297, Value: "$b = append($b, strconv.FormatInt($x, $base)...)"}},
-					ReportTemplate:  "$$ => $b = strconv.AppendInt($b, $x, $base)",
-					SuggestTemplate: "$b = strconv.AppendInt($b, $x, $base)",
-				},
-				{
-					Line:            299,
-					SyntaxPatterns:  []ir.PatternString{{Line: 299, Value: "$b = append($b, strconv.FormatUint($x, $base)...)"}},
-					ReportTemplate:  "$$ => $b = strconv.AppendUint($b, $x, $base)",
-					SuggestTemplate: "$b = strconv.AppendUint($b, $x, $base)",
+			DocTags:     []string{"o1", "score1"},
+			DocSummary:  "Detects strconv.FormatInt calls that can be strconv.Itoa",
+			DocBefore:   "strconv.FormatInt(int64(x), 10)",
+			DocAfter:    "strconv.Itoa(x)",
+			DocNote:     "only the int typed values are matched, Itoa doesn't accept other integer types",
+			Rules: []ir.Rule{{
+				Line:            434,
+				SyntaxPatterns:  []ir.PatternString{{Line: 434, Value: "strconv.FormatInt(int64($x), 10)"}},
+				ReportTemplate:  "$$ => strconv.Itoa($x)",
+				SuggestTemplate: "strconv.Itoa($x)",
+				WhereExpr: ir.FilterExpr{
+					Line:  435,
+					Op:    ir.FilterVarTypeIsOp,
+					Src:   "m[\"x\"].Type.Is(`int`)",
+					Value: "x",
+					Args:  []ir.FilterExpr{{Line: 435, Op: ir.FilterStringOp, Src: "`int`", Value: "int"}},
 				},
+			}},
+		},
+		{
+			Line:        444,
+			Name:        "appendConcat",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score2"},
+			DocSummary:  "Detects string concatenations that are appended to a byte slice",
+			DocBefore:   "b = append(b, \"key=\"+s...)",
+			DocAfter:    "b = append(append(b, \"key=\"...), s...)",
+			Rules: []ir.Rule{
 				{
-					Line:            302,
-					SyntaxPatterns:  []ir.PatternString{{Line: 302, Value: "$b = append($b, $t.Format($layout)...)"}},
-					ReportTemplate:  "$$ => $b = $t.AppendFormat($b, $layout)",
-					SuggestTemplate: "$b = $t.AppendFormat($b, $layout)",
+					Line:            453,
+					SyntaxPatterns:  []ir.PatternString{{Line: 453, Value: "append($b, $x + $y + $z...)"}},
+					ReportTemplate:  "$$ => append(append(append($b, $x...), $y...), $z...)",
+					SuggestTemplate: "append(append(append($b, $x...), $y...), $z...)",
 					WhereExpr: ir.FilterExpr{
-						Line: 303,
-						Op:   ir.FilterOrOp,
-						Src:  "m[\"t\"].Type.Is(`time.Time`) || m[\"t\"].Type.Is(`*time.Time`)",
+						Line: 454,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"b\"].Type.Is(`[]byte`) && isString(m[\"x\"]) && isString(m[\"y\"]) && isString(m[\"z\"]) &&\n\t!(m[\"x\"].Const && m[\"y\"].Const && m[\"z\"].Const)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  303,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"t\"].Type.Is(`time.Time`)",
-								Value: "t",
-								Args:  []ir.FilterExpr{{Line: 303, Op: ir.FilterStringOp, Src: "`time.Time`", Value: "time.Time"}},
+								Line: 454,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"b\"].Type.Is(`[]byte`) && isString(m[\"x\"]) && isString(m[\"y\"]) && isString(m[\"z\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line: 454,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"b\"].Type.Is(`[]byte`) && isString(m[\"x\"]) && isString(m[\"y\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line: 454,
+												Op:   ir.FilterAndOp,
+												Src:  "m[\"b\"].Type.Is(`[]byte`) && isString(m[\"x\"])",
+												Args: []ir.FilterExpr{
+													{
+														Line:  454,
+														Op:    ir.FilterVarTypeIsOp,
+														Src:   "m[\"b\"].Type.Is(`[]byte`)",
+														Value: "b",
+														Args:  []ir.FilterExpr{{Line: 454, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+													},
+													{
+														Line:  454,
+														Op:    ir.FilterVarTypeUnderlyingIsOp,
+														Src:   "isString(m[\"x\"])",
+														Value: "x",
+														Args:  []ir.FilterExpr{{Line: 450, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+													},
+												},
+											},
+											{
+												Line:  454,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "isString(m[\"y\"])",
+												Value: "y",
+												Args:  []ir.FilterExpr{{Line: 450, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+										},
+									},
+									{
+										Line:  454,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "isString(m[\"z\"])",
+										Value: "z",
+										Args:  []ir.FilterExpr{{Line: 450, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+									},
+								},
 							},
 							{
-								Line:  303,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"t\"].Type.Is(`*time.Time`)",
-								Value: "t",
-								Args:  []ir.FilterExpr{{Line: 303, Op: ir.FilterStringOp, Src: "`*time.Time`", Value: "*time.Time"}},
+								Line: 455,
+								Op:   ir.FilterNotOp,
+								Src:  "!(m[\"x\"].Const && m[\"y\"].Const && m[\"z\"].Const)",
+								Args: []ir.FilterExpr{{
+									Line: 455,
+									Op:   ir.FilterAndOp,
+									Src:  "(m[\"x\"].Const && m[\"y\"].Const && m[\"z\"].Const)",
+									Args: []ir.FilterExpr{
+										{
+											Line: 455,
+											Op:   ir.FilterAndOp,
+											Src:  "m[\"x\"].Const && m[\"y\"].Const",
+											Args: []ir.FilterExpr{
+												{
+													Line:  455,
+													Op:    ir.FilterVarConstOp,
+													Src:   "m[\"x\"].Const",
+													Value: "x",
+												},
+												{
+													Line:  455,
+													Op:    ir.FilterVarConstOp,
+													Src:   "m[\"y\"].Const",
+													Value: "y",
+												},
+											},
+										},
+										{
+											Line:  455,
+											Op:    ir.FilterVarConstOp,
+											Src:   "m[\"z\"].Const",
+											Value: "z",
+										},
+									},
+								}},
 							},
 						},
 					},
 				},
 				{
-					Line:            306,
-					SyntaxPatterns:  []ir.PatternString{{Line: 306, Value: "$b = append($b, $v.String()...)"}},
-					ReportTemplate:  "$$ => $b = $v.Append($b, 'g', 10)",
-					SuggestTemplate: "$b = $v.Append($b, 'g', 10)",
+					Line:            457,
+					SyntaxPatterns:  []ir.PatternString{{Line: 457, Value: "append($b, $x + $y...)"}},
+					ReportTemplate:  "$$ => append(append($b, $x...), $y...)",
+					SuggestTemplate: "append(append($b, $x...), $y...)",
 					WhereExpr: ir.FilterExpr{
-						Line: 307,
-						Op:   ir.FilterOrOp,
-						Src:  "m[\"v\"].Type.Is(`big.Float`) || m[\"v\"].Type.Is(`*big.Float`)",
+						Line: 458,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"b\"].Type.Is(`[]byte`) && isString(m[\"x\"]) && isString(m[\"y\"]) &&\n\t!(m[\"x\"].Const && m[\"y\"].Const)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  307,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"v\"].Type.Is(`big.Float`)",
-								Value: "v",
-								Args:  []ir.FilterExpr{{Line: 307, Op: ir.FilterStringOp, Src: "`big.Float`", Value: "big.Float"}},
-							},
-							{
-								Line:  307,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"v\"].Type.Is(`*big.Float`)",
-								Value: "v",
-								Args:  []ir.FilterExpr{{Line: 307, Op: ir.FilterStringOp, Src: "`*big.Float`", Value: "*big.Float"}},
-							},
+								Line: 458,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"b\"].Type.Is(`[]byte`) && isString(m[\"x\"]) && isString(m[\"y\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line: 458,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"b\"].Type.Is(`[]byte`) && isString(m[\"x\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  458,
+												Op:    ir.FilterVarTypeIsOp,
+												Src:   "m[\"b\"].Type.Is(`[]byte`)",
+												Value: "b",
+												Args:  []ir.FilterExpr{{Line: 458, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+											},
+											{
+												Line:  458,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "isString(m[\"x\"])",
+												Value: "x",
+												Args:  []ir.FilterExpr{{Line: 450, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+											},
+										},
+									},
+									{
+										Line:  458,
+										Op:    ir.FilterVarTypeUnderlyingIsOp,
+										Src:   "isString(m[\"y\"])",
+										Value: "y",
+										Args:  []ir.FilterExpr{{Line: 450, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+									},
+								},
+							},
+							{
+								Line: 459,
+								Op:   ir.FilterNotOp,
+								Src:  "!(m[\"x\"].Const && m[\"y\"].Const)",
+								Args: []ir.FilterExpr{{
+									Line: 459,
+									Op:   ir.FilterAndOp,
+									Src:  "(m[\"x\"].Const && m[\"y\"].Const)",
+									Args: []ir.FilterExpr{
+										{
+											Line:  459,
+											Op:    ir.FilterVarConstOp,
+											Src:   "m[\"x\"].Const",
+											Value: "x",
+										},
+										{
+											Line:  459,
+											Op:    ir.FilterVarConstOp,
+											Src:   "m[\"y\"].Const",
+											Value: "y",
+										},
+									},
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        468,
+			Name:        "appendAPI",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score4"},
+			DocSummary:  "Detects cases that can benefit from append-friendly APIs",
+			DocBefore:   "b = append(b, strconv.Itoa(v)...)",
+			DocAfter:    "b = strconv.AppendInt(b, v, 10)",
+			Rules: []ir.Rule{
+				{
+					Line:            476,
+					SyntaxPatterns:  []ir.PatternString{{Line: 476, Value: "$b = append($b, strconv.Itoa($x)...)"}},
+					ReportTemplate:  "$$ => $b = strconv.AppendInt($b, int64($x), 10)",
+					SuggestTemplate: "$b = strconv.AppendInt($b, int64($x), 10)",
+				},
+				{
+					Line:            478,
+					SyntaxPatterns:  []ir.PatternString{{Line: 478, Value: "$b = append($b, strconv.FormatInt($x, $base)...)"}},
+					ReportTemplate:  "$$ => $b = strconv.AppendInt($b, $x, $base)",
+					SuggestTemplate: "$b = strconv.AppendInt($b, $x, $base)",
+				},
+				{
+					Line:            480,
+					SyntaxPatterns:  []ir.PatternString{{Line: 480, Value: "$b = append($b, strconv.FormatUint($x, $base)...)"}},
+					ReportTemplate:  "$$ => $b = strconv.AppendUint($b, $x, $base)",
+					SuggestTemplate: "$b = strconv.AppendUint($b, $x, $base)",
+				},
+				{
+					Line:            483,
+					SyntaxPatterns:  []ir.PatternString{{Line: 483, Value: "$b = append($b, $t.Format($layout)...)"}},
+					ReportTemplate:  "$$ => $b = $t.AppendFormat($b, $layout)",
+					SuggestTemplate: "$b = $t.AppendFormat($b, $layout)",
+					WhereExpr: ir.FilterExpr{
+						Line: 484,
+						Op:   ir.FilterOrOp,
+						Src:  "m[\"t\"].Type.Is(`time.Time`) || m[\"t\"].Type.Is(`*time.Time`)",
+						Args: []ir.FilterExpr{
+							{
+								Line:  484,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"t\"].Type.Is(`time.Time`)",
+								Value: "t",
+								Args:  []ir.FilterExpr{{Line: 484, Op: ir.FilterStringOp, Src: "`time.Time`", Value: "time.Time"}},
+							},
+							{
+								Line:  484,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"t\"].Type.Is(`*time.Time`)",
+								Value: "t",
+								Args:  []ir.FilterExpr{{Line: 484, Op: ir.FilterStringOp, Src: "`*time.Time`", Value: "*time.Time"}},
+							},
+						},
+					},
+				},
+				{
+					Line:            487,
+					SyntaxPatterns:  []ir.PatternString{{Line: 487, Value: "$b = append($b, $v.String()...)"}},
+					ReportTemplate:  "$$ => $b = $v.Append($b, 'g', 10)",
+					SuggestTemplate: "$b = $v.Append($b, 'g', 10)",
+					WhereExpr: ir.FilterExpr{
+						Line: 488,
+						Op:   ir.FilterOrOp,
+						Src:  "m[\"v\"].Type.Is(`big.Float`) || m[\"v\"].Type.Is(`*big.Float`)",
+						Args: []ir.FilterExpr{
+							{
+								Line:  488,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"v\"].Type.Is(`big.Float`)",
+								Value: "v",
+								Args:  []ir.FilterExpr{{Line: 488, Op: ir.FilterStringOp, Src: "`big.Float`", Value: "big.Float"}},
+							},
+							{
+								Line:  488,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"v\"].Type.Is(`*big.Float`)",
+								Value: "v",
+								Args:  []ir.FilterExpr{{Line: 488, Op: ir.FilterStringOp, Src: "`*big.Float`", Value: "*big.Float"}},
+							},
 						},
 					},
 				},
 				{
-					Line:            309,
-					SyntaxPatterns:  []ir.PatternString{{Line: 309, Value: "$b = append($b, $v.Text($format, $prec)...)"}},
+					Line:            490,
+					SyntaxPatterns:  []ir.PatternString{{Line: 490, Value: "$b = append($b, $v.Text($format, $prec)...)"}},
 					ReportTemplate:  "$$ => $b = $v.Append($b, $format, $prec)",
 					SuggestTemplate: "$b = $v.Append($b, $format, $prec)",
 					WhereExpr: ir.FilterExpr{
-						Line: 310,
+						Line: 491,
 						Op:   ir.FilterOrOp,
 						Src:  "m[\"v\"].Type.Is(`big.Float`) || m[\"v\"].Type.Is(`*big.Float`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  310,
+								Line:  491,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"v\"].Type.Is(`big.Float`)",
 								Value: "v",
-								Args:  []ir.FilterExpr{{Line: 310, Op: ir.FilterStringOp, Src: "`big.Float`", Value: "big.Float"}},
+								Args:  []ir.FilterExpr{{Line: 491, Op: ir.FilterStringOp, Src: "`big.Float`", Value: "big.Float"}},
 							},
 							{
-								Line:  310,
+								Line:  491,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"v\"].Type.Is(`*big.Float`)",
 								Value: "v",
-								Args:  []ir.FilterExpr{{Line: 310, Op: ir.FilterStringOp, Src: "`*big.Float`", Value: "*big.Float"}},
+								Args:  []ir.FilterExpr{{Line: 491, Op: ir.FilterStringOp, Src: "`*big.Float`", Value: "*big.Float"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            313,
-					SyntaxPatterns:  []ir.PatternString{{Line: 313, Value: "$b = append($b, $v.String()...)"}},
+					Line:            494,
+					SyntaxPatterns:  []ir.PatternString{{Line: 494, Value: "$b = append($b, $v.String()...)"}},
 					ReportTemplate:  "$$ => $b = $v.Append($b, 10)",
 					SuggestTemplate: "$b = $v.Append($b, 10)",
 					WhereExpr: ir.FilterExpr{
-						Line: 314,
+						Line: 495,
 						Op:   ir.FilterOrOp,
 						Src:  "m[\"v\"].Type.Is(`big.Int`) || m[\"v\"].Type.Is(`*big.Int`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  314,
+								Line:  495,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"v\"].Type.Is(`big.Int`)",
 								Value: "v",
-								Args:  []ir.FilterExpr{{Line: 314, Op: ir.FilterStringOp, Src: "`big.Int`", Value: "big.Int"}},
+								Args:  []ir.FilterExpr{{Line: 495, Op: ir.FilterStringOp, Src: "`big.Int`", Value: "big.Int"}},
 							},
 							{
-								Line:  314,
+								Line:  495,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"v\"].Type.Is(`*big.Int`)",
 								Value: "v",
-								Args:  []ir.FilterExpr{{Line: 314, Op: ir.FilterStringOp, Src: "`*big.Int`", Value: "*big.Int"}},
+								Args:  []ir.FilterExpr{{Line: 495, Op: ir.FilterStringOp, Src: "`*big.Int`", Value: "*big.Int"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            316,
-					SyntaxPatterns:  []ir.PatternString{{Line: 316, Value: "$b = append($b, $v.Text($base)...)"}},
+					Line:            497,
+					SyntaxPatterns:  []ir.PatternString{{Line: 497, Value: "$b = append($b, $v.Text($base)...)"}},
 					ReportTemplate:  "$$ => $b = $v.Append($b, $base)",
 					SuggestTemplate: "$b = $v.Append($b, $base)",
 					WhereExpr: ir.FilterExpr{
-						Line: 317,
+						Line: 498,
 						Op:   ir.FilterOrOp,
 						Src:  "m[\"v\"].Type.Is(`big.Int`) || m[\"v\"].Type.Is(`*big.Int`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  317,
+								Line:  498,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"v\"].Type.Is(`big.Int`)",
 								Value: "v",
-								Args:  []ir.FilterExpr{{Line: 317, Op: ir.FilterStringOp, Src: "`big.Int`", Value: "big.Int"}},
+								Args:  []ir.FilterExpr{{Line: 498, Op: ir.FilterStringOp, Src: "`big.Int`", Value: "big.Int"}},
 							},
 							{
-								Line:  317,
+								Line:  498,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"v\"].Type.Is(`*big.Int`)",
 								Value: "v",
-								Args:  []ir.FilterExpr{{Line: 317, Op: ir.FilterStringOp, Src: "`*big.Int`", Value: "*big.Int"}},
+								Args:  []ir.FilterExpr{{Line: 498, Op: ir.FilterStringOp, Src: "`*big.Int`", Value: "*big.Int"}},
 							},
 						},
 					},
@@ -1292,7 +1744,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        325,
+			Line:        507,
 			Name:        "convReorder",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
@@ -1301,81 +1753,81 @@ var Universal = &ir.File{
 			DocAfter:    "string(bytes.TrimSpace(b))",
 			Rules: []ir.Rule{
 				{
-					Line:            331,
-					SyntaxPatterns:  []ir.PatternString{{Line: 331, Value: "strings.TrimSpace(string($b))"}},
+					Line:            513,
+					SyntaxPatterns:  []ir.PatternString{{Line: 513, Value: "strings.TrimSpace(string($b))"}},
 					ReportTemplate:  "$$ => string(bytes.TrimSpace($b))",
 					SuggestTemplate: "string(bytes.TrimSpace($b))",
 					WhereExpr: ir.FilterExpr{
-						Line:  332,
+						Line:  514,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"b\"].Type.Is(`[]byte`)",
 						Value: "b",
-						Args:  []ir.FilterExpr{{Line: 332, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+						Args:  []ir.FilterExpr{{Line: 514, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 					},
 				},
 				{
-					Line:            335,
-					SyntaxPatterns:  []ir.PatternString{{Line: 335, Value: "bytes.TrimSpace([]byte($s))"}},
+					Line:            517,
+					SyntaxPatterns:  []ir.PatternString{{Line: 517, Value: "bytes.TrimSpace([]byte($s))"}},
 					ReportTemplate:  "$$ => []byte(strings.TrimSpace($s))",
 					SuggestTemplate: "[]byte(strings.TrimSpace($s))",
 					WhereExpr: ir.FilterExpr{
-						Line:  336,
+						Line:  518,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"s\"].Type.Is(`string`)",
 						Value: "s",
-						Args:  []ir.FilterExpr{{Line: 336, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+						Args:  []ir.FilterExpr{{Line: 518, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 					},
 				},
 				{
-					Line:            339,
-					SyntaxPatterns:  []ir.PatternString{{Line: 339, Value: "strings.TrimPrefix(string($b1), string($b2))"}},
+					Line:            521,
+					SyntaxPatterns:  []ir.PatternString{{Line: 521, Value: "strings.TrimPrefix(string($b1), string($b2))"}},
 					ReportTemplate:  "$$ => string(bytes.TrimPrefix($b1, $b2))",
 					SuggestTemplate: "string(bytes.TrimPrefix($b1, $b2))",
 					WhereExpr: ir.FilterExpr{
-						Line: 340,
+						Line: 522,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"b1\"].Type.Is(`[]byte`) && m[\"b2\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  340,
+								Line:  522,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b1\"].Type.Is(`[]byte`)",
 								Value: "b1",
-								Args:  []ir.FilterExpr{{Line: 340, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 522, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 							{
-								Line:  340,
+								Line:  522,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b2\"].Type.Is(`[]byte`)",
 								Value: "b2",
-								Args:  []ir.FilterExpr{{Line: 340, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 522, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            343,
-					SyntaxPatterns:  []ir.PatternString{{Line: 343, Value: "bytes.TrimPrefix([]byte($s1), []byte($s2))"}},
+					Line:            525,
+					SyntaxPatterns:  []ir.PatternString{{Line: 525, Value: "bytes.TrimPrefix([]byte($s1), []byte($s2))"}},
 					ReportTemplate:  "$$ => []byte(strings.TrimPrefix($s1, $s2))",
 					SuggestTemplate: "[]byte(strings.TrimPrefix($s1, $s2))",
 					WhereExpr: ir.FilterExpr{
-						Line: 344,
+						Line: 526,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"s1\"].Type.Is(`string`) && m[\"s2\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  344,
+								Line:  526,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s1\"].Type.Is(`string`)",
 								Value: "s1",
-								Args:  []ir.FilterExpr{{Line: 344, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 526, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  344,
+								Line:  526,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s2\"].Type.Is(`string`)",
 								Value: "s2",
-								Args:  []ir.FilterExpr{{Line: 344, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 526, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
@@ -1383,7 +1835,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        352,
+			Line:        535,
 			Name:        "slicedConv",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
@@ -1392,35 +1844,35 @@ var Universal = &ir.File{
 			DocAfter:    "string(b[:n])",
 			Rules: []ir.Rule{
 				{
-					Line:            353,
-					SyntaxPatterns:  []ir.PatternString{{Line: 353, Value: "string($b)[:$n]"}},
+					Line:            536,
+					SyntaxPatterns:  []ir.PatternString{{Line: 536, Value: "string($b)[:$n]"}},
 					ReportTemplate:  "$$ => string($b[:$n])",
 					SuggestTemplate: "string($b[:$n])",
 					WhereExpr: ir.FilterExpr{
-						Line:  354,
+						Line:  537,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"b\"].Type.Is(`[]byte`)",
 						Value: "b",
-						Args:  []ir.FilterExpr{{Line: 354, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+						Args:  []ir.FilterExpr{{Line: 537, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 					},
 				},
 				{
-					Line:            357,
-					SyntaxPatterns:  []ir.PatternString{{Line: 357, Value: "[]byte($s)[:$n]"}},
+					Line:            540,
+					SyntaxPatterns:  []ir.PatternString{{Line: 540, Value: "[]byte($s)[:$n]"}},
 					ReportTemplate:  "$$ => []byte($s[:$n])",
 					SuggestTemplate: "[]byte($s[:$n])",
 					WhereExpr: ir.FilterExpr{
-						Line:  358,
+						Line:  541,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"s\"].Type.Is(`string`)",
 						Value: "s",
-						Args:  []ir.FilterExpr{{Line: 358, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+						Args:  []ir.FilterExpr{{Line: 541, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 					},
 				},
 			},
 		},
 		{
-			Line:        366,
+			Line:        550,
 			Name:        "stringCopyElim",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score4"},
@@ -1429,141 +1881,141 @@ var Universal = &ir.File{
 			DocAfter:    "copy(b, s)",
 			Rules: []ir.Rule{
 				{
-					Line:            367,
-					SyntaxPatterns:  []ir.PatternString{{Line: 367, Value: "copy($b, []byte($s))"}},
+					Line:            551,
+					SyntaxPatterns:  []ir.PatternString{{Line: 551, Value: "copy($b, []byte($s))"}},
 					ReportTemplate:  "$$ => copy($b, $s)",
 					SuggestTemplate: "copy($b, $s)",
 					WhereExpr: ir.FilterExpr{
-						Line:  368,
+						Line:  552,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"s\"].Type.Is(`string`)",
 						Value: "s",
-						Args:  []ir.FilterExpr{{Line: 368, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+						Args:  []ir.FilterExpr{{Line: 552, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 					},
 				},
 				{
-					Line:            371,
-					SyntaxPatterns:  []ir.PatternString{{Line: 371, Value: "append($b, []byte($s)...)"}},
+					Line:            555,
+					SyntaxPatterns:  []ir.PatternString{{Line: 555, Value: "append($b, []byte($s)...)"}},
 					ReportTemplate:  "$$ => append($b, $s...)",
 					SuggestTemplate: "append($b, $s...)",
 					WhereExpr: ir.FilterExpr{
-						Line:  372,
+						Line:  556,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"s\"].Type.Is(`string`)",
 						Value: "s",
-						Args:  []ir.FilterExpr{{Line: 372, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+						Args:  []ir.FilterExpr{{Line: 556, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 					},
 				},
 				{
-					Line:            375,
-					SyntaxPatterns:  []ir.PatternString{{Line: 375, Value: "append($b, string($b2)...)"}},
+					Line:            559,
+					SyntaxPatterns:  []ir.PatternString{{Line: 559, Value: "append($b, string($b2)...)"}},
 					ReportTemplate:  "$$ => append($b, $b2...)",
 					SuggestTemplate: "append($b, $b2...)",
 					WhereExpr: ir.FilterExpr{
-						Line:  376,
+						Line:  560,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"b2\"].Type.Is(`[]byte`)",
 						Value: "b2",
-						Args:  []ir.FilterExpr{{Line: 376, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+						Args:  []ir.FilterExpr{{Line: 560, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 					},
 				},
 				{
-					Line:            379,
-					SyntaxPatterns:  []ir.PatternString{{Line: 379, Value: "len(string($b))"}},
+					Line:            563,
+					SyntaxPatterns:  []ir.PatternString{{Line: 563, Value: "len(string($b))"}},
 					ReportTemplate:  "$$ => len($b)",
 					SuggestTemplate: "len($b)",
 					WhereExpr: ir.FilterExpr{
-						Line:  379,
+						Line:  563,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"b\"].Type.Is(`[]byte`)",
 						Value: "b",
-						Args:  []ir.FilterExpr{{Line: 379, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+						Args:  []ir.FilterExpr{{Line: 563, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 					},
 				},
 				{
-					Line:            381,
-					SyntaxPatterns:  []ir.PatternString{{Line: 381, Value: "[]byte(strings.$f(string($b)))"}},
+					Line:            565,
+					SyntaxPatterns:  []ir.PatternString{{Line: 565, Value: "[]byte(strings.$f(string($b)))"}},
 					ReportTemplate:  "$$ => bytes.$f($b)",
 					SuggestTemplate: "bytes.$f($b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 382,
+						Line: 566,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"b\"].Type.Is(`[]byte`) &&\n\tm[\"f\"].Text.Matches(`ToUpper|ToLower|TrimSpace`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  382,
+								Line:  566,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 382, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 566, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 							{
-								Line:  383,
+								Line:  567,
 								Op:    ir.FilterVarTextMatchesOp,
 								Src:   "m[\"f\"].Text.Matches(`ToUpper|ToLower|TrimSpace`)",
 								Value: "f",
-								Args:  []ir.FilterExpr{{Line: 383, Op: ir.FilterStringOp, Src: "`ToUpper|ToLower|TrimSpace`", Value: "ToUpper|ToLower|TrimSpace"}},
+								Args:  []ir.FilterExpr{{Line: 567, Op: ir.FilterStringOp, Src: "`ToUpper|ToLower|TrimSpace`", Value: "ToUpper|ToLower|TrimSpace"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            386,
-					SyntaxPatterns:  []ir.PatternString{{Line: 386, Value: "[]byte(strings.$f(string($b), $s2))"}},
+					Line:            570,
+					SyntaxPatterns:  []ir.PatternString{{Line: 570, Value: "[]byte(strings.$f(string($b), $s2))"}},
 					ReportTemplate:  "$$ => bytes.$f($b, []byte($s2))",
 					SuggestTemplate: "bytes.$f($b, []byte($s2))",
 					WhereExpr: ir.FilterExpr{
-						Line: 387,
+						Line: 571,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"b\"].Type.Is(`[]byte`) &&\n\tm[\"f\"].Text.Matches(`TrimPrefix|TrimSuffix`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  387,
+								Line:  571,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 387, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 571, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 							{
-								Line:  388,
+								Line:  572,
 								Op:    ir.FilterVarTextMatchesOp,
 								Src:   "m[\"f\"].Text.Matches(`TrimPrefix|TrimSuffix`)",
 								Value: "f",
-								Args:  []ir.FilterExpr{{Line: 388, Op: ir.FilterStringOp, Src: "`TrimPrefix|TrimSuffix`", Value: "TrimPrefix|TrimSuffix"}},
+								Args:  []ir.FilterExpr{{Line: 572, Op: ir.FilterStringOp, Src: "`TrimPrefix|TrimSuffix`", Value: "TrimPrefix|TrimSuffix"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            391,
-					SyntaxPatterns:  []ir.PatternString{{Line: 391, Value: "bytes.NewReader([]byte($x))"}},
+					Line:            575,
+					SyntaxPatterns:  []ir.PatternString{{Line: 575, Value: "bytes.NewReader([]byte($x))"}},
 					ReportTemplate:  "$$ => strings.NewReader($x)",
 					SuggestTemplate: "strings.NewReader($x)",
 					WhereExpr: ir.FilterExpr{
-						Line:  392,
+						Line:  576,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`string`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 392, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+						Args:  []ir.FilterExpr{{Line: 576, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 					},
 				},
 				{
-					Line:            395,
-					SyntaxPatterns:  []ir.PatternString{{Line: 395, Value: "strings.NewReader(string($x))"}},
+					Line:            579,
+					SyntaxPatterns:  []ir.PatternString{{Line: 579, Value: "strings.NewReader(string($x))"}},
 					ReportTemplate:  "$$ => bytes.NewReader($x)",
 					SuggestTemplate: "bytes.NewReader($x)",
 					WhereExpr: ir.FilterExpr{
-						Line:  396,
+						Line:  580,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`[]byte`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 396, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+						Args:  []ir.FilterExpr{{Line: 580, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 					},
 				},
 			},
 		},
 		{
-			Line:        404,
+			Line:        589,
 			Name:        "regexpStringCopyElim",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
@@ -1572,327 +2024,327 @@ var Universal = &ir.File{
 			DocAfter:    "regexp.ReplaceAllString(s, \"foo\")",
 			Rules: []ir.Rule{
 				{
-					Line:            407,
-					SyntaxPatterns:  []ir.PatternString{{Line: 407, Value: "$re.Match([]byte($s))"}},
+					Line:            592,
+					SyntaxPatterns:  []ir.PatternString{{Line: 592, Value: "$re.Match([]byte($s))"}},
 					ReportTemplate:  "$$ => $re.MatchString($s)",
 					SuggestTemplate: "$re.MatchString($s)",
 					WhereExpr: ir.FilterExpr{
-						Line: 408,
+						Line: 593,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  408,
+								Line:  593,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 								Value: "re",
-								Args:  []ir.FilterExpr{{Line: 408, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+								Args:  []ir.FilterExpr{{Line: 593, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 							},
 							{
-								Line:  408,
+								Line:  593,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 408, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 593, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            411,
-					SyntaxPatterns:  []ir.PatternString{{Line: 411, Value: "$re.FindIndex([]byte($s))"}},
+					Line:            596,
+					SyntaxPatterns:  []ir.PatternString{{Line: 596, Value: "$re.FindIndex([]byte($s))"}},
 					ReportTemplate:  "$$ => $re.FindStringIndex($s)",
 					SuggestTemplate: "$re.FindStringIndex($s)",
 					WhereExpr: ir.FilterExpr{
-						Line: 412,
+						Line: 597,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  412,
+								Line:  597,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 								Value: "re",
-								Args:  []ir.FilterExpr{{Line: 412, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+								Args:  []ir.FilterExpr{{Line: 597, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 							},
 							{
-								Line:  412,
+								Line:  597,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 412, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 597, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            415,
-					SyntaxPatterns:  []ir.PatternString{{Line: 415, Value: "$re.FindAllIndex([]byte($s), $n)"}},
+					Line:            600,
+					SyntaxPatterns:  []ir.PatternString{{Line: 600, Value: "$re.FindAllIndex([]byte($s), $n)"}},
 					ReportTemplate:  "$$ => $re.FindAllStringIndex($s, $n)",
 					SuggestTemplate: "$re.FindAllStringIndex($s, $n)",
 					WhereExpr: ir.FilterExpr{
-						Line: 416,
+						Line: 601,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  416,
+								Line:  601,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 								Value: "re",
-								Args:  []ir.FilterExpr{{Line: 416, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+								Args:  []ir.FilterExpr{{Line: 601, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 							},
 							{
-								Line:  416,
+								Line:  601,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 416, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 601, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            419,
-					SyntaxPatterns:  []ir.PatternString{{Line: 419, Value: "string($re.ReplaceAll([]byte($s), []byte($s2)))"}},
+					Line:            604,
+					SyntaxPatterns:  []ir.PatternString{{Line: 604, Value: "string($re.ReplaceAll([]byte($s), []byte($s2)))"}},
 					ReportTemplate:  "$$ => $re.ReplaceAllString($s, $s2)",
 					SuggestTemplate: "$re.ReplaceAllString($s, $s2)",
 					WhereExpr: ir.FilterExpr{
-						Line: 420,
+						Line: 605,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"s\"].Type.Is(`string`) && m[\"s2\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 420,
+								Line: 605,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"s\"].Type.Is(`string`)",
 								Args: []ir.FilterExpr{
 									{
-										Line:  420,
+										Line:  605,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 										Value: "re",
-										Args:  []ir.FilterExpr{{Line: 420, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+										Args:  []ir.FilterExpr{{Line: 605, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 									},
 									{
-										Line:  420,
+										Line:  605,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"s\"].Type.Is(`string`)",
 										Value: "s",
-										Args:  []ir.FilterExpr{{Line: 420, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+										Args:  []ir.FilterExpr{{Line: 605, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 									},
 								},
 							},
 							{
-								Line:  420,
+								Line:  605,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s2\"].Type.Is(`string`)",
 								Value: "s2",
-								Args:  []ir.FilterExpr{{Line: 420, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 605, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            423,
-					SyntaxPatterns:  []ir.PatternString{{Line: 423, Value: "string($re.ReplaceAll([]byte($s), $b))"}},
+					Line:            608,
+					SyntaxPatterns:  []ir.PatternString{{Line: 608, Value: "string($re.ReplaceAll([]byte($s), $b))"}},
 					ReportTemplate:  "$$ => $re.ReplaceAllString($s, string($b))",
 					SuggestTemplate: "$re.ReplaceAllString($s, string($b))",
 					WhereExpr: ir.FilterExpr{
-						Line: 424,
+						Line: 609,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"s\"].Type.Is(`string`) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 424,
+								Line: 609,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"s\"].Type.Is(`string`)",
 								Args: []ir.FilterExpr{
 									{
-										Line:  424,
+										Line:  609,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 										Value: "re",
-										Args:  []ir.FilterExpr{{Line: 424, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+										Args:  []ir.FilterExpr{{Line: 609, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 									},
 									{
-										Line:  424,
+										Line:  609,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"s\"].Type.Is(`string`)",
 										Value: "s",
-										Args:  []ir.FilterExpr{{Line: 424, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+										Args:  []ir.FilterExpr{{Line: 609, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 									},
 								},
 							},
 							{
-								Line:  424,
+								Line:  609,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 424, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 609, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            429,
-					SyntaxPatterns:  []ir.PatternString{{Line: 429, Value: "$re.MatchString(string($b))"}},
+					Line:            614,
+					SyntaxPatterns:  []ir.PatternString{{Line: 614, Value: "$re.MatchString(string($b))"}},
 					ReportTemplate:  "$$ => $re.Match($b)",
 					SuggestTemplate: "$re.Match($b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 430,
+						Line: 615,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  430,
+								Line:  615,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 								Value: "re",
-								Args:  []ir.FilterExpr{{Line: 430, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+								Args:  []ir.FilterExpr{{Line: 615, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 							},
 							{
-								Line:  430,
+								Line:  615,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 430, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 615, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            433,
-					SyntaxPatterns:  []ir.PatternString{{Line: 433, Value: "$re.FindStringIndex(string($b))"}},
+					Line:            618,
+					SyntaxPatterns:  []ir.PatternString{{Line: 618, Value: "$re.FindStringIndex(string($b))"}},
 					ReportTemplate:  "$$ => $re.FindIndex($b)",
 					SuggestTemplate: "$re.FindIndex($b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 434,
+						Line: 619,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  434,
+								Line:  619,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 								Value: "re",
-								Args:  []ir.FilterExpr{{Line: 434, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+								Args:  []ir.FilterExpr{{Line: 619, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 							},
 							{
-								Line:  434,
+								Line:  619,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 434, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 619, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            437,
-					SyntaxPatterns:  []ir.PatternString{{Line: 437, Value: "$re.FindAllStringIndex(string($b), $n)"}},
+					Line:            622,
+					SyntaxPatterns:  []ir.PatternString{{Line: 622, Value: "$re.FindAllStringIndex(string($b), $n)"}},
 					ReportTemplate:  "$$ => $re.FindAllIndex($b, $n)",
 					SuggestTemplate: "$re.FindAllIndex($b, $n)",
 					WhereExpr: ir.FilterExpr{
-						Line: 438,
+						Line: 623,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  438,
+								Line:  623,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 								Value: "re",
-								Args:  []ir.FilterExpr{{Line: 438, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+								Args:  []ir.FilterExpr{{Line: 623, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 							},
 							{
-								Line:  438,
+								Line:  623,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 438, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 623, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            441,
-					SyntaxPatterns:  []ir.PatternString{{Line: 441, Value: "[]byte($re.ReplaceAllString(string($b), string($b2)))"}},
+					Line:            626,
+					SyntaxPatterns:  []ir.PatternString{{Line: 626, Value: "[]byte($re.ReplaceAllString(string($b), string($b2)))"}},
 					ReportTemplate:  "$$ => $re.ReplaceAll($b, $b2)",
 					SuggestTemplate: "$re.ReplaceAll($b, $b2)",
 					WhereExpr: ir.FilterExpr{
-						Line: 442,
+						Line: 627,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"b\"].Type.Is(`[]byte`) && m[\"b2\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 442,
+								Line: 627,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"b\"].Type.Is(`[]byte`)",
 								Args: []ir.FilterExpr{
 									{
-										Line:  442,
+										Line:  627,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 										Value: "re",
-										Args:  []ir.FilterExpr{{Line: 442, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+										Args:  []ir.FilterExpr{{Line: 627, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 									},
 									{
-										Line:  442,
+										Line:  627,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"b\"].Type.Is(`[]byte`)",
 										Value: "b",
-										Args:  []ir.FilterExpr{{Line: 442, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+										Args:  []ir.FilterExpr{{Line: 627, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 									},
 								},
 							},
 							{
-								Line:  442,
+								Line:  627,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b2\"].Type.Is(`[]byte`)",
 								Value: "b2",
-								Args:  []ir.FilterExpr{{Line: 442, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 627, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            445,
-					SyntaxPatterns:  []ir.PatternString{{Line: 445, Value: "[]byte($re.ReplaceAllString(string($b), $s))"}},
+					Line:            630,
+					SyntaxPatterns:  []ir.PatternString{{Line: 630, Value: "[]byte($re.ReplaceAllString(string($b), $s))"}},
 					ReportTemplate:  "$$ => $re.ReplaceAll($b, []byte($s))",
 					SuggestTemplate: "$re.ReplaceAll($b, []byte($s))",
 					WhereExpr: ir.FilterExpr{
-						Line: 446,
+						Line: 631,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"b\"].Type.Is(`[]byte`) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 446,
+								Line: 631,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"b\"].Type.Is(`[]byte`)",
 								Args: []ir.FilterExpr{
 									{
-										Line:  446,
+										Line:  631,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
 										Value: "re",
-										Args:  []ir.FilterExpr{{Line: 446, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+										Args:  []ir.FilterExpr{{Line: 631, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
 									},
 									{
-										Line:  446,
+										Line:  631,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"b\"].Type.Is(`[]byte`)",
 										Value: "b",
-										Args:  []ir.FilterExpr{{Line: 446, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+										Args:  []ir.FilterExpr{{Line: 631, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 									},
 								},
 							},
 							{
-								Line:  446,
+								Line:  631,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 446, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 631, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
@@ -1900,7 +2352,90 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        455,
+			Line:        641,
+			Name:        "regexpFindMatch",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score2"},
+			DocSummary:  "Detects regexp FindString calls that are only used to test for a match",
+			DocBefore:   "re.FindString(s) != \"\"",
+			DocAfter:    "re.MatchString(s)",
+			DocNote:     "if the pattern can match an empty string, FindString returns \"\" even on a match",
+			Rules: []ir.Rule{
+				{
+					Line: 644,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 644, Value: "$re.FindString($s) != \"\""},
+						{Line: 644, Value: "\"\" != $re.FindString($s)"},
+					},
+					ReportTemplate:  "$$ => $re.MatchString($s)",
+					SuggestTemplate: "$re.MatchString($s)",
+					WhereExpr: ir.FilterExpr{
+						Line:  645,
+						Op:    ir.FilterVarTypeIsOp,
+						Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
+						Value: "re",
+						Args:  []ir.FilterExpr{{Line: 645, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+					},
+				},
+				{
+					Line: 648,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 648, Value: "$re.FindString($s) == \"\""},
+						{Line: 648, Value: "\"\" == $re.FindString($s)"},
+					},
+					ReportTemplate:  "$$ => !$re.MatchString($s)",
+					SuggestTemplate: "!$re.MatchString($s)",
+					WhereExpr: ir.FilterExpr{
+						Line:  649,
+						Op:    ir.FilterVarTypeIsOp,
+						Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
+						Value: "re",
+						Args:  []ir.FilterExpr{{Line: 649, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+					},
+				},
+			},
+		},
+		{
+			Line:        659,
+			Name:        "regexpGuardedFind",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score2"},
+			DocSummary:  "Detects regexp MatchString guards before FindString calls with the same input",
+			DocBefore:   "if re.MatchString(s) { x := re.FindString(s); use(x) }",
+			DocAfter:    "if x := re.FindString(s); x != \"\" { use(x) }",
+			DocNote:     "if the pattern can match an empty string, FindString returns \"\" even on a match",
+			Rules: []ir.Rule{{
+				Line:            664,
+				SyntaxPatterns:  []ir.PatternString{{Line: 664, Value: "if $re.MatchString($s) { $x := $re.FindString($s); $*body }"}},
+				ReportTemplate:  "$re.MatchString($s) guard is redundant, use if $x := $re.FindString($s); $x != \"\" { … }",
+				SuggestTemplate: "if $x := $re.FindString($s); $x != \"\" { $body }",
+				WhereExpr: ir.FilterExpr{
+					Line: 665,
+					Op:   ir.FilterAndOp,
+					Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"re\"].Pure && m[\"s\"].Pure",
+					Args: []ir.FilterExpr{
+						{
+							Line: 665,
+							Op:   ir.FilterAndOp,
+							Src:  "m[\"re\"].Type.Is(`*regexp.Regexp`) && m[\"re\"].Pure",
+							Args: []ir.FilterExpr{
+								{
+									Line:  665,
+									Op:    ir.FilterVarTypeIsOp,
+									Src:   "m[\"re\"].Type.Is(`*regexp.Regexp`)",
+									Value: "re",
+									Args:  []ir.FilterExpr{{Line: 665, Op: ir.FilterStringOp, Src: "`*regexp.Regexp`", Value: "*regexp.Regexp"}},
+								},
+								{Line: 665, Op: ir.FilterVarPureOp, Src: "m[\"re\"].Pure", Value: "re"},
+							},
+						},
+						{Line: 665, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
+					},
+				},
+			}},
+		},
+		{
+			Line:        676,
 			Name:        "indexAlloc",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
@@ -1910,467 +2445,467 @@ var Universal = &ir.File{
 			DocNote:     "See Go issue for details: https://github.com/golang/go/issues/25864",
 			Rules: []ir.Rule{
 				{
-					Line:            459,
-					SyntaxPatterns:  []ir.PatternString{{Line: 459, Value: "strings.$f(string($b1), string($b2))"}},
+					Line:            680,
+					SyntaxPatterns:  []ir.PatternString{{Line: 680, Value: "strings.$f(string($b1), string($b2))"}},
 					ReportTemplate:  "$$ => bytes.$f($b1, $b2)",
 					SuggestTemplate: "bytes.$f($b1, $b2)",
 					WhereExpr: ir.FilterExpr{
-						Line: 460,
+						Line: 681,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"f\"].Text.Matches(`Compare|Contains|HasPrefix|HasSuffix|EqualFold`) &&\n\tm[\"b1\"].Type.Is(`[]byte`) && m[\"b2\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 460,
+								Line: 681,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"f\"].Text.Matches(`Compare|Contains|HasPrefix|HasSuffix|EqualFold`) &&\n\tm[\"b1\"].Type.Is(`[]byte`)",
 								Args: []ir.FilterExpr{
 									{
-										Line:  460,
+										Line:  681,
 										Op:    ir.FilterVarTextMatchesOp,
 										Src:   "m[\"f\"].Text.Matches(`Compare|Contains|HasPrefix|HasSuffix|EqualFold`)",
 										Value: "f",
-										Args:  []ir.FilterExpr{{Line: 460, Op: ir.FilterStringOp, Src: "`Compare|Contains|HasPrefix|HasSuffix|EqualFold`", Value: "Compare|Contains|HasPrefix|HasSuffix|EqualFold"}},
+										Args:  []ir.FilterExpr{{Line: 681, Op: ir.FilterStringOp, Src: "`Compare|Contains|HasPrefix|HasSuffix|EqualFold`", Value: "Compare|Contains|HasPrefix|HasSuffix|EqualFold"}},
 									},
 									{
-										Line:  461,
+										Line:  682,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"b1\"].Type.Is(`[]byte`)",
 										Value: "b1",
-										Args:  []ir.FilterExpr{{Line: 461, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+										Args:  []ir.FilterExpr{{Line: 682, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 									},
 								},
 							},
 							{
-								Line:  461,
+								Line:  682,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b2\"].Type.Is(`[]byte`)",
 								Value: "b2",
-								Args:  []ir.FilterExpr{{Line: 461, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 682, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            464,
-					SyntaxPatterns:  []ir.PatternString{{Line: 464, Value: "bytes.$f([]byte($s1), []byte($s2))"}},
+					Line:            685,
+					SyntaxPatterns:  []ir.PatternString{{Line: 685, Value: "bytes.$f([]byte($s1), []byte($s2))"}},
 					ReportTemplate:  "$$ => strings.$f($s1, $s2)",
 					SuggestTemplate: "strings.$f($s1, $s2)",
 					WhereExpr: ir.FilterExpr{
-						Line: 465,
+						Line: 686,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"f\"].Text.Matches(`Compare|Contains|HasPrefix|HasSuffix|EqualFold`) &&\n\tm[\"s1\"].Type.Is(`string`) && m[\"s2\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 465,
+								Line: 686,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"f\"].Text.Matches(`Compare|Contains|HasPrefix|HasSuffix|EqualFold`) &&\n\tm[\"s1\"].Type.Is(`string`)",
 								Args: []ir.FilterExpr{
 									{
-										Line:  465,
+										Line:  686,
 										Op:    ir.FilterVarTextMatchesOp,
 										Src:   "m[\"f\"].Text.Matches(`Compare|Contains|HasPrefix|HasSuffix|EqualFold`)",
 										Value: "f",
-										Args:  []ir.FilterExpr{{Line: 465, Op: ir.FilterStringOp, Src: "`Compare|Contains|HasPrefix|HasSuffix|EqualFold`", Value: "Compare|Contains|HasPrefix|HasSuffix|EqualFold"}},
+										Args:  []ir.FilterExpr{{Line: 686, Op: ir.FilterStringOp, Src: "`Compare|Contains|HasPrefix|HasSuffix|EqualFold`", Value: "Compare|Contains|HasPrefix|HasSuffix|EqualFold"}},
 									},
 									{
-										Line:  466,
+										Line:  687,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"s1\"].Type.Is(`string`)",
 										Value: "s1",
-										Args:  []ir.FilterExpr{{Line: 466, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+										Args:  []ir.FilterExpr{{Line: 687, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 									},
 								},
 							},
 							{
-								Line:  466,
+								Line:  687,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s2\"].Type.Is(`string`)",
 								Value: "s2",
-								Args:  []ir.FilterExpr{{Line: 466, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 687, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            475,
-					SyntaxPatterns:  []ir.PatternString{{Line: 475, Value: "strings.Index(string($x), $y)"}},
+					Line:            696,
+					SyntaxPatterns:  []ir.PatternString{{Line: 696, Value: "strings.Index(string($x), $y)"}},
 					ReportTemplate:  "$$ => bytes.Index($x, []byte($y))",
 					SuggestTemplate: "bytes.Index($x, []byte($y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 475,
+						Line: 696,
 						Op:   ir.FilterAndOp,
 						Src:  "canOptimizeStrings(m)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 475,
+								Line: 696,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure &&\n\t!m[\"y\"].Node.Is(`CallExpr`)",
 								Args: []ir.FilterExpr{
 									{
-										Line: 475,
+										Line: 696,
 										Op:   ir.FilterAndOp,
 										Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure",
 										Args: []ir.FilterExpr{
-											{Line: 475, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-											{Line: 475, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+											{Line: 696, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+											{Line: 696, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 										},
 									},
 									{
-										Line: 471,
+										Line: 692,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Node.Is(`CallExpr`)",
 										Args: []ir.FilterExpr{{
-											Line:  475,
+											Line:  696,
 											Op:    ir.FilterVarNodeIsOp,
 											Src:   "m[\"y\"].Node.Is(`CallExpr`)",
 											Value: "y",
-											Args:  []ir.FilterExpr{{Line: 471, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
+											Args:  []ir.FilterExpr{{Line: 692, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
 										}},
 									},
 								},
 							},
 							{
-								Line:  475,
+								Line:  696,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`[]byte`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 472, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 693, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            476,
-					SyntaxPatterns:  []ir.PatternString{{Line: 476, Value: "strings.Contains(string($x), $y)"}},
+					Line:            697,
+					SyntaxPatterns:  []ir.PatternString{{Line: 697, Value: "strings.Contains(string($x), $y)"}},
 					ReportTemplate:  "$$ => bytes.Contains($x, []byte($y))",
 					SuggestTemplate: "bytes.Contains($x, []byte($y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 476,
+						Line: 697,
 						Op:   ir.FilterAndOp,
 						Src:  "canOptimizeStrings(m)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 476,
+								Line: 697,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure &&\n\t!m[\"y\"].Node.Is(`CallExpr`)",
 								Args: []ir.FilterExpr{
 									{
-										Line: 476,
+										Line: 697,
 										Op:   ir.FilterAndOp,
 										Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure",
 										Args: []ir.FilterExpr{
-											{Line: 476, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-											{Line: 476, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+											{Line: 697, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+											{Line: 697, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 										},
 									},
 									{
-										Line: 471,
+										Line: 692,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Node.Is(`CallExpr`)",
 										Args: []ir.FilterExpr{{
-											Line:  476,
+											Line:  697,
 											Op:    ir.FilterVarNodeIsOp,
 											Src:   "m[\"y\"].Node.Is(`CallExpr`)",
 											Value: "y",
-											Args:  []ir.FilterExpr{{Line: 471, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
+											Args:  []ir.FilterExpr{{Line: 692, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
 										}},
 									},
 								},
 							},
 							{
-								Line:  476,
+								Line:  697,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`[]byte`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 472, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 693, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            477,
-					SyntaxPatterns:  []ir.PatternString{{Line: 477, Value: "strings.HasPrefix(string($x), $y)"}},
+					Line:            698,
+					SyntaxPatterns:  []ir.PatternString{{Line: 698, Value: "strings.HasPrefix(string($x), $y)"}},
 					ReportTemplate:  "$$ => bytes.HasPrefix($x, []byte($y))",
 					SuggestTemplate: "bytes.HasPrefix($x, []byte($y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 477,
+						Line: 698,
 						Op:   ir.FilterAndOp,
 						Src:  "canOptimizeStrings(m)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 477,
+								Line: 698,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure &&\n\t!m[\"y\"].Node.Is(`CallExpr`)",
 								Args: []ir.FilterExpr{
 									{
-										Line: 477,
+										Line: 698,
 										Op:   ir.FilterAndOp,
 										Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure",
 										Args: []ir.FilterExpr{
-											{Line: 477, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-											{Line: 477, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+											{Line: 698, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+											{Line: 698, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 										},
 									},
 									{
-										Line: 471,
+										Line: 692,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Node.Is(`CallExpr`)",
 										Args: []ir.FilterExpr{{
-											Line:  477,
+											Line:  698,
 											Op:    ir.FilterVarNodeIsOp,
 											Src:   "m[\"y\"].Node.Is(`CallExpr`)",
 											Value: "y",
-											Args:  []ir.FilterExpr{{Line: 471, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
+											Args:  []ir.FilterExpr{{Line: 692, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
 										}},
 									},
 								},
 							},
 							{
-								Line:  477,
+								Line:  698,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`[]byte`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 472, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 693, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            478,
-					SyntaxPatterns:  []ir.PatternString{{Line: 478, Value: "strings.HasSuffix(string($x), $y)"}},
+					Line:            699,
+					SyntaxPatterns:  []ir.PatternString{{Line: 699, Value: "strings.HasSuffix(string($x), $y)"}},
 					ReportTemplate:  "$$ => bytes.HasSuffix($x, []byte($y))",
 					SuggestTemplate: "bytes.HasSuffix($x, []byte($y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 478,
+						Line: 699,
 						Op:   ir.FilterAndOp,
 						Src:  "canOptimizeStrings(m)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 478,
+								Line: 699,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure &&\n\t!m[\"y\"].Node.Is(`CallExpr`)",
 								Args: []ir.FilterExpr{
 									{
-										Line: 478,
+										Line: 699,
 										Op:   ir.FilterAndOp,
 										Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure",
 										Args: []ir.FilterExpr{
-											{Line: 478, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-											{Line: 478, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+											{Line: 699, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+											{Line: 699, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 										},
 									},
 									{
-										Line: 471,
+										Line: 692,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Node.Is(`CallExpr`)",
 										Args: []ir.FilterExpr{{
-											Line:  478,
+											Line:  699,
 											Op:    ir.FilterVarNodeIsOp,
 											Src:   "m[\"y\"].Node.Is(`CallExpr`)",
 											Value: "y",
-											Args:  []ir.FilterExpr{{Line: 471, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
+											Args:  []ir.FilterExpr{{Line: 692, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
 										}},
 									},
 								},
 							},
 							{
-								Line:  478,
+								Line:  699,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`[]byte`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 472, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 693, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            486,
-					SyntaxPatterns:  []ir.PatternString{{Line: 486, Value: "bytes.Index([]byte($x), $y)"}},
+					Line:            707,
+					SyntaxPatterns:  []ir.PatternString{{Line: 707, Value: "bytes.Index([]byte($x), $y)"}},
 					ReportTemplate:  "$$ => strings.Index($x, string($y))",
 					SuggestTemplate: "strings.Index($x, string($y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 486,
+						Line: 707,
 						Op:   ir.FilterAndOp,
 						Src:  "canOptimizeBytes(m)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 486,
+								Line: 707,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure &&\n\t!m[\"y\"].Node.Is(`CallExpr`)",
 								Args: []ir.FilterExpr{
 									{
-										Line: 486,
+										Line: 707,
 										Op:   ir.FilterAndOp,
 										Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure",
 										Args: []ir.FilterExpr{
-											{Line: 486, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-											{Line: 486, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+											{Line: 707, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+											{Line: 707, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 										},
 									},
 									{
-										Line: 482,
+										Line: 703,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Node.Is(`CallExpr`)",
 										Args: []ir.FilterExpr{{
-											Line:  486,
+											Line:  707,
 											Op:    ir.FilterVarNodeIsOp,
 											Src:   "m[\"y\"].Node.Is(`CallExpr`)",
 											Value: "y",
-											Args:  []ir.FilterExpr{{Line: 482, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
+											Args:  []ir.FilterExpr{{Line: 703, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
 										}},
 									},
 								},
 							},
 							{
-								Line:  486,
+								Line:  707,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 483, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 704, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            487,
-					SyntaxPatterns:  []ir.PatternString{{Line: 487, Value: "bytes.Contains([]byte($x), $y)"}},
+					Line:            708,
+					SyntaxPatterns:  []ir.PatternString{{Line: 708, Value: "bytes.Contains([]byte($x), $y)"}},
 					ReportTemplate:  "$$ => strings.Contains($x, string($y))",
 					SuggestTemplate: "strings.Contains($x, string($y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 487,
+						Line: 708,
 						Op:   ir.FilterAndOp,
 						Src:  "canOptimizeBytes(m)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 487,
+								Line: 708,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure &&\n\t!m[\"y\"].Node.Is(`CallExpr`)",
 								Args: []ir.FilterExpr{
 									{
-										Line: 487,
+										Line: 708,
 										Op:   ir.FilterAndOp,
 										Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure",
 										Args: []ir.FilterExpr{
-											{Line: 487, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-											{Line: 487, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+											{Line: 708, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+											{Line: 708, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 										},
 									},
 									{
-										Line: 482,
+										Line: 703,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Node.Is(`CallExpr`)",
 										Args: []ir.FilterExpr{{
-											Line:  487,
+											Line:  708,
 											Op:    ir.FilterVarNodeIsOp,
 											Src:   "m[\"y\"].Node.Is(`CallExpr`)",
 											Value: "y",
-											Args:  []ir.FilterExpr{{Line: 482, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
+											Args:  []ir.FilterExpr{{Line: 703, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
 										}},
 									},
 								},
 							},
 							{
-								Line:  487,
+								Line:  708,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 483, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 704, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            488,
-					SyntaxPatterns:  []ir.PatternString{{Line: 488, Value: "bytes.HasPrefix([]byte($x), $y)"}},
+					Line:            709,
+					SyntaxPatterns:  []ir.PatternString{{Line: 709, Value: "bytes.HasPrefix([]byte($x), $y)"}},
 					ReportTemplate:  "$$ => strings.HasPrefix($x, string($y))",
 					SuggestTemplate: "strings.HasPrefix($x, string($y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 488,
+						Line: 709,
 						Op:   ir.FilterAndOp,
 						Src:  "canOptimizeBytes(m)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 488,
+								Line: 709,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure &&\n\t!m[\"y\"].Node.Is(`CallExpr`)",
 								Args: []ir.FilterExpr{
 									{
-										Line: 488,
+										Line: 709,
 										Op:   ir.FilterAndOp,
 										Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure",
 										Args: []ir.FilterExpr{
-											{Line: 488, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-											{Line: 488, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+											{Line: 709, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+											{Line: 709, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 										},
 									},
 									{
-										Line: 482,
+										Line: 703,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Node.Is(`CallExpr`)",
 										Args: []ir.FilterExpr{{
-											Line:  488,
+											Line:  709,
 											Op:    ir.FilterVarNodeIsOp,
 											Src:   "m[\"y\"].Node.Is(`CallExpr`)",
 											Value: "y",
-											Args:  []ir.FilterExpr{{Line: 482, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
+											Args:  []ir.FilterExpr{{Line: 703, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
 										}},
 									},
 								},
 							},
 							{
-								Line:  488,
+								Line:  709,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 483, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 704, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            489,
-					SyntaxPatterns:  []ir.PatternString{{Line: 489, Value: "bytes.HasSuffix([]byte($x), $y)"}},
+					Line:            710,
+					SyntaxPatterns:  []ir.PatternString{{Line: 710, Value: "bytes.HasSuffix([]byte($x), $y)"}},
 					ReportTemplate:  "$$ => strings.HasSuffix($x, string($y))",
 					SuggestTemplate: "strings.HasSuffix($x, string($y))",
 					WhereExpr: ir.FilterExpr{
-						Line: 489,
+						Line: 710,
 						Op:   ir.FilterAndOp,
 						Src:  "canOptimizeBytes(m)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 489,
+								Line: 710,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure &&\n\t!m[\"y\"].Node.Is(`CallExpr`)",
 								Args: []ir.FilterExpr{
 									{
-										Line: 489,
+										Line: 710,
 										Op:   ir.FilterAndOp,
 										Src:  "m[\"x\"].Pure &&\n\n\tm[\"y\"].Pure",
 										Args: []ir.FilterExpr{
-											{Line: 489, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
-											{Line: 489, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
+											{Line: 710, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+											{Line: 710, Op: ir.FilterVarPureOp, Src: "m[\"y\"].Pure", Value: "y"},
 										},
 									},
 									{
-										Line: 482,
+										Line: 703,
 										Op:   ir.FilterNotOp,
 										Src:  "!m[\"y\"].Node.Is(`CallExpr`)",
 										Args: []ir.FilterExpr{{
-											Line:  489,
+											Line:  710,
 											Op:    ir.FilterVarNodeIsOp,
 											Src:   "m[\"y\"].Node.Is(`CallExpr`)",
 											Value: "y",
-											Args:  []ir.FilterExpr{{Line: 482, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
+											Args:  []ir.FilterExpr{{Line: 703, Op: ir.FilterStringOp, Src: "`CallExpr`", Value: "CallExpr"}},
 										}},
 									},
 								},
 							},
 							{
-								Line:  489,
+								Line:  710,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`string`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 483, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 704, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
@@ -2378,7 +2913,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        496,
+			Line:        718,
 			Name:        "writeByte",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score1"},
@@ -2386,46 +2921,46 @@ var Universal = &ir.File{
 			DocBefore:   "w.WriteRune('\\n')",
 			DocAfter:    "w.WriteByte('\\n')",
 			Rules: []ir.Rule{{
-				Line:            500,
-				SyntaxPatterns:  []ir.PatternString{{Line: 500, Value: "$w.WriteRune($c)"}},
+				Line:            722,
+				SyntaxPatterns:  []ir.PatternString{{Line: 722, Value: "$w.WriteRune($c)"}},
 				ReportTemplate:  "$$ => $w.WriteByte($c)",
 				SuggestTemplate: "$w.WriteByte($c)",
 				WhereExpr: ir.FilterExpr{
-					Line: 501,
+					Line: 723,
 					Op:   ir.FilterAndOp,
 					Src:  "m[\"w\"].Type.HasMethod(`io.ByteWriter.WriteByte`) && (m[\"c\"].Const && m[\"c\"].Value.Int() < runeSelf)",
 					Args: []ir.FilterExpr{
 						{
-							Line:  501,
+							Line:  723,
 							Op:    ir.FilterVarTypeHasMethodOp,
 							Src:   "m[\"w\"].Type.HasMethod(`io.ByteWriter.WriteByte`)",
 							Value: "w",
-							Args:  []ir.FilterExpr{{Line: 501, Op: ir.FilterStringOp, Src: "`io.ByteWriter.WriteByte`", Value: "io.ByteWriter.WriteByte"}},
+							Args:  []ir.FilterExpr{{Line: 723, Op: ir.FilterStringOp, Src: "`io.ByteWriter.WriteByte`", Value: "io.ByteWriter.WriteByte"}},
 						},
 						{
-							Line: 501,
+							Line: 723,
 							Op:   ir.FilterAndOp,
 							Src:  "(m[\"c\"].Const && m[\"c\"].Value.Int() < runeSelf)",
 							Args: []ir.FilterExpr{
 								{
-									Line:  501,
+									Line:  723,
 									Op:    ir.FilterVarConstOp,
 									Src:   "m[\"c\"].Const",
 									Value: "c",
 								},
 								{
-									Line: 501,
+									Line: 723,
 									Op:   ir.FilterLtOp,
 									Src:  "m[\"c\"].Value.Int() < runeSelf",
 									Args: []ir.FilterExpr{
 										{
-											Line:  501,
+											Line:  723,
 											Op:    ir.FilterVarValueIntOp,
 											Src:   "m[\"c\"].Value.Int()",
 											Value: "c",
 										},
 										{
-											Line:  501,
+											Line:  723,
 											Op:    ir.FilterIntOp,
 											Src:   "runeSelf",
 											Value: int64(128),
@@ -2439,7 +2974,7 @@ var Universal = &ir.File{
 			}},
 		},
 		{
-			Line:        509,
+			Line:        732,
 			Name:        "sliceClear",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
@@ -2447,23 +2982,23 @@ var Universal = &ir.File{
 			DocBefore:   "for i := 0; i < len(buf); i++ { buf[i] = 0 }",
 			DocAfter:    "for i := range buf { buf[i] = 0 }",
 			Rules: []ir.Rule{{
-				Line:            510,
-				SyntaxPatterns:  []ir.PatternString{{Line: 510, Value: "for $i := 0; $i < len($xs); $i++ { $xs[$i] = $zero }"}},
+				Line:            733,
+				SyntaxPatterns:  []ir.PatternString{{Line: 733, Value: "for $i := 0; $i < len($xs); $i++ { $xs[$i] = $zero }"}},
 				ReportTemplate:  "for ... { ... } => for $i := range $xs { $xs[$i] = $zero }",
 				SuggestTemplate: "for $i := range $xs { $xs[$i] = $zero }",
 				WhereExpr: ir.FilterExpr{
-					Line: 511,
+					Line: 734,
 					Op:   ir.FilterEqOp,
 					Src:  "m[\"zero\"].Value.Int() == 0",
 					Args: []ir.FilterExpr{
 						{
-							Line:  511,
+							Line:  734,
 							Op:    ir.FilterVarValueIntOp,
 							Src:   "m[\"zero\"].Value.Int()",
 							Value: "zero",
 						},
 						{
-							Line:  511,
+							Line:  734,
 							Op:    ir.FilterIntOp,
 							Src:   "0",
 							Value: int64(0),
@@ -2473,192 +3008,972 @@ var Universal = &ir.File{
 			}},
 		},
 		{
-			Line:        520,
+			Line:        745,
 			Name:        "mapClear",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2", "reformat"},
 			DocSummary:  "Detects cases where map clear idiom can be used",
-			DocBefore:   "o.set = make(map[string]int, len(o.set))",
-			DocAfter:    "for k := range o.set { delete(o.set, k) }",
-			Rules: []ir.Rule{{
-				Line:            521,
-				SyntaxPatterns:  []ir.PatternString{{Line: 521, Value: "$m = make(map[$_]$_, len($m))"}},
-				ReportTemplate:  "$$ => for k := range $m { delete($m, k) }",
-				SuggestTemplate: "for k := range $m { delete($m, k) }",
-			}},
-		},
-		{
-			Line:        527,
-			Name:        "mapAssignOp",
-			MatcherName: "m",
-			DocTags:     []string{"o1", "score2"},
-			DocSummary:  "Detects map <op>= patterns that can be rewritten to avoid double hashing",
+			DocBefore:   "for k := range o.set { delete(o.set, k) }",
+			DocAfter:    "clear(o.set)",
+			DocNote:     "before Go 1.21, a map re-make is rewritten as a delete loop instead",
 			Rules: []ir.Rule{
 				{
-					Line: 528,
-					SyntaxPatterns: []ir.PatternString{
-						{Line: 528, Value: "$m[$k] = $m[$k] + 1"},
-						{Line: 528, Value: "$m[$k] += 1"},
-					},
-					ReportTemplate:  "$$ => $m[$k]++",
-					SuggestTemplate: "$m[$k]++",
-					WhereExpr: ir.FilterExpr{
-						Line: 529,
-						Op:   ir.FilterAndOp,
-						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
-						Args: []ir.FilterExpr{
-							{
-								Line:  529,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
-								Value: "m",
-								Args:  []ir.FilterExpr{{Line: 529, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
-							},
-							{Line: 529, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
-						},
-					},
-				},
-				{
-					Line:            532,
-					SyntaxPatterns:  []ir.PatternString{{Line: 532, Value: "$m[$k] = $m[$k] + $v"}},
-					ReportTemplate:  "$$ => $m[$k] += $v",
-					SuggestTemplate: "$m[$k] += $v",
-					WhereExpr: ir.FilterExpr{
-						Line: 533,
-						Op:   ir.FilterAndOp,
-						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
-						Args: []ir.FilterExpr{
-							{
-								Line:  533,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
-								Value: "m",
-								Args:  []ir.FilterExpr{{Line: 533, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
-							},
-							{Line: 533, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
-						},
-					},
-				},
-				{
-					Line:            535,
-					SyntaxPatterns:  []ir.PatternString{{Line: 535, Value: "$m[$k] = $m[$k] - $v"}},
-					ReportTemplate:  "$$ => $m[$k] -= $v",
-					SuggestTemplate: "$m[$k] -= $v",
+					Line:            748,
+					SyntaxPatterns:  []ir.PatternString{{Line: 748, Value: "for $k := range $m { delete($m, $k) }"}},
+					ReportTemplate:  "for ... { ... } => clear($m)",
+					SuggestTemplate: "clear($m)",
 					WhereExpr: ir.FilterExpr{
-						Line: 536,
+						Line: 749,
 						Op:   ir.FilterAndOp,
-						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
+						Src:  "m.GoVersion().GreaterEqThan(\"1.21\") && m[\"m\"].Pure",
 						Args: []ir.FilterExpr{
 							{
-								Line:  536,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
-								Value: "m",
-								Args:  []ir.FilterExpr{{Line: 536, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+								Line:  749,
+								Op:    ir.FilterGoVersionGreaterEqThanOp,
+								Src:   "m.GoVersion().GreaterEqThan(\"1.21\")",
+								Value: "1.21",
 							},
-							{Line: 536, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
+							{Line: 749, Op: ir.FilterVarPureOp, Src: "m[\"m\"].Pure", Value: "m"},
 						},
 					},
 				},
 				{
-					Line:            538,
-					SyntaxPatterns:  []ir.PatternString{{Line: 538, Value: "$m[$k] = $m[$k] * $v"}},
-					ReportTemplate:  "$$ => $m[$k] *= $v",
-					SuggestTemplate: "$m[$k] *= $v",
+					Line:            753,
+					SyntaxPatterns:  []ir.PatternString{{Line: 753, Value: "$m = make(map[$_]$_, len($m))"}},
+					ReportTemplate:  "$$ => clear($m)",
+					SuggestTemplate: "clear($m)",
 					WhereExpr: ir.FilterExpr{
-						Line: 539,
-						Op:   ir.FilterAndOp,
-						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
-						Args: []ir.FilterExpr{
-							{
-								Line:  539,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
-								Value: "m",
-								Args:  []ir.FilterExpr{{Line: 539, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
-							},
-							{Line: 539, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
-						},
+						Line:  754,
+						Op:    ir.FilterGoVersionGreaterEqThanOp,
+						Src:   "m.GoVersion().GreaterEqThan(\"1.21\")",
+						Value: "1.21",
 					},
 				},
 				{
-					Line:            541,
-					SyntaxPatterns:  []ir.PatternString{{Line: 541, Value: "$m[$k] = $m[$k] / $v"}},
-					ReportTemplate:  "$$ => $m[$k] /= $v",
-					SuggestTemplate: "$m[$k] /= $v",
+					Line:            756,
+					SyntaxPatterns:  []ir.PatternString{{Line: 756, Value: "$m = make(map[$_]$_, len($m))"}},
+					ReportTemplate:  "$$ => for k := range $m { delete($m, k) }",
+					SuggestTemplate: "for k := range $m { delete($m, k) }",
 					WhereExpr: ir.FilterExpr{
-						Line: 542,
-						Op:   ir.FilterAndOp,
-						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
-						Args: []ir.FilterExpr{
-							{
-								Line:  542,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
-								Value: "m",
-								Args:  []ir.FilterExpr{{Line: 542, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
-							},
-							{Line: 542, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
-						},
+						Line:  757,
+						Op:    ir.FilterGoVersionLessThanOp,
+						Src:   "m.GoVersion().LessThan(\"1.21\")",
+						Value: "1.21",
 					},
 				},
 			},
 		},
 		{
-			Line:        551,
-			Name:        "utf8DecodeRune",
+			Line:        767,
+			Name:        "slicesSort",
 			MatcherName: "m",
-			DocTags:     []string{"o1", "score4"},
-			DocSummary:  "Detects expressions like []rune(s)[0] that may cause unwanted rune slice allocation",
-			DocBefore:   "r := []rune(s)[0]",
-			DocAfter:    "r, _ := utf8.DecodeRuneInString(s)",
-			DocNote:     "See Go issue for details: https://github.com/golang/go/issues/45260",
+			DocTags:     []string{"o1", "score3"},
+			DocSummary:  "Detects sort.Slice calls on basic type slices that can use slices.Sort",
+			DocBefore:   "sort.Slice(xs, func(i, j int) bool { return xs[i] < xs[j] })",
+			DocAfter:    "slices.Sort(xs)",
+			DocNote:     "only the unnamed element types are matched, a descending order is report-only",
 			Rules: []ir.Rule{
 				{
-					Line:            558,
-					SyntaxPatterns:  []ir.PatternString{{Line: 558, Value: "$ch := []rune($s)[0]"}},
-					ReportTemplate:  "$$ => $ch, _ := utf8.DecodeRuneInString($ch)",
-					SuggestTemplate: "$ch, _ := utf8.DecodeRuneInString($ch)",
+					Line:            787,
+					SyntaxPatterns:  []ir.PatternString{{Line: 787, Value: "sort.Slice($s, func($i, $j int) bool { return $s[$i] < $s[$j] })"}},
+					ReportTemplate:  "$$ => slices.Sort($s)",
+					SuggestTemplate: "slices.Sort($s)",
 					WhereExpr: ir.FilterExpr{
-						Line: 559,
+						Line: 788,
 						Op:   ir.FilterAndOp,
-						Src:  "m[\"s\"].Type.Is(`string`) && m.File().Imports(`unicode/utf8`)",
+						Src:  "m.GoVersion().GreaterEqThan(\"1.21\") && m[\"s\"].Pure && isOrderedSlice(m[\"s\"])",
 						Args: []ir.FilterExpr{
 							{
-								Line:  559,
-								Op:    ir.FilterVarTypeIsOp,
-								Src:   "m[\"s\"].Type.Is(`string`)",
-								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 559, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Line: 788,
+								Op:   ir.FilterAndOp,
+								Src:  "m.GoVersion().GreaterEqThan(\"1.21\") && m[\"s\"].Pure",
+								Args: []ir.FilterExpr{
+									{
+										Line:  788,
+										Op:    ir.FilterGoVersionGreaterEqThanOp,
+										Src:   "m.GoVersion().GreaterEqThan(\"1.21\")",
+										Value: "1.21",
+									},
+									{Line: 788, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
+								},
 							},
 							{
-								Line:  559,
-								Op:    ir.FilterFileImportsOp,
-								Src:   "m.File().Imports(`unicode/utf8`)",
-								Value: "unicode/utf8",
-							},
-						},
-					},
+								Line: 788,
+								Op:   ir.FilterOrOp,
+								Src:  "isOrderedSlice(m[\"s\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line: 788,
+										Op:   ir.FilterOrOp,
+										Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`) ||\n\n\tm[\"s\"].Type.Is(`[]uint64`) ||\n\n\tm[\"s\"].Type.Is(`[]uintptr`) ||\n\n\tm[\"s\"].Type.Is(`[]float32`) ||\n\n\tm[\"s\"].Type.Is(`[]float64`)",
+										Args: []ir.FilterExpr{
+											{
+												Line: 788,
+												Op:   ir.FilterOrOp,
+												Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`) ||\n\n\tm[\"s\"].Type.Is(`[]uint64`) ||\n\n\tm[\"s\"].Type.Is(`[]uintptr`) ||\n\n\tm[\"s\"].Type.Is(`[]float32`)",
+												Args: []ir.FilterExpr{
+													{
+														Line: 788,
+														Op:   ir.FilterOrOp,
+														Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`) ||\n\n\tm[\"s\"].Type.Is(`[]uint64`) ||\n\n\tm[\"s\"].Type.Is(`[]uintptr`)",
+														Args: []ir.FilterExpr{
+															{
+																Line: 788,
+																Op:   ir.FilterOrOp,
+																Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`) ||\n\n\tm[\"s\"].Type.Is(`[]uint64`)",
+																Args: []ir.FilterExpr{
+																	{
+																		Line: 788,
+																		Op:   ir.FilterOrOp,
+																		Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`)",
+																		Args: []ir.FilterExpr{
+																			{
+																				Line: 788,
+																				Op:   ir.FilterOrOp,
+																				Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`)",
+																				Args: []ir.FilterExpr{
+																					{
+																						Line: 788,
+																						Op:   ir.FilterOrOp,
+																						Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`)",
+																						Args: []ir.FilterExpr{
+																							{
+																								Line: 788,
+																								Op:   ir.FilterOrOp,
+																								Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`)",
+																								Args: []ir.FilterExpr{
+																									{
+																										Line: 788,
+																										Op:   ir.FilterOrOp,
+																										Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`)",
+																										Args: []ir.FilterExpr{
+																											{
+																												Line: 788,
+																												Op:   ir.FilterOrOp,
+																												Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`)",
+																												Args: []ir.FilterExpr{
+																													{
+																														Line: 788,
+																														Op:   ir.FilterOrOp,
+																														Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`)",
+																														Args: []ir.FilterExpr{
+																															{
+																																Line: 788,
+																																Op:   ir.FilterOrOp,
+																																Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`)",
+																																Args: []ir.FilterExpr{
+																																	{
+																																		Line:  788,
+																																		Op:    ir.FilterVarTypeIsOp,
+																																		Src:   "m[\"s\"].Type.Is(`[]int`)",
+																																		Value: "s",
+																																		Args:  []ir.FilterExpr{{Line: 779, Op: ir.FilterStringOp, Src: "`[]int`", Value: "[]int"}},
+																																	},
+																																	{
+																																		Line:  788,
+																																		Op:    ir.FilterVarTypeIsOp,
+																																		Src:   "m[\"s\"].Type.Is(`[]int8`)",
+																																		Value: "s",
+																																		Args:  []ir.FilterExpr{{Line: 779, Op: ir.FilterStringOp, Src: "`[]int8`", Value: "[]int8"}},
+																																	},
+																																},
+																															},
+																															{
+																																Line:  788,
+																																Op:    ir.FilterVarTypeIsOp,
+																																Src:   "m[\"s\"].Type.Is(`[]int16`)",
+																																Value: "s",
+																																Args:  []ir.FilterExpr{{Line: 779, Op: ir.FilterStringOp, Src: "`[]int16`", Value: "[]int16"}},
+																															},
+																														},
+																													},
+																													{
+																														Line:  788,
+																														Op:    ir.FilterVarTypeIsOp,
+																														Src:   "m[\"s\"].Type.Is(`[]int32`)",
+																														Value: "s",
+																														Args:  []ir.FilterExpr{{Line: 780, Op: ir.FilterStringOp, Src: "`[]int32`", Value: "[]int32"}},
+																													},
+																												},
+																											},
+																											{
+																												Line:  788,
+																												Op:    ir.FilterVarTypeIsOp,
+																												Src:   "m[\"s\"].Type.Is(`[]int64`)",
+																												Value: "s",
+																												Args:  []ir.FilterExpr{{Line: 780, Op: ir.FilterStringOp, Src: "`[]int64`", Value: "[]int64"}},
+																											},
+																										},
+																									},
+																									{
+																										Line:  788,
+																										Op:    ir.FilterVarTypeIsOp,
+																										Src:   "m[\"s\"].Type.Is(`[]uint`)",
+																										Value: "s",
+																										Args:  []ir.FilterExpr{{Line: 781, Op: ir.FilterStringOp, Src: "`[]uint`", Value: "[]uint"}},
+																									},
+																								},
+																							},
+																							{
+																								Line:  788,
+																								Op:    ir.FilterVarTypeIsOp,
+																								Src:   "m[\"s\"].Type.Is(`[]uint8`)",
+																								Value: "s",
+																								Args:  []ir.FilterExpr{{Line: 781, Op: ir.FilterStringOp, Src: "`[]uint8`", Value: "[]uint8"}},
+																							},
+																						},
+																					},
+																					{
+																						Line:  788,
+																						Op:    ir.FilterVarTypeIsOp,
+																						Src:   "m[\"s\"].Type.Is(`[]uint16`)",
+																						Value: "s",
+																						Args:  []ir.FilterExpr{{Line: 781, Op: ir.FilterStringOp, Src: "`[]uint16`", Value: "[]uint16"}},
+																					},
+																				},
+																			},
+																			{
+																				Line:  788,
+																				Op:    ir.FilterVarTypeIsOp,
+																				Src:   "m[\"s\"].Type.Is(`[]uint32`)",
+																				Value: "s",
+																				Args:  []ir.FilterExpr{{Line: 782, Op: ir.FilterStringOp, Src: "`[]uint32`", Value: "[]uint32"}},
+																			},
+																		},
+																	},
+																	{
+																		Line:  788,
+																		Op:    ir.FilterVarTypeIsOp,
+																		Src:   "m[\"s\"].Type.Is(`[]uint64`)",
+																		Value: "s",
+																		Args:  []ir.FilterExpr{{Line: 782, Op: ir.FilterStringOp, Src: "`[]uint64`", Value: "[]uint64"}},
+																	},
+																},
+															},
+															{
+																Line:  788,
+																Op:    ir.FilterVarTypeIsOp,
+																Src:   "m[\"s\"].Type.Is(`[]uintptr`)",
+																Value: "s",
+																Args:  []ir.FilterExpr{{Line: 782, Op: ir.FilterStringOp, Src: "`[]uintptr`", Value: "[]uintptr"}},
+															},
+														},
+													},
+													{
+														Line:  788,
+														Op:    ir.FilterVarTypeIsOp,
+														Src:   "m[\"s\"].Type.Is(`[]float32`)",
+														Value: "s",
+														Args:  []ir.FilterExpr{{Line: 783, Op: ir.FilterStringOp, Src: "`[]float32`", Value: "[]float32"}},
+													},
+												},
+											},
+											{
+												Line:  788,
+												Op:    ir.FilterVarTypeIsOp,
+												Src:   "m[\"s\"].Type.Is(`[]float64`)",
+												Value: "s",
+												Args:  []ir.FilterExpr{{Line: 783, Op: ir.FilterStringOp, Src: "`[]float64`", Value: "[]float64"}},
+											},
+										},
+									},
+									{
+										Line:  788,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"s\"].Type.Is(`[]string`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 784, Op: ir.FilterStringOp, Src: "`[]string`", Value: "[]string"}},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Line:           793,
+					SyntaxPatterns: []ir.PatternString{{Line: 793, Value: "sort.Slice($s, func($i, $j int) bool { return $s[$i] > $s[$j] })"}},
+					ReportTemplate: "use slices.Sort($s) followed by slices.Reverse($s), or slices.SortFunc with a reversed cmp.Compare",
+					WhereExpr: ir.FilterExpr{
+						Line: 794,
+						Op:   ir.FilterAndOp,
+						Src:  "m.GoVersion().GreaterEqThan(\"1.21\") && m[\"s\"].Pure && isOrderedSlice(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 794,
+								Op:   ir.FilterAndOp,
+								Src:  "m.GoVersion().GreaterEqThan(\"1.21\") && m[\"s\"].Pure",
+								Args: []ir.FilterExpr{
+									{
+										Line:  794,
+										Op:    ir.FilterGoVersionGreaterEqThanOp,
+										Src:   "m.GoVersion().GreaterEqThan(\"1.21\")",
+										Value: "1.21",
+									},
+									{Line: 794, Op: ir.FilterVarPureOp, Src: "m[\"s\"].Pure", Value: "s"},
+								},
+							},
+							{
+								Line: 794,
+								Op:   ir.FilterOrOp,
+								Src:  "isOrderedSlice(m[\"s\"])",
+								Args: []ir.FilterExpr{
+									{
+										Line: 794,
+										Op:   ir.FilterOrOp,
+										Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`) ||\n\n\tm[\"s\"].Type.Is(`[]uint64`) ||\n\n\tm[\"s\"].Type.Is(`[]uintptr`) ||\n\n\tm[\"s\"].Type.Is(`[]float32`) ||\n\n\tm[\"s\"].Type.Is(`[]float64`)",
+										Args: []ir.FilterExpr{
+											{
+												Line: 794,
+												Op:   ir.FilterOrOp,
+												Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`) ||\n\n\tm[\"s\"].Type.Is(`[]uint64`) ||\n\n\tm[\"s\"].Type.Is(`[]uintptr`) ||\n\n\tm[\"s\"].Type.Is(`[]float32`)",
+												Args: []ir.FilterExpr{
+													{
+														Line: 794,
+														Op:   ir.FilterOrOp,
+														Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`) ||\n\n\tm[\"s\"].Type.Is(`[]uint64`) ||\n\n\tm[\"s\"].Type.Is(`[]uintptr`)",
+														Args: []ir.FilterExpr{
+															{
+																Line: 794,
+																Op:   ir.FilterOrOp,
+																Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`) ||\n\n\tm[\"s\"].Type.Is(`[]uint64`)",
+																Args: []ir.FilterExpr{
+																	{
+																		Line: 794,
+																		Op:   ir.FilterOrOp,
+																		Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`) ||\n\n\tm[\"s\"].Type.Is(`[]uint32`)",
+																		Args: []ir.FilterExpr{
+																			{
+																				Line: 794,
+																				Op:   ir.FilterOrOp,
+																				Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`) ||\n\n\tm[\"s\"].Type.Is(`[]uint16`)",
+																				Args: []ir.FilterExpr{
+																					{
+																						Line: 794,
+																						Op:   ir.FilterOrOp,
+																						Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`) ||\n\n\tm[\"s\"].Type.Is(`[]uint8`)",
+																						Args: []ir.FilterExpr{
+																							{
+																								Line: 794,
+																								Op:   ir.FilterOrOp,
+																								Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`) ||\n\n\tm[\"s\"].Type.Is(`[]uint`)",
+																								Args: []ir.FilterExpr{
+																									{
+																										Line: 794,
+																										Op:   ir.FilterOrOp,
+																										Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`) ||\n\n\tm[\"s\"].Type.Is(`[]int64`)",
+																										Args: []ir.FilterExpr{
+																											{
+																												Line: 794,
+																												Op:   ir.FilterOrOp,
+																												Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`) ||\n\n\tm[\"s\"].Type.Is(`[]int32`)",
+																												Args: []ir.FilterExpr{
+																													{
+																														Line: 794,
+																														Op:   ir.FilterOrOp,
+																														Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`) ||\n\n\tm[\"s\"].Type.Is(`[]int16`)",
+																														Args: []ir.FilterExpr{
+																															{
+																																Line: 794,
+																																Op:   ir.FilterOrOp,
+																																Src:  "m[\"s\"].Type.Is(`[]int`) ||\n\n\tm[\"s\"].Type.Is(`[]int8`)",
+																																Args: []ir.FilterExpr{
+																																	{
+																																		Line:  794,
+																																		Op:    ir.FilterVarTypeIsOp,
+																																		Src:   "m[\"s\"].Type.Is(`[]int`)",
+																																		Value: "s",
+																																		Args:  []ir.FilterExpr{{Line: 779, Op: ir.FilterStringOp, Src: "`[]int`", Value: "[]int"}},
+																																	},
+																																	{
+																																		Line:  794,
+																																		Op:    ir.FilterVarTypeIsOp,
+																																		Src:   "m[\"s\"].Type.Is(`[]int8`)",
+																																		Value: "s",
+																																		Args:  []ir.FilterExpr{{Line: 779, Op: ir.FilterStringOp, Src: "`[]int8`", Value: "[]int8"}},
+																																	},
+																																},
+																															},
+																															{
+																																Line:  794,
+																																Op:    ir.FilterVarTypeIsOp,
+																																Src:   "m[\"s\"].Type.Is(`[]int16`)",
+																																Value: "s",
+																																Args:  []ir.FilterExpr{{Line: 779, Op: ir.FilterStringOp, Src: "`[]int16`", Value: "[]int16"}},
+																															},
+																														},
+																													},
+																													{
+																														Line:  794,
+																														Op:    ir.FilterVarTypeIsOp,
+																														Src:   "m[\"s\"].Type.Is(`[]int32`)",
+																														Value: "s",
+																														Args:  []ir.FilterExpr{{Line: 780, Op: ir.FilterStringOp, Src: "`[]int32`", Value: "[]int32"}},
+																													},
+																												},
+																											},
+																											{
+																												Line:  794,
+																												Op:    ir.FilterVarTypeIsOp,
+																												Src:   "m[\"s\"].Type.Is(`[]int64`)",
+																												Value: "s",
+																												Args:  []ir.FilterExpr{{Line: 780, Op: ir.FilterStringOp, Src: "`[]int64`", Value: "[]int64"}},
+																											},
+																										},
+																									},
+																									{
+																										Line:  794,
+																										Op:    ir.FilterVarTypeIsOp,
+																										Src:   "m[\"s\"].Type.Is(`[]uint`)",
+																										Value: "s",
+																										Args:  []ir.FilterExpr{{Line: 781, Op: ir.FilterStringOp, Src: "`[]uint`", Value: "[]uint"}},
+																									},
+																								},
+																							},
+																							{
+																								Line:  794,
+																								Op:    ir.FilterVarTypeIsOp,
+																								Src:   "m[\"s\"].Type.Is(`[]uint8`)",
+																								Value: "s",
+																								Args:  []ir.FilterExpr{{Line: 781, Op: ir.FilterStringOp, Src: "`[]uint8`", Value: "[]uint8"}},
+																							},
+																						},
+																					},
+																					{
+																						Line:  794,
+																						Op:    ir.FilterVarTypeIsOp,
+																						Src:   "m[\"s\"].Type.Is(`[]uint16`)",
+																						Value: "s",
+																						Args:  []ir.FilterExpr{{Line: 781, Op: ir.FilterStringOp, Src: "`[]uint16`", Value: "[]uint16"}},
+																					},
+																				},
+																			},
+																			{
+																				Line:  794,
+																				Op:    ir.FilterVarTypeIsOp,
+																				Src:   "m[\"s\"].Type.Is(`[]uint32`)",
+																				Value: "s",
+																				Args:  []ir.FilterExpr{{Line: 782, Op: ir.FilterStringOp, Src: "`[]uint32`", Value: "[]uint32"}},
+																			},
+																		},
+																	},
+																	{
+																		Line:  794,
+																		Op:    ir.FilterVarTypeIsOp,
+																		Src:   "m[\"s\"].Type.Is(`[]uint64`)",
+																		Value: "s",
+																		Args:  []ir.FilterExpr{{Line: 782, Op: ir.FilterStringOp, Src: "`[]uint64`", Value: "[]uint64"}},
+																	},
+																},
+															},
+															{
+																Line:  794,
+																Op:    ir.FilterVarTypeIsOp,
+																Src:   "m[\"s\"].Type.Is(`[]uintptr`)",
+																Value: "s",
+																Args:  []ir.FilterExpr{{Line: 782, Op: ir.FilterStringOp, Src: "`[]uintptr`", Value: "[]uintptr"}},
+															},
+														},
+													},
+													{
+														Line:  794,
+														Op:    ir.FilterVarTypeIsOp,
+														Src:   "m[\"s\"].Type.Is(`[]float32`)",
+														Value: "s",
+														Args:  []ir.FilterExpr{{Line: 783, Op: ir.FilterStringOp, Src: "`[]float32`", Value: "[]float32"}},
+													},
+												},
+											},
+											{
+												Line:  794,
+												Op:    ir.FilterVarTypeIsOp,
+												Src:   "m[\"s\"].Type.Is(`[]float64`)",
+												Value: "s",
+												Args:  []ir.FilterExpr{{Line: 783, Op: ir.FilterStringOp, Src: "`[]float64`", Value: "[]float64"}},
+											},
+										},
+									},
+									{
+										Line:  794,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"s\"].Type.Is(`[]string`)",
+										Value: "s",
+										Args:  []ir.FilterExpr{{Line: 784, Op: ir.FilterStringOp, Src: "`[]string`", Value: "[]string"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        801,
+			Name:        "mapAssignOp",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score2"},
+			DocSummary:  "Detects map <op>= patterns that can be rewritten to avoid double hashing",
+			Rules: []ir.Rule{
+				{
+					Line: 802,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 802, Value: "$m[$k] = $m[$k] + 1"},
+						{Line: 802, Value: "$m[$k] += 1"},
+					},
+					ReportTemplate:  "$$ => $m[$k]++",
+					SuggestTemplate: "$m[$k]++",
+					WhereExpr: ir.FilterExpr{
+						Line: 803,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
+						Args: []ir.FilterExpr{
+							{
+								Line:  803,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
+								Value: "m",
+								Args:  []ir.FilterExpr{{Line: 803, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+							},
+							{Line: 803, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
+						},
+					},
+				},
+				{
+					Line:            806,
+					SyntaxPatterns:  []ir.PatternString{{Line: 806, Value: "$m[$k] = $m[$k] + $v"}},
+					ReportTemplate:  "$$ => $m[$k] += $v",
+					SuggestTemplate: "$m[$k] += $v",
+					WhereExpr: ir.FilterExpr{
+						Line: 807,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
+						Args: []ir.FilterExpr{
+							{
+								Line:  807,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
+								Value: "m",
+								Args:  []ir.FilterExpr{{Line: 807, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+							},
+							{Line: 807, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
+						},
+					},
+				},
+				{
+					Line:            809,
+					SyntaxPatterns:  []ir.PatternString{{Line: 809, Value: "$m[$k] = $m[$k] - $v"}},
+					ReportTemplate:  "$$ => $m[$k] -= $v",
+					SuggestTemplate: "$m[$k] -= $v",
+					WhereExpr: ir.FilterExpr{
+						Line: 810,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
+						Args: []ir.FilterExpr{
+							{
+								Line:  810,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
+								Value: "m",
+								Args:  []ir.FilterExpr{{Line: 810, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+							},
+							{Line: 810, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
+						},
+					},
+				},
+				{
+					Line:            812,
+					SyntaxPatterns:  []ir.PatternString{{Line: 812, Value: "$m[$k] = $m[$k] * $v"}},
+					ReportTemplate:  "$$ => $m[$k] *= $v",
+					SuggestTemplate: "$m[$k] *= $v",
+					WhereExpr: ir.FilterExpr{
+						Line: 813,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
+						Args: []ir.FilterExpr{
+							{
+								Line:  813,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
+								Value: "m",
+								Args:  []ir.FilterExpr{{Line: 813, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+							},
+							{Line: 813, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
+						},
+					},
+				},
+				{
+					Line:            815,
+					SyntaxPatterns:  []ir.PatternString{{Line: 815, Value: "$m[$k] = $m[$k] / $v"}},
+					ReportTemplate:  "$$ => $m[$k] /= $v",
+					SuggestTemplate: "$m[$k] /= $v",
+					WhereExpr: ir.FilterExpr{
+						Line: 816,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"m\"].Type.Is(`map[$_]$_`) && m[\"k\"].Pure",
+						Args: []ir.FilterExpr{
+							{
+								Line:  816,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"m\"].Type.Is(`map[$_]$_`)",
+								Value: "m",
+								Args:  []ir.FilterExpr{{Line: 816, Op: ir.FilterStringOp, Src: "`map[$_]$_`", Value: "map[$_]$_"}},
+							},
+							{Line: 816, Op: ir.FilterVarPureOp, Src: "m[\"k\"].Pure", Value: "k"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        825,
+			Name:        "stringByteIndex",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score4"},
+			DocSummary:  "Detects expressions like []byte(s)[i] that may cause unwanted byte slice allocation",
+			DocBefore:   "b := []byte(s)[i]",
+			DocAfter:    "b := s[i]",
+			Rules: []ir.Rule{
+				{
+					Line:            838,
+					SyntaxPatterns:  []ir.PatternString{{Line: 838, Value: "$x := []byte($s)[$i]"}},
+					ReportTemplate:  "$$ => $x := $s[$i]",
+					SuggestTemplate: "$x := $s[$i]",
+					WhereExpr: ir.FilterExpr{
+						Line: 838,
+						Op:   ir.FilterAndOp,
+						Src:  "isString(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line:  838,
+								Op:    ir.FilterVarTypeUnderlyingIsOp,
+								Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
+								Value: "s",
+								Args:  []ir.FilterExpr{{Line: 832, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+							},
+							{
+								Line: 832,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"s\"].Node.Is(`BinaryExpr`)",
+								Args: []ir.FilterExpr{{
+									Line:  838,
+									Op:    ir.FilterVarNodeIsOp,
+									Src:   "m[\"s\"].Node.Is(`BinaryExpr`)",
+									Value: "s",
+									Args:  []ir.FilterExpr{{Line: 832, Op: ir.FilterStringOp, Src: "`BinaryExpr`", Value: "BinaryExpr"}},
+								}},
+							},
+						},
+					},
+				},
+				{
+					Line:            839,
+					SyntaxPatterns:  []ir.PatternString{{Line: 839, Value: "$x := []byte($s)[$i]"}},
+					ReportTemplate:  "$$ => $x := ($s)[$i]",
+					SuggestTemplate: "$x := ($s)[$i]",
+					WhereExpr: ir.FilterExpr{
+						Line: 839,
+						Op:   ir.FilterAndOp,
+						Src:  "isStringConcat(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line:  839,
+								Op:    ir.FilterVarTypeUnderlyingIsOp,
+								Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
+								Value: "s",
+								Args:  []ir.FilterExpr{{Line: 835, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+							},
+							{
+								Line:  839,
+								Op:    ir.FilterVarNodeIsOp,
+								Src:   "m[\"s\"].Node.Is(`BinaryExpr`)",
+								Value: "s",
+								Args:  []ir.FilterExpr{{Line: 835, Op: ir.FilterStringOp, Src: "`BinaryExpr`", Value: "BinaryExpr"}},
+							},
+						},
+					},
+				},
+				{
+					Line:            840,
+					SyntaxPatterns:  []ir.PatternString{{Line: 840, Value: "$x = []byte($s)[$i]"}},
+					ReportTemplate:  "$$ => $x = $s[$i]",
+					SuggestTemplate: "$x = $s[$i]",
+					WhereExpr: ir.FilterExpr{
+						Line: 840,
+						Op:   ir.FilterAndOp,
+						Src:  "isString(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line:  840,
+								Op:    ir.FilterVarTypeUnderlyingIsOp,
+								Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
+								Value: "s",
+								Args:  []ir.FilterExpr{{Line: 832, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+							},
+							{
+								Line: 832,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"s\"].Node.Is(`BinaryExpr`)",
+								Args: []ir.FilterExpr{{
+									Line:  840,
+									Op:    ir.FilterVarNodeIsOp,
+									Src:   "m[\"s\"].Node.Is(`BinaryExpr`)",
+									Value: "s",
+									Args:  []ir.FilterExpr{{Line: 832, Op: ir.FilterStringOp, Src: "`BinaryExpr`", Value: "BinaryExpr"}},
+								}},
+							},
+						},
+					},
+				},
+				{
+					Line:            841,
+					SyntaxPatterns:  []ir.PatternString{{Line: 841, Value: "$x = []byte($s)[$i]"}},
+					ReportTemplate:  "$$ => $x = ($s)[$i]",
+					SuggestTemplate: "$x = ($s)[$i]",
+					WhereExpr: ir.FilterExpr{
+						Line: 841,
+						Op:   ir.FilterAndOp,
+						Src:  "isStringConcat(m[\"s\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line:  841,
+								Op:    ir.FilterVarTypeUnderlyingIsOp,
+								Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
+								Value: "s",
+								Args:  []ir.FilterExpr{{Line: 835, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+							},
+							{
+								Line:  841,
+								Op:    ir.FilterVarNodeIsOp,
+								Src:   "m[\"s\"].Node.Is(`BinaryExpr`)",
+								Value: "s",
+								Args:  []ir.FilterExpr{{Line: 835, Op: ir.FilterStringOp, Src: "`BinaryExpr`", Value: "BinaryExpr"}},
+							},
+						},
+					},
+				},
+				{
+					Line:            843,
+					SyntaxPatterns:  []ir.PatternString{{Line: 843, Value: "[]byte($s)[$i]"}},
+					ReportTemplate:  "$$ => $s[$i]",
+					SuggestTemplate: "$s[$i]",
+					WhereExpr: ir.FilterExpr{
+						Line: 844,
+						Op:   ir.FilterAndOp,
+						Src:  "isString(m[\"s\"]) &&\n\t!m[\"$$\"].Node.Parent().Is(`AssignStmt`) &&\n\t!m[\"$$\"].Node.Parent().Is(`UnaryExpr`) &&\n\t!m[\"$$\"].Node.Parent().Is(`IncDecStmt`)",
+						Args: []ir.FilterExpr{
+							{
+								Line: 844,
+								Op:   ir.FilterAndOp,
+								Src:  "isString(m[\"s\"]) &&\n\t!m[\"$$\"].Node.Parent().Is(`AssignStmt`) &&\n\t!m[\"$$\"].Node.Parent().Is(`UnaryExpr`)",
+								Args: []ir.FilterExpr{
+									{
+										Line: 844,
+										Op:   ir.FilterAndOp,
+										Src:  "isString(m[\"s\"]) &&\n\t!m[\"$$\"].Node.Parent().Is(`AssignStmt`)",
+										Args: []ir.FilterExpr{
+											{
+												Line: 844,
+												Op:   ir.FilterAndOp,
+												Src:  "isString(m[\"s\"])",
+												Args: []ir.FilterExpr{
+													{
+														Line:  844,
+														Op:    ir.FilterVarTypeUnderlyingIsOp,
+														Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
+														Value: "s",
+														Args:  []ir.FilterExpr{{Line: 832, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+													},
+													{
+														Line: 832,
+														Op:   ir.FilterNotOp,
+														Src:  "!m[\"s\"].Node.Is(`BinaryExpr`)",
+														Args: []ir.FilterExpr{{
+															Line:  844,
+															Op:    ir.FilterVarNodeIsOp,
+															Src:   "m[\"s\"].Node.Is(`BinaryExpr`)",
+															Value: "s",
+															Args:  []ir.FilterExpr{{Line: 832, Op: ir.FilterStringOp, Src: "`BinaryExpr`", Value: "BinaryExpr"}},
+														}},
+													},
+												},
+											},
+											{
+												Line: 845,
+												Op:   ir.FilterNotOp,
+												Src:  "!m[\"$$\"].Node.Parent().Is(`AssignStmt`)",
+												Args: []ir.FilterExpr{{
+													Line: 845,
+													Op:   ir.FilterRootNodeParentIsOp,
+													Src:  "m[\"$$\"].Node.Parent().Is(`AssignStmt`)",
+													Args: []ir.FilterExpr{{Line: 845, Op: ir.FilterStringOp, Src: "`AssignStmt`", Value: "AssignStmt"}},
+												}},
+											},
+										},
+									},
+									{
+										Line: 846,
+										Op:   ir.FilterNotOp,
+										Src:  "!m[\"$$\"].Node.Parent().Is(`UnaryExpr`)",
+										Args: []ir.FilterExpr{{
+											Line: 846,
+											Op:   ir.FilterRootNodeParentIsOp,
+											Src:  "m[\"$$\"].Node.Parent().Is(`UnaryExpr`)",
+											Args: []ir.FilterExpr{{Line: 846, Op: ir.FilterStringOp, Src: "`UnaryExpr`", Value: "UnaryExpr"}},
+										}},
+									},
+								},
+							},
+							{
+								Line: 847,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"$$\"].Node.Parent().Is(`IncDecStmt`)",
+								Args: []ir.FilterExpr{{
+									Line: 847,
+									Op:   ir.FilterRootNodeParentIsOp,
+									Src:  "m[\"$$\"].Node.Parent().Is(`IncDecStmt`)",
+									Args: []ir.FilterExpr{{Line: 847, Op: ir.FilterStringOp, Src: "`IncDecStmt`", Value: "IncDecStmt"}},
+								}},
+							},
+						},
+					},
+				},
+				{
+					Line:            849,
+					SyntaxPatterns:  []ir.PatternString{{Line: 849, Value: "[]byte($s)[$i]"}},
+					ReportTemplate:  "$$ => ($s)[$i]",
+					SuggestTemplate: "($s)[$i]",
+					WhereExpr: ir.FilterExpr{
+						Line: 850,
+						Op:   ir.FilterAndOp,
+						Src:  "isStringConcat(m[\"s\"]) &&\n\t!m[\"$$\"].Node.Parent().Is(`AssignStmt`) &&\n\t!m[\"$$\"].Node.Parent().Is(`UnaryExpr`) &&\n\t!m[\"$$\"].Node.Parent().Is(`IncDecStmt`)",
+						Args: []ir.FilterExpr{
+							{
+								Line: 850,
+								Op:   ir.FilterAndOp,
+								Src:  "isStringConcat(m[\"s\"]) &&\n\t!m[\"$$\"].Node.Parent().Is(`AssignStmt`) &&\n\t!m[\"$$\"].Node.Parent().Is(`UnaryExpr`)",
+								Args: []ir.FilterExpr{
+									{
+										Line: 850,
+										Op:   ir.FilterAndOp,
+										Src:  "isStringConcat(m[\"s\"]) &&\n\t!m[\"$$\"].Node.Parent().Is(`AssignStmt`)",
+										Args: []ir.FilterExpr{
+											{
+												Line: 850,
+												Op:   ir.FilterAndOp,
+												Src:  "isStringConcat(m[\"s\"])",
+												Args: []ir.FilterExpr{
+													{
+														Line:  850,
+														Op:    ir.FilterVarTypeUnderlyingIsOp,
+														Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
+														Value: "s",
+														Args:  []ir.FilterExpr{{Line: 835, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+													},
+													{
+														Line:  850,
+														Op:    ir.FilterVarNodeIsOp,
+														Src:   "m[\"s\"].Node.Is(`BinaryExpr`)",
+														Value: "s",
+														Args:  []ir.FilterExpr{{Line: 835, Op: ir.FilterStringOp, Src: "`BinaryExpr`", Value: "BinaryExpr"}},
+													},
+												},
+											},
+											{
+												Line: 851,
+												Op:   ir.FilterNotOp,
+												Src:  "!m[\"$$\"].Node.Parent().Is(`AssignStmt`)",
+												Args: []ir.FilterExpr{{
+													Line: 851,
+													Op:   ir.FilterRootNodeParentIsOp,
+													Src:  "m[\"$$\"].Node.Parent().Is(`AssignStmt`)",
+													Args: []ir.FilterExpr{{Line: 851, Op: ir.FilterStringOp, Src: "`AssignStmt`", Value: "AssignStmt"}},
+												}},
+											},
+										},
+									},
+									{
+										Line: 852,
+										Op:   ir.FilterNotOp,
+										Src:  "!m[\"$$\"].Node.Parent().Is(`UnaryExpr`)",
+										Args: []ir.FilterExpr{{
+											Line: 852,
+											Op:   ir.FilterRootNodeParentIsOp,
+											Src:  "m[\"$$\"].Node.Parent().Is(`UnaryExpr`)",
+											Args: []ir.FilterExpr{{Line: 852, Op: ir.FilterStringOp, Src: "`UnaryExpr`", Value: "UnaryExpr"}},
+										}},
+									},
+								},
+							},
+							{
+								Line: 853,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"$$\"].Node.Parent().Is(`IncDecStmt`)",
+								Args: []ir.FilterExpr{{
+									Line: 853,
+									Op:   ir.FilterRootNodeParentIsOp,
+									Src:  "m[\"$$\"].Node.Parent().Is(`IncDecStmt`)",
+									Args: []ir.FilterExpr{{Line: 853, Op: ir.FilterStringOp, Src: "`IncDecStmt`", Value: "IncDecStmt"}},
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        863,
+			Name:        "utf8DecodeRune",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score4"},
+			DocSummary:  "Detects expressions like []rune(s)[0] that may cause unwanted rune slice allocation",
+			DocBefore:   "r := []rune(s)[0]",
+			DocAfter:    "r, _ := utf8.DecodeRuneInString(s)",
+			DocNote:     "See Go issue for details: https://github.com/golang/go/issues/45260",
+			Rules: []ir.Rule{
+				{
+					Line:            870,
+					SyntaxPatterns:  []ir.PatternString{{Line: 870, Value: "$ch := []rune($s)[0]"}},
+					ReportTemplate:  "$$ => $ch, _ := utf8.DecodeRuneInString($ch)",
+					SuggestTemplate: "$ch, _ := utf8.DecodeRuneInString($ch)",
+					WhereExpr: ir.FilterExpr{
+						Line: 871,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"s\"].Type.Is(`string`) && m.File().Imports(`unicode/utf8`)",
+						Args: []ir.FilterExpr{
+							{
+								Line:  871,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"s\"].Type.Is(`string`)",
+								Value: "s",
+								Args:  []ir.FilterExpr{{Line: 871, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+							},
+							{
+								Line:  871,
+								Op:    ir.FilterFileImportsOp,
+								Src:   "m.File().Imports(`unicode/utf8`)",
+								Value: "unicode/utf8",
+							},
+						},
+					},
 				},
 				{
-					Line:            562,
-					SyntaxPatterns:  []ir.PatternString{{Line: 562, Value: "$ch = []rune($s)[0]"}},
+					Line:            874,
+					SyntaxPatterns:  []ir.PatternString{{Line: 874, Value: "$ch = []rune($s)[0]"}},
 					ReportTemplate:  "$$ => $ch, _ = utf8.DecodeRuneInString($ch)",
 					SuggestTemplate: "$ch, _ = utf8.DecodeRuneInString($ch)",
 					WhereExpr: ir.FilterExpr{
-						Line: 563,
+						Line: 875,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"s\"].Type.Is(`string`) && m.File().Imports(`unicode/utf8`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  563,
+								Line:  875,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 563, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 875, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  563,
+								Line:  875,
 								Op:    ir.FilterFileImportsOp,
 								Src:   "m.File().Imports(`unicode/utf8`)",
 								Value: "unicode/utf8",
@@ -2667,27 +3982,27 @@ var Universal = &ir.File{
 					},
 				},
 				{
-					Line:           568,
-					SyntaxPatterns: []ir.PatternString{{Line: 568, Value: "[]rune($s)[0]"}},
+					Line:           880,
+					SyntaxPatterns: []ir.PatternString{{Line: 880, Value: "[]rune($s)[0]"}},
 					ReportTemplate: "use utf8.DecodeRuneInString($s) here",
 					WhereExpr: ir.FilterExpr{
-						Line: 569,
+						Line: 881,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"s\"].Type.Is(`string`) && !m.File().Imports(`unicode/utf8`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  569,
+								Line:  881,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 569, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 881, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line: 569,
+								Line: 881,
 								Op:   ir.FilterNotOp,
 								Src:  "!m.File().Imports(`unicode/utf8`)",
 								Args: []ir.FilterExpr{{
-									Line:  569,
+									Line:  881,
 									Op:    ir.FilterFileImportsOp,
 									Src:   "m.File().Imports(`unicode/utf8`)",
 									Value: "unicode/utf8",
@@ -2699,7 +4014,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        577,
+			Line:        890,
 			Name:        "fprint",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
@@ -2708,66 +4023,401 @@ var Universal = &ir.File{
 			DocAfter:    "fmt.Fprintf(w, \"%x\", 10)",
 			Rules: []ir.Rule{
 				{
-					Line:            578,
-					SyntaxPatterns:  []ir.PatternString{{Line: 578, Value: "$w.Write([]byte(fmt.Sprint($*args)))"}},
+					Line:            891,
+					SyntaxPatterns:  []ir.PatternString{{Line: 891, Value: "$w.Write([]byte(fmt.Sprint($*args)))"}},
 					ReportTemplate:  "$$ => fmt.Fprint($w, $args)",
 					SuggestTemplate: "fmt.Fprint($w, $args)",
 					WhereExpr: ir.FilterExpr{
-						Line:  579,
+						Line:  892,
 						Op:    ir.FilterVarTypeImplementsOp,
 						Src:   "m[\"w\"].Type.Implements(\"io.Writer\")",
 						Value: "w",
-						Args:  []ir.FilterExpr{{Line: 579, Op: ir.FilterStringOp, Src: "\"io.Writer\"", Value: "io.Writer"}},
+						Args:  []ir.FilterExpr{{Line: 892, Op: ir.FilterStringOp, Src: "\"io.Writer\"", Value: "io.Writer"}},
 					},
 				},
 				{
-					Line:            582,
-					SyntaxPatterns:  []ir.PatternString{{Line: 582, Value: "$w.Write([]byte(fmt.Sprintf($*args)))"}},
+					Line:            895,
+					SyntaxPatterns:  []ir.PatternString{{Line: 895, Value: "$w.Write([]byte(fmt.Sprintf($*args)))"}},
 					ReportTemplate:  "$$ => fmt.Fprintf($w, $args)",
 					SuggestTemplate: "fmt.Fprintf($w, $args)",
 					WhereExpr: ir.FilterExpr{
-						Line:  583,
+						Line:  896,
 						Op:    ir.FilterVarTypeImplementsOp,
 						Src:   "m[\"w\"].Type.Implements(\"io.Writer\")",
 						Value: "w",
-						Args:  []ir.FilterExpr{{Line: 583, Op: ir.FilterStringOp, Src: "\"io.Writer\"", Value: "io.Writer"}},
+						Args:  []ir.FilterExpr{{Line: 896, Op: ir.FilterStringOp, Src: "\"io.Writer\"", Value: "io.Writer"}},
 					},
 				},
 				{
-					Line:            586,
-					SyntaxPatterns:  []ir.PatternString{{Line: 586, Value: "$w.Write([]byte(fmt.Sprintln($*args)))"}},
+					Line:            899,
+					SyntaxPatterns:  []ir.PatternString{{Line: 899, Value: "$w.Write([]byte(fmt.Sprintln($*args)))"}},
 					ReportTemplate:  "$$ => fmt.Fprintln($w, $args)",
 					SuggestTemplate: "fmt.Fprintln($w, $args)",
 					WhereExpr: ir.FilterExpr{
-						Line:  587,
+						Line:  900,
 						Op:    ir.FilterVarTypeImplementsOp,
 						Src:   "m[\"w\"].Type.Implements(\"io.Writer\")",
 						Value: "w",
-						Args:  []ir.FilterExpr{{Line: 587, Op: ir.FilterStringOp, Src: "\"io.Writer\"", Value: "io.Writer"}},
+						Args:  []ir.FilterExpr{{Line: 900, Op: ir.FilterStringOp, Src: "\"io.Writer\"", Value: "io.Writer"}},
+					},
+				},
+				{
+					Line:            903,
+					SyntaxPatterns:  []ir.PatternString{{Line: 903, Value: "io.WriteString($w, fmt.Sprint($*args))"}},
+					ReportTemplate:  "$$ => fmt.Fprint($w, $args)",
+					SuggestTemplate: "fmt.Fprint($w, $args)",
+				},
+				{
+					Line:            906,
+					SyntaxPatterns:  []ir.PatternString{{Line: 906, Value: "io.WriteString($w, fmt.Sprintf($*args))"}},
+					ReportTemplate:  "$$ => fmt.Fprintf($w, $args)",
+					SuggestTemplate: "fmt.Fprintf($w, $args)",
+				},
+				{
+					Line:            909,
+					SyntaxPatterns:  []ir.PatternString{{Line: 909, Value: "io.WriteString($w, fmt.Sprintln($*args))"}},
+					ReportTemplate:  "$$ => fmt.Fprintln($w, $args)",
+					SuggestTemplate: "fmt.Fprintln($w, $args)",
+				},
+			},
+		},
+		{
+			Line:        918,
+			Name:        "bufferFprintf",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score2"},
+			DocSummary:  "Detects bytes.Buffer and strings.Builder WriteString calls with fmt.Sprint(f/ln) argument",
+			DocBefore:   "buf.WriteString(fmt.Sprintf(\"%x\", 10))",
+			DocAfter:    "fmt.Fprintf(buf, \"%x\", 10)",
+			Rules: []ir.Rule{
+				{
+					Line:            927,
+					SyntaxPatterns:  []ir.PatternString{{Line: 927, Value: "$buf.WriteString(fmt.Sprintf($format, $args...))"}},
+					ReportTemplate:  "$$ => fmt.Fprintf($buf, $format, $args...)",
+					SuggestTemplate: "fmt.Fprintf($buf, $format, $args...)",
+					WhereExpr: ir.FilterExpr{
+						Line: 928,
+						Op:   ir.FilterAndOp,
+						Src:  "isBufferPtr(m[\"buf\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 920,
+								Op:   ir.FilterOrOp,
+								Src:  "(m[\"buf\"].Type.Is(`*bytes.Buffer`) ||\n\n\tm[\"buf\"].Type.Is(`*strings.Builder`))",
+								Args: []ir.FilterExpr{
+									{
+										Line:  928,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 920, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+									},
+									{
+										Line:  928,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`*strings.Builder`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 920, Op: ir.FilterStringOp, Src: "`*strings.Builder`", Value: "*strings.Builder"}},
+									},
+								},
+							},
+							{
+								Line:  928,
+								Op:    ir.FilterVarTypeImplementsOp,
+								Src:   "m[\"buf\"].Type.Implements(`io.Writer`)",
+								Value: "buf",
+								Args:  []ir.FilterExpr{{Line: 921, Op: ir.FilterStringOp, Src: "`io.Writer`", Value: "io.Writer"}},
+							},
+						},
+					},
+				},
+				{
+					Line:            930,
+					SyntaxPatterns:  []ir.PatternString{{Line: 930, Value: "$buf.WriteString(fmt.Sprint($*args))"}},
+					ReportTemplate:  "$$ => fmt.Fprint($buf, $args)",
+					SuggestTemplate: "fmt.Fprint($buf, $args)",
+					WhereExpr: ir.FilterExpr{
+						Line: 931,
+						Op:   ir.FilterAndOp,
+						Src:  "isBufferPtr(m[\"buf\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 920,
+								Op:   ir.FilterOrOp,
+								Src:  "(m[\"buf\"].Type.Is(`*bytes.Buffer`) ||\n\n\tm[\"buf\"].Type.Is(`*strings.Builder`))",
+								Args: []ir.FilterExpr{
+									{
+										Line:  931,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 920, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+									},
+									{
+										Line:  931,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`*strings.Builder`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 920, Op: ir.FilterStringOp, Src: "`*strings.Builder`", Value: "*strings.Builder"}},
+									},
+								},
+							},
+							{
+								Line:  931,
+								Op:    ir.FilterVarTypeImplementsOp,
+								Src:   "m[\"buf\"].Type.Implements(`io.Writer`)",
+								Value: "buf",
+								Args:  []ir.FilterExpr{{Line: 921, Op: ir.FilterStringOp, Src: "`io.Writer`", Value: "io.Writer"}},
+							},
+						},
+					},
+				},
+				{
+					Line:            933,
+					SyntaxPatterns:  []ir.PatternString{{Line: 933, Value: "$buf.WriteString(fmt.Sprintf($*args))"}},
+					ReportTemplate:  "$$ => fmt.Fprintf($buf, $args)",
+					SuggestTemplate: "fmt.Fprintf($buf, $args)",
+					WhereExpr: ir.FilterExpr{
+						Line: 934,
+						Op:   ir.FilterAndOp,
+						Src:  "isBufferPtr(m[\"buf\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 920,
+								Op:   ir.FilterOrOp,
+								Src:  "(m[\"buf\"].Type.Is(`*bytes.Buffer`) ||\n\n\tm[\"buf\"].Type.Is(`*strings.Builder`))",
+								Args: []ir.FilterExpr{
+									{
+										Line:  934,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 920, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+									},
+									{
+										Line:  934,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`*strings.Builder`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 920, Op: ir.FilterStringOp, Src: "`*strings.Builder`", Value: "*strings.Builder"}},
+									},
+								},
+							},
+							{
+								Line:  934,
+								Op:    ir.FilterVarTypeImplementsOp,
+								Src:   "m[\"buf\"].Type.Implements(`io.Writer`)",
+								Value: "buf",
+								Args:  []ir.FilterExpr{{Line: 921, Op: ir.FilterStringOp, Src: "`io.Writer`", Value: "io.Writer"}},
+							},
+						},
+					},
+				},
+				{
+					Line:            936,
+					SyntaxPatterns:  []ir.PatternString{{Line: 936, Value: "$buf.WriteString(fmt.Sprintln($*args))"}},
+					ReportTemplate:  "$$ => fmt.Fprintln($buf, $args)",
+					SuggestTemplate: "fmt.Fprintln($buf, $args)",
+					WhereExpr: ir.FilterExpr{
+						Line: 937,
+						Op:   ir.FilterAndOp,
+						Src:  "isBufferPtr(m[\"buf\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 920,
+								Op:   ir.FilterOrOp,
+								Src:  "(m[\"buf\"].Type.Is(`*bytes.Buffer`) ||\n\n\tm[\"buf\"].Type.Is(`*strings.Builder`))",
+								Args: []ir.FilterExpr{
+									{
+										Line:  937,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 920, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+									},
+									{
+										Line:  937,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`*strings.Builder`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 920, Op: ir.FilterStringOp, Src: "`*strings.Builder`", Value: "*strings.Builder"}},
+									},
+								},
+							},
+							{
+								Line:  937,
+								Op:    ir.FilterVarTypeImplementsOp,
+								Src:   "m[\"buf\"].Type.Implements(`io.Writer`)",
+								Value: "buf",
+								Args:  []ir.FilterExpr{{Line: 921, Op: ir.FilterStringOp, Src: "`io.Writer`", Value: "io.Writer"}},
+							},
+						},
+					},
+				},
+				{
+					Line:            942,
+					SyntaxPatterns:  []ir.PatternString{{Line: 942, Value: "$buf.WriteString(fmt.Sprintf($format, $args...))"}},
+					ReportTemplate:  "$$ => fmt.Fprintf(&$buf, $format, $args...)",
+					SuggestTemplate: "fmt.Fprintf(&$buf, $format, $args...)",
+					WhereExpr: ir.FilterExpr{
+						Line: 943,
+						Op:   ir.FilterAndOp,
+						Src:  "isBufferValue(m[\"buf\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 924,
+								Op:   ir.FilterOrOp,
+								Src:  "(m[\"buf\"].Type.Is(`bytes.Buffer`) ||\n\n\tm[\"buf\"].Type.Is(`strings.Builder`))",
+								Args: []ir.FilterExpr{
+									{
+										Line:  943,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 924, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+									},
+									{
+										Line:  943,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`strings.Builder`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 924, Op: ir.FilterStringOp, Src: "`strings.Builder`", Value: "strings.Builder"}},
+									},
+								},
+							},
+							{
+								Line:  943,
+								Op:    ir.FilterVarAddressableOp,
+								Src:   "m[\"buf\"].Addressable",
+								Value: "buf",
+							},
+						},
+					},
+				},
+				{
+					Line:            945,
+					SyntaxPatterns:  []ir.PatternString{{Line: 945, Value: "$buf.WriteString(fmt.Sprint($*args))"}},
+					ReportTemplate:  "$$ => fmt.Fprint(&$buf, $args)",
+					SuggestTemplate: "fmt.Fprint(&$buf, $args)",
+					WhereExpr: ir.FilterExpr{
+						Line: 946,
+						Op:   ir.FilterAndOp,
+						Src:  "isBufferValue(m[\"buf\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 924,
+								Op:   ir.FilterOrOp,
+								Src:  "(m[\"buf\"].Type.Is(`bytes.Buffer`) ||\n\n\tm[\"buf\"].Type.Is(`strings.Builder`))",
+								Args: []ir.FilterExpr{
+									{
+										Line:  946,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 924, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+									},
+									{
+										Line:  946,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`strings.Builder`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 924, Op: ir.FilterStringOp, Src: "`strings.Builder`", Value: "strings.Builder"}},
+									},
+								},
+							},
+							{
+								Line:  946,
+								Op:    ir.FilterVarAddressableOp,
+								Src:   "m[\"buf\"].Addressable",
+								Value: "buf",
+							},
+						},
+					},
+				},
+				{
+					Line:            948,
+					SyntaxPatterns:  []ir.PatternString{{Line: 948, Value: "$buf.WriteString(fmt.Sprintf($*args))"}},
+					ReportTemplate:  "$$ => fmt.Fprintf(&$buf, $args)",
+					SuggestTemplate: "fmt.Fprintf(&$buf, $args)",
+					WhereExpr: ir.FilterExpr{
+						Line: 949,
+						Op:   ir.FilterAndOp,
+						Src:  "isBufferValue(m[\"buf\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 924,
+								Op:   ir.FilterOrOp,
+								Src:  "(m[\"buf\"].Type.Is(`bytes.Buffer`) ||\n\n\tm[\"buf\"].Type.Is(`strings.Builder`))",
+								Args: []ir.FilterExpr{
+									{
+										Line:  949,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 924, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+									},
+									{
+										Line:  949,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`strings.Builder`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 924, Op: ir.FilterStringOp, Src: "`strings.Builder`", Value: "strings.Builder"}},
+									},
+								},
+							},
+							{
+								Line:  949,
+								Op:    ir.FilterVarAddressableOp,
+								Src:   "m[\"buf\"].Addressable",
+								Value: "buf",
+							},
+						},
+					},
+				},
+				{
+					Line:            951,
+					SyntaxPatterns:  []ir.PatternString{{Line: 951, Value: "$buf.WriteString(fmt.Sprintln($*args))"}},
+					ReportTemplate:  "$$ => fmt.Fprintln(&$buf, $args)",
+					SuggestTemplate: "fmt.Fprintln(&$buf, $args)",
+					WhereExpr: ir.FilterExpr{
+						Line: 952,
+						Op:   ir.FilterAndOp,
+						Src:  "isBufferValue(m[\"buf\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line: 924,
+								Op:   ir.FilterOrOp,
+								Src:  "(m[\"buf\"].Type.Is(`bytes.Buffer`) ||\n\n\tm[\"buf\"].Type.Is(`strings.Builder`))",
+								Args: []ir.FilterExpr{
+									{
+										Line:  952,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 924, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+									},
+									{
+										Line:  952,
+										Op:    ir.FilterVarTypeIsOp,
+										Src:   "m[\"buf\"].Type.Is(`strings.Builder`)",
+										Value: "buf",
+										Args:  []ir.FilterExpr{{Line: 924, Op: ir.FilterStringOp, Src: "`strings.Builder`", Value: "strings.Builder"}},
+									},
+								},
+							},
+							{
+								Line:  952,
+								Op:    ir.FilterVarAddressableOp,
+								Src:   "m[\"buf\"].Addressable",
+								Value: "buf",
+							},
+						},
 					},
 				},
-				{
-					Line:            590,
-					SyntaxPatterns:  []ir.PatternString{{Line: 590, Value: "io.WriteString($w, fmt.Sprint($*args))"}},
-					ReportTemplate:  "$$ => fmt.Fprint($w, $args)",
-					SuggestTemplate: "fmt.Fprint($w, $args)",
-				},
-				{
-					Line:            593,
-					SyntaxPatterns:  []ir.PatternString{{Line: 593, Value: "io.WriteString($w, fmt.Sprintf($*args))"}},
-					ReportTemplate:  "$$ => fmt.Fprintf($w, $args)",
-					SuggestTemplate: "fmt.Fprintf($w, $args)",
-				},
-				{
-					Line:            596,
-					SyntaxPatterns:  []ir.PatternString{{Line: 596, Value: "io.WriteString($w, fmt.Sprintln($*args))"}},
-					ReportTemplate:  "$$ => fmt.Fprintln($w, $args)",
-					SuggestTemplate: "fmt.Fprintln($w, $args)",
-				},
 			},
 		},
 		{
-			Line:        604,
+			Line:        961,
 			Name:        "writeString",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score4"},
@@ -2775,35 +4425,35 @@ var Universal = &ir.File{
 			DocBefore:   "w.Write([]byte(\"foo\"))",
 			DocAfter:    "w.WriteString(\"foo\")",
 			Rules: []ir.Rule{{
-				Line:            605,
-				SyntaxPatterns:  []ir.PatternString{{Line: 605, Value: "$w.Write([]byte($s))"}},
+				Line:            962,
+				SyntaxPatterns:  []ir.PatternString{{Line: 962, Value: "$w.Write([]byte($s))"}},
 				ReportTemplate:  "$$ => $w.WriteString($s)",
 				SuggestTemplate: "$w.WriteString($s)",
 				WhereExpr: ir.FilterExpr{
-					Line: 606,
+					Line: 963,
 					Op:   ir.FilterAndOp,
 					Src:  "m[\"w\"].Type.HasMethod(\"io.StringWriter.WriteString\") && m[\"s\"].Type.Is(`string`)",
 					Args: []ir.FilterExpr{
 						{
-							Line:  606,
+							Line:  963,
 							Op:    ir.FilterVarTypeHasMethodOp,
 							Src:   "m[\"w\"].Type.HasMethod(\"io.StringWriter.WriteString\")",
 							Value: "w",
-							Args:  []ir.FilterExpr{{Line: 606, Op: ir.FilterStringOp, Src: "\"io.StringWriter.WriteString\"", Value: "io.StringWriter.WriteString"}},
+							Args:  []ir.FilterExpr{{Line: 963, Op: ir.FilterStringOp, Src: "\"io.StringWriter.WriteString\"", Value: "io.StringWriter.WriteString"}},
 						},
 						{
-							Line:  606,
+							Line:  963,
 							Op:    ir.FilterVarTypeIsOp,
 							Src:   "m[\"s\"].Type.Is(`string`)",
 							Value: "s",
-							Args:  []ir.FilterExpr{{Line: 606, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+							Args:  []ir.FilterExpr{{Line: 963, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 						},
 					},
 				},
 			}},
 		},
 		{
-			Line:        614,
+			Line:        972,
 			Name:        "writeBytes",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score4"},
@@ -2812,94 +4462,94 @@ var Universal = &ir.File{
 			DocAfter:    "w.Write(buf.Bytes())",
 			Rules: []ir.Rule{
 				{
-					Line:            619,
-					SyntaxPatterns:  []ir.PatternString{{Line: 619, Value: "io.WriteString($w, $buf.String())"}},
+					Line:            977,
+					SyntaxPatterns:  []ir.PatternString{{Line: 977, Value: "io.WriteString($w, $buf.String())"}},
 					ReportTemplate:  "$$ => $w.Write($buf.Bytes())",
 					SuggestTemplate: "$w.Write($buf.Bytes())",
 					WhereExpr: ir.FilterExpr{
-						Line: 620,
+						Line: 978,
 						Op:   ir.FilterOrOp,
 						Src:  "isBuffer(m[\"buf\"])",
 						Args: []ir.FilterExpr{
 							{
-								Line:  620,
+								Line:  978,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 								Value: "buf",
-								Args:  []ir.FilterExpr{{Line: 616, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+								Args:  []ir.FilterExpr{{Line: 974, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 							},
 							{
-								Line:  620,
+								Line:  978,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 								Value: "buf",
-								Args:  []ir.FilterExpr{{Line: 616, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+								Args:  []ir.FilterExpr{{Line: 974, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            623,
-					SyntaxPatterns:  []ir.PatternString{{Line: 623, Value: "io.WriteString($w, string($buf.Bytes()))"}},
+					Line:            981,
+					SyntaxPatterns:  []ir.PatternString{{Line: 981, Value: "io.WriteString($w, string($buf.Bytes()))"}},
 					ReportTemplate:  "$$ => $w.Write($buf.Bytes())",
 					SuggestTemplate: "$w.Write($buf.Bytes())",
 					WhereExpr: ir.FilterExpr{
-						Line: 624,
+						Line: 982,
 						Op:   ir.FilterOrOp,
 						Src:  "isBuffer(m[\"buf\"])",
 						Args: []ir.FilterExpr{
 							{
-								Line:  624,
+								Line:  982,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 								Value: "buf",
-								Args:  []ir.FilterExpr{{Line: 616, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+								Args:  []ir.FilterExpr{{Line: 974, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 							},
 							{
-								Line:  624,
+								Line:  982,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 								Value: "buf",
-								Args:  []ir.FilterExpr{{Line: 616, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+								Args:  []ir.FilterExpr{{Line: 974, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            627,
-					SyntaxPatterns:  []ir.PatternString{{Line: 627, Value: "$w.WriteString($buf.String())"}},
+					Line:            985,
+					SyntaxPatterns:  []ir.PatternString{{Line: 985, Value: "$w.WriteString($buf.String())"}},
 					ReportTemplate:  "$$ => $w.Write($buf.Bytes())",
 					SuggestTemplate: "$w.Write($buf.Bytes())",
 					WhereExpr: ir.FilterExpr{
-						Line: 628,
+						Line: 986,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"w\"].Type.HasMethod(\"io.Writer.Write\") && isBuffer(m[\"buf\"])",
 						Args: []ir.FilterExpr{
 							{
-								Line:  628,
+								Line:  986,
 								Op:    ir.FilterVarTypeHasMethodOp,
 								Src:   "m[\"w\"].Type.HasMethod(\"io.Writer.Write\")",
 								Value: "w",
-								Args:  []ir.FilterExpr{{Line: 628, Op: ir.FilterStringOp, Src: "\"io.Writer.Write\"", Value: "io.Writer.Write"}},
+								Args:  []ir.FilterExpr{{Line: 986, Op: ir.FilterStringOp, Src: "\"io.Writer.Write\"", Value: "io.Writer.Write"}},
 							},
 							{
-								Line: 628,
+								Line: 986,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  628,
+										Line:  986,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 616, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 974, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  628,
+										Line:  986,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 616, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 974, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
@@ -2907,28 +4557,28 @@ var Universal = &ir.File{
 					},
 				},
 				{
-					Line:            631,
-					SyntaxPatterns:  []ir.PatternString{{Line: 631, Value: "$w.WriteString(string($b))"}},
+					Line:            989,
+					SyntaxPatterns:  []ir.PatternString{{Line: 989, Value: "$w.WriteString(string($b))"}},
 					ReportTemplate:  "$$ => $w.Write($b)",
 					SuggestTemplate: "$w.Write($b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 632,
+						Line: 990,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"w\"].Type.HasMethod(\"io.Writer.Write\") && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  632,
+								Line:  990,
 								Op:    ir.FilterVarTypeHasMethodOp,
 								Src:   "m[\"w\"].Type.HasMethod(\"io.Writer.Write\")",
 								Value: "w",
-								Args:  []ir.FilterExpr{{Line: 632, Op: ir.FilterStringOp, Src: "\"io.Writer.Write\"", Value: "io.Writer.Write"}},
+								Args:  []ir.FilterExpr{{Line: 990, Op: ir.FilterStringOp, Src: "\"io.Writer.Write\"", Value: "io.Writer.Write"}},
 							},
 							{
-								Line:  632,
+								Line:  990,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 632, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 990, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
@@ -2936,7 +4586,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        640,
+			Line:        999,
 			Name:        "bufferString",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score4"},
@@ -2945,620 +4595,620 @@ var Universal = &ir.File{
 			DocAfter:    "bytes.Contains(buf.Bytes(), b)",
 			Rules: []ir.Rule{
 				{
-					Line:            645,
-					SyntaxPatterns:  []ir.PatternString{{Line: 645, Value: "strings.$f($buf1.String(), $buf2.String())"}},
+					Line:            1004,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1004, Value: "strings.$f($buf1.String(), $buf2.String())"}},
 					ReportTemplate:  "$$ => bytes.$f($buf1.Bytes(), $buf2.Bytes())",
 					SuggestTemplate: "bytes.$f($buf1.Bytes(), $buf2.Bytes())",
 					WhereExpr: ir.FilterExpr{
-						Line: 647,
+						Line: 1006,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf1\"]) && isBuffer(m[\"buf2\"]) &&\n\tm[\"f\"].Text.Matches(`Compare|Contains|HasPrefix|HasSuffix|EqualFold`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 647,
+								Line: 1006,
 								Op:   ir.FilterAndOp,
 								Src:  "isBuffer(m[\"buf1\"]) && isBuffer(m[\"buf2\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line: 647,
+										Line: 1006,
 										Op:   ir.FilterOrOp,
 										Src:  "isBuffer(m[\"buf1\"])",
 										Args: []ir.FilterExpr{
 											{
-												Line:  647,
+												Line:  1006,
 												Op:    ir.FilterVarTypeIsOp,
 												Src:   "m[\"buf1\"].Type.Is(`bytes.Buffer`)",
 												Value: "buf1",
-												Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+												Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 											},
 											{
-												Line:  647,
+												Line:  1006,
 												Op:    ir.FilterVarTypeIsOp,
 												Src:   "m[\"buf1\"].Type.Is(`*bytes.Buffer`)",
 												Value: "buf1",
-												Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+												Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 											},
 										},
 									},
 									{
-										Line: 647,
+										Line: 1006,
 										Op:   ir.FilterOrOp,
 										Src:  "isBuffer(m[\"buf2\"])",
 										Args: []ir.FilterExpr{
 											{
-												Line:  647,
+												Line:  1006,
 												Op:    ir.FilterVarTypeIsOp,
 												Src:   "m[\"buf2\"].Type.Is(`bytes.Buffer`)",
 												Value: "buf2",
-												Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+												Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 											},
 											{
-												Line:  647,
+												Line:  1006,
 												Op:    ir.FilterVarTypeIsOp,
 												Src:   "m[\"buf2\"].Type.Is(`*bytes.Buffer`)",
 												Value: "buf2",
-												Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+												Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 											},
 										},
 									},
 								},
 							},
 							{
-								Line:  648,
+								Line:  1007,
 								Op:    ir.FilterVarTextMatchesOp,
 								Src:   "m[\"f\"].Text.Matches(`Compare|Contains|HasPrefix|HasSuffix|EqualFold`)",
 								Value: "f",
-								Args:  []ir.FilterExpr{{Line: 648, Op: ir.FilterStringOp, Src: "`Compare|Contains|HasPrefix|HasSuffix|EqualFold`", Value: "Compare|Contains|HasPrefix|HasSuffix|EqualFold"}},
+								Args:  []ir.FilterExpr{{Line: 1007, Op: ir.FilterStringOp, Src: "`Compare|Contains|HasPrefix|HasSuffix|EqualFold`", Value: "Compare|Contains|HasPrefix|HasSuffix|EqualFold"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            652,
-					SyntaxPatterns:  []ir.PatternString{{Line: 652, Value: "strings.Contains($buf.String(), string($b))"}},
+					Line:            1011,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1011, Value: "strings.Contains($buf.String(), string($b))"}},
 					ReportTemplate:  "$$ => bytes.Contains($buf.Bytes(), $b)",
 					SuggestTemplate: "bytes.Contains($buf.Bytes(), $b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 653,
+						Line: 1012,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 653,
+								Line: 1012,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  653,
+										Line:  1012,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  653,
+										Line:  1012,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  653,
+								Line:  1012,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 653, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 1012, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            655,
-					SyntaxPatterns:  []ir.PatternString{{Line: 655, Value: "strings.HasPrefix($buf.String(), string($b))"}},
+					Line:            1014,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1014, Value: "strings.HasPrefix($buf.String(), string($b))"}},
 					ReportTemplate:  "$$ => bytes.HasPrefix($buf.Bytes(), $b)",
 					SuggestTemplate: "bytes.HasPrefix($buf.Bytes(), $b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 656,
+						Line: 1015,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 656,
+								Line: 1015,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  656,
+										Line:  1015,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  656,
+										Line:  1015,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  656,
+								Line:  1015,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 656, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 1015, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            658,
-					SyntaxPatterns:  []ir.PatternString{{Line: 658, Value: "strings.HasSuffix($buf.String(), string($b))"}},
+					Line:            1017,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1017, Value: "strings.HasSuffix($buf.String(), string($b))"}},
 					ReportTemplate:  "$$ => bytes.HasSuffix($buf.Bytes(), $b)",
 					SuggestTemplate: "bytes.HasSuffix($buf.Bytes(), $b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 659,
+						Line: 1018,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 659,
+								Line: 1018,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  659,
+										Line:  1018,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  659,
+										Line:  1018,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  659,
+								Line:  1018,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 659, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 1018, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            661,
-					SyntaxPatterns:  []ir.PatternString{{Line: 661, Value: "strings.Count($buf.String(), string($b))"}},
+					Line:            1020,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1020, Value: "strings.Count($buf.String(), string($b))"}},
 					ReportTemplate:  "$$ => bytes.Count($buf.Bytes(), $b)",
 					SuggestTemplate: "bytes.Count($buf.Bytes(), $b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 662,
+						Line: 1021,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 662,
+								Line: 1021,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  662,
+										Line:  1021,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  662,
+										Line:  1021,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  662,
+								Line:  1021,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 662, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 1021, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            664,
-					SyntaxPatterns:  []ir.PatternString{{Line: 664, Value: "strings.Index($buf.String(), string($b))"}},
+					Line:            1023,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1023, Value: "strings.Index($buf.String(), string($b))"}},
 					ReportTemplate:  "$$ => bytes.Index($buf.Bytes(), $b)",
 					SuggestTemplate: "bytes.Index($buf.Bytes(), $b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 665,
+						Line: 1024,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 665,
+								Line: 1024,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  665,
+										Line:  1024,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  665,
+										Line:  1024,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  665,
+								Line:  1024,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 665, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 1024, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            667,
-					SyntaxPatterns:  []ir.PatternString{{Line: 667, Value: "strings.EqualFold($buf.String(), string($b))"}},
+					Line:            1026,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1026, Value: "strings.EqualFold($buf.String(), string($b))"}},
 					ReportTemplate:  "$$ => bytes.EqualFold($buf.Bytes(), $b)",
 					SuggestTemplate: "bytes.EqualFold($buf.Bytes(), $b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 668,
+						Line: 1027,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 668,
+								Line: 1027,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  668,
+										Line:  1027,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  668,
+										Line:  1027,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  668,
+								Line:  1027,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 668, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 1027, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            671,
-					SyntaxPatterns:  []ir.PatternString{{Line: 671, Value: "strings.Contains($buf.String(), $s)"}},
+					Line:            1030,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1030, Value: "strings.Contains($buf.String(), $s)"}},
 					ReportTemplate:  "$$ => bytes.Contains($buf.Bytes(), []byte($s))",
 					SuggestTemplate: "bytes.Contains($buf.Bytes(), []byte($s))",
 					WhereExpr: ir.FilterExpr{
-						Line: 672,
+						Line: 1031,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 672,
+								Line: 1031,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  672,
+										Line:  1031,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  672,
+										Line:  1031,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  672,
+								Line:  1031,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 672, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 1031, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            674,
-					SyntaxPatterns:  []ir.PatternString{{Line: 674, Value: "strings.HasPrefix($buf.String(), $s)"}},
+					Line:            1033,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1033, Value: "strings.HasPrefix($buf.String(), $s)"}},
 					ReportTemplate:  "$$ => bytes.HasPrefix($buf.Bytes(), []byte($s))",
 					SuggestTemplate: "bytes.HasPrefix($buf.Bytes(), []byte($s))",
 					WhereExpr: ir.FilterExpr{
-						Line: 675,
+						Line: 1034,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 675,
+								Line: 1034,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  675,
+										Line:  1034,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  675,
+										Line:  1034,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  675,
+								Line:  1034,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 675, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 1034, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            677,
-					SyntaxPatterns:  []ir.PatternString{{Line: 677, Value: "strings.HasSuffix($buf.String(), $s)"}},
+					Line:            1036,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1036, Value: "strings.HasSuffix($buf.String(), $s)"}},
 					ReportTemplate:  "$$ => bytes.HasSuffix($buf.Bytes(), []byte($s))",
 					SuggestTemplate: "bytes.HasSuffix($buf.Bytes(), []byte($s))",
 					WhereExpr: ir.FilterExpr{
-						Line: 678,
+						Line: 1037,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 678,
+								Line: 1037,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  678,
+										Line:  1037,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  678,
+										Line:  1037,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  678,
+								Line:  1037,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 678, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 1037, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            680,
-					SyntaxPatterns:  []ir.PatternString{{Line: 680, Value: "strings.Count($buf.String(), $s)"}},
+					Line:            1039,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1039, Value: "strings.Count($buf.String(), $s)"}},
 					ReportTemplate:  "$$ => bytes.Count($buf.Bytes(), []byte($s))",
 					SuggestTemplate: "bytes.Count($buf.Bytes(), []byte($s))",
 					WhereExpr: ir.FilterExpr{
-						Line: 681,
+						Line: 1040,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 681,
+								Line: 1040,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  681,
+										Line:  1040,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  681,
+										Line:  1040,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  681,
+								Line:  1040,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 681, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 1040, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            683,
-					SyntaxPatterns:  []ir.PatternString{{Line: 683, Value: "strings.Index($buf.String(), $s)"}},
+					Line:            1042,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1042, Value: "strings.Index($buf.String(), $s)"}},
 					ReportTemplate:  "$$ => bytes.Index($buf.Bytes(), []byte($s))",
 					SuggestTemplate: "bytes.Index($buf.Bytes(), []byte($s))",
 					WhereExpr: ir.FilterExpr{
-						Line: 684,
+						Line: 1043,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 684,
+								Line: 1043,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  684,
+										Line:  1043,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  684,
+										Line:  1043,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  684,
+								Line:  1043,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 684, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 1043, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            686,
-					SyntaxPatterns:  []ir.PatternString{{Line: 686, Value: "strings.EqualFold($buf.String(), $s)"}},
+					Line:            1045,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1045, Value: "strings.EqualFold($buf.String(), $s)"}},
 					ReportTemplate:  "$$ => bytes.EqualFold($buf.Bytes(), []byte($s))",
 					SuggestTemplate: "bytes.EqualFold($buf.Bytes(), []byte($s))",
 					WhereExpr: ir.FilterExpr{
-						Line: 687,
+						Line: 1046,
 						Op:   ir.FilterAndOp,
 						Src:  "isBuffer(m[\"buf\"]) && m[\"s\"].Type.Is(`string`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 687,
+								Line: 1046,
 								Op:   ir.FilterOrOp,
 								Src:  "isBuffer(m[\"buf\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line:  687,
+										Line:  1046,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 									},
 									{
-										Line:  687,
+										Line:  1046,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 										Value: "buf",
-										Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+										Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 									},
 								},
 							},
 							{
-								Line:  687,
+								Line:  1046,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 687, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 1046, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            690,
-					SyntaxPatterns:  []ir.PatternString{{Line: 690, Value: "[]byte($buf.String())"}},
+					Line:            1049,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1049, Value: "[]byte($buf.String())"}},
 					ReportTemplate:  "$$ => $buf.Bytes()",
 					SuggestTemplate: "$buf.Bytes()",
 					WhereExpr: ir.FilterExpr{
-						Line: 690,
+						Line: 1049,
 						Op:   ir.FilterOrOp,
 						Src:  "isBuffer(m[\"buf\"])",
 						Args: []ir.FilterExpr{
 							{
-								Line:  690,
+								Line:  1049,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 								Value: "buf",
-								Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+								Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 							},
 							{
-								Line:  690,
+								Line:  1049,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 								Value: "buf",
-								Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+								Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 							},
 						},
 					},
 				},
 				{
-					Line: 692,
+					Line: 1051,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 692, Value: "fmt.Fprint($w, $buf.String())"},
-						{Line: 692, Value: "fmt.Fprintf($w, \"%s\", $buf.String())"},
-						{Line: 692, Value: "fmt.Fprintf($w, \"%v\", $buf.String())"},
+						{Line: 1051, Value: "fmt.Fprint($w, $buf.String())"},
+						{Line: 1051, Value: "fmt.Fprintf($w, \"%s\", $buf.String())"},
+						{Line: 1051, Value: "fmt.Fprintf($w, \"%v\", $buf.String())"},
 					},
 					ReportTemplate:  "$$ => $w.Write($buf.Bytes())",
 					SuggestTemplate: "$w.Write($buf.Bytes())",
 					WhereExpr: ir.FilterExpr{
-						Line: 693,
+						Line: 1052,
 						Op:   ir.FilterOrOp,
 						Src:  "isBuffer(m[\"buf\"])",
 						Args: []ir.FilterExpr{
 							{
-								Line:  693,
+								Line:  1052,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"buf\"].Type.Is(`bytes.Buffer`)",
 								Value: "buf",
-								Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
+								Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`bytes.Buffer`", Value: "bytes.Buffer"}},
 							},
 							{
-								Line:  693,
+								Line:  1052,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"buf\"].Type.Is(`*bytes.Buffer`)",
 								Value: "buf",
-								Args:  []ir.FilterExpr{{Line: 642, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+								Args:  []ir.FilterExpr{{Line: 1001, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 							},
 						},
 					},
@@ -3566,58 +5216,58 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        699,
+			Line:        1059,
 			Name:        "rangeExprCopy",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
 			DocSummary:  "Detects array range loops that result in an excessive full data copy",
 			Rules: []ir.Rule{
 				{
-					Line: 700,
+					Line: 1060,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 700, Value: "for $_, $_ := range $e"},
-						{Line: 700, Value: "for $_, $_ = range $e"},
+						{Line: 1060, Value: "for $_, $_ := range $e"},
+						{Line: 1060, Value: "for $_, $_ = range $e"},
 					},
 					ReportTemplate:  "$e => &$e",
 					SuggestTemplate: "&$e",
 					WhereExpr: ir.FilterExpr{
-						Line: 701,
+						Line: 1061,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"e\"].Addressable && m[\"e\"].Type.Is(`[$_]$_`) && m[\"e\"].Type.Size > 2048",
 						Args: []ir.FilterExpr{
 							{
-								Line: 701,
+								Line: 1061,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"e\"].Addressable && m[\"e\"].Type.Is(`[$_]$_`)",
 								Args: []ir.FilterExpr{
 									{
-										Line:  701,
+										Line:  1061,
 										Op:    ir.FilterVarAddressableOp,
 										Src:   "m[\"e\"].Addressable",
 										Value: "e",
 									},
 									{
-										Line:  701,
+										Line:  1061,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"e\"].Type.Is(`[$_]$_`)",
 										Value: "e",
-										Args:  []ir.FilterExpr{{Line: 701, Op: ir.FilterStringOp, Src: "`[$_]$_`", Value: "[$_]$_"}},
+										Args:  []ir.FilterExpr{{Line: 1061, Op: ir.FilterStringOp, Src: "`[$_]$_`", Value: "[$_]$_"}},
 									},
 								},
 							},
 							{
-								Line: 701,
+								Line: 1061,
 								Op:   ir.FilterGtOp,
 								Src:  "m[\"e\"].Type.Size > 2048",
 								Args: []ir.FilterExpr{
 									{
-										Line:  701,
+										Line:  1061,
 										Op:    ir.FilterVarTypeSizeOp,
 										Src:   "m[\"e\"].Type.Size",
 										Value: "e",
 									},
 									{
-										Line:  701,
+										Line:  1061,
 										Op:    ir.FilterIntOp,
 										Src:   "2048",
 										Value: int64(2048),
@@ -3629,37 +5279,37 @@ var Universal = &ir.File{
 					LocationVar: "e",
 				},
 				{
-					Line: 707,
+					Line: 1067,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 707, Value: "for $_, $_ := range $e"},
-						{Line: 707, Value: "for $_, $_ = range $e"},
+						{Line: 1067, Value: "for $_, $_ := range $e"},
+						{Line: 1067, Value: "for $_, $_ = range $e"},
 					},
 					ReportTemplate: "range over big array value expression is ineffective",
 					WhereExpr: ir.FilterExpr{
-						Line: 708,
+						Line: 1068,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"e\"].Type.Is(`[$_]$_`) && m[\"e\"].Type.Size > 2048",
 						Args: []ir.FilterExpr{
 							{
-								Line:  708,
+								Line:  1068,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"e\"].Type.Is(`[$_]$_`)",
 								Value: "e",
-								Args:  []ir.FilterExpr{{Line: 708, Op: ir.FilterStringOp, Src: "`[$_]$_`", Value: "[$_]$_"}},
+								Args:  []ir.FilterExpr{{Line: 1068, Op: ir.FilterStringOp, Src: "`[$_]$_`", Value: "[$_]$_"}},
 							},
 							{
-								Line: 708,
+								Line: 1068,
 								Op:   ir.FilterGtOp,
 								Src:  "m[\"e\"].Type.Size > 2048",
 								Args: []ir.FilterExpr{
 									{
-										Line:  708,
+										Line:  1068,
 										Op:    ir.FilterVarTypeSizeOp,
 										Src:   "m[\"e\"].Type.Size",
 										Value: "e",
 									},
 									{
-										Line:  708,
+										Line:  1068,
 										Op:    ir.FilterIntOp,
 										Src:   "2048",
 										Value: int64(2048),
@@ -3673,39 +5323,39 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        715,
+			Line:        1076,
 			Name:        "rangeToAppend",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
 			DocSummary:  "Detects range loops that can be turned into a single append call",
 			Rules: []ir.Rule{{
-				Line:            716,
-				SyntaxPatterns:  []ir.PatternString{{Line: 716, Value: "for _, $x := range $src { $dst = append($dst, $x) }"}},
+				Line:            1077,
+				SyntaxPatterns:  []ir.PatternString{{Line: 1077, Value: "for _, $x := range $src { $dst = append($dst, $x) }"}},
 				ReportTemplate:  "for … { … } => $dst = append($dst, $src...)",
 				SuggestTemplate: "$dst = append($dst, $src...)",
 				WhereExpr: ir.FilterExpr{
-					Line: 717,
+					Line: 1078,
 					Op:   ir.FilterAndOp,
 					Src:  "m[\"src\"].Type.Is(`[]$_`) && !m[\"dst\"].Contains(`$x`) && m[\"src\"].Type.IdenticalTo(m[\"dst\"])",
 					Args: []ir.FilterExpr{
 						{
-							Line: 717,
+							Line: 1078,
 							Op:   ir.FilterAndOp,
 							Src:  "m[\"src\"].Type.Is(`[]$_`) && !m[\"dst\"].Contains(`$x`)",
 							Args: []ir.FilterExpr{
 								{
-									Line:  717,
+									Line:  1078,
 									Op:    ir.FilterVarTypeIsOp,
 									Src:   "m[\"src\"].Type.Is(`[]$_`)",
 									Value: "src",
-									Args:  []ir.FilterExpr{{Line: 717, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+									Args:  []ir.FilterExpr{{Line: 1078, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
 								},
 								{
-									Line: 717,
+									Line: 1078,
 									Op:   ir.FilterNotOp,
 									Src:  "!m[\"dst\"].Contains(`$x`)",
 									Args: []ir.FilterExpr{{
-										Line:  717,
+										Line:  1078,
 										Op:    ir.FilterVarContainsOp,
 										Src:   "m[\"dst\"].Contains(`$x`)",
 										Value: "dst",
@@ -3715,7 +5365,7 @@ var Universal = &ir.File{
 							},
 						},
 						{
-							Line:  717,
+							Line:  1078,
 							Op:    ir.FilterVarTypeIdenticalToOp,
 							Src:   "m[\"src\"].Type.IdenticalTo(m[\"dst\"])",
 							Value: "src",
@@ -3726,220 +5376,283 @@ var Universal = &ir.File{
 			}},
 		},
 		{
-			Line:        724,
+			Line:        1086,
 			Name:        "rangeToCopy",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score4"},
 			DocSummary:  "Detects range loops that can be turned into a single copy call",
-			Rules: []ir.Rule{{
-				Line: 725,
-				SyntaxPatterns: []ir.PatternString{
-					{Line: 726, Value: "for $i := range $src { $dst[$i] = $src[$i] }"},
-					{Line: 727, Value: "for $i, $x := range $src { $dst[$i] = $x }"},
-					{Line: 728, Value: "for $i := 0; $i < len($src); $i++ { $dst[$i] = $src[$i] }"},
-				},
-				ReportTemplate:  "for … { … } => copy($dst, $src)",
-				SuggestTemplate: "copy($dst, $src)",
-				WhereExpr: ir.FilterExpr{
-					Line: 729,
-					Op:   ir.FilterAndOp,
-					Src:  "m[\"src\"].Type.Is(`[]$_`) && m[\"src\"].Type.IdenticalTo(m[\"dst\"])",
-					Args: []ir.FilterExpr{
-						{
-							Line:  729,
-							Op:    ir.FilterVarTypeIsOp,
-							Src:   "m[\"src\"].Type.Is(`[]$_`)",
-							Value: "src",
-							Args:  []ir.FilterExpr{{Line: 729, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+			Rules: []ir.Rule{
+				{
+					Line: 1087,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 1088, Value: "for $i := range $src { $dst[$i] = $src[$i] }"},
+						{Line: 1089, Value: "for $i, $x := range $src { $dst[$i] = $x }"},
+						{Line: 1090, Value: "for $i := 0; $i < len($src); $i++ { $dst[$i] = $src[$i] }"},
+					},
+					ReportTemplate:  "for … { … } => copy($dst, $src)",
+					SuggestTemplate: "copy($dst, $src)",
+					WhereExpr: ir.FilterExpr{
+						Line: 1091,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"src\"].Type.Is(`[]$_`) && m[\"src\"].Type.IdenticalTo(m[\"dst\"])",
+						Args: []ir.FilterExpr{
+							{
+								Line:  1091,
+								Op:    ir.FilterVarTypeIsOp,
+								Src:   "m[\"src\"].Type.Is(`[]$_`)",
+								Value: "src",
+								Args:  []ir.FilterExpr{{Line: 1091, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+							},
+							{
+								Line:  1091,
+								Op:    ir.FilterVarTypeIdenticalToOp,
+								Src:   "m[\"src\"].Type.IdenticalTo(m[\"dst\"])",
+								Value: "src",
+								Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "dst"}},
+							},
 						},
-						{
-							Line:  729,
-							Op:    ir.FilterVarTypeIdenticalToOp,
-							Src:   "m[\"src\"].Type.IdenticalTo(m[\"dst\"])",
-							Value: "src",
-							Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "dst"}},
+					},
+				},
+				{
+					Line: 1101,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 1102, Value: "for $i := range $dst { $dst[$i] = $src[$i] }"},
+						{Line: 1103, Value: "for $i := 0; $i < len($dst); $i++ { $dst[$i] = $src[$i] }"},
+					},
+					ReportTemplate: "for … { … } can be replaced with copy($dst, $src) if $dst and $src don't overlap",
+					WhereExpr: ir.FilterExpr{
+						Line: 1104,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"dst\"].Type.Is(`[]$_`) && m[\"src\"].Type.IdenticalTo(m[\"dst\"]) &&\n\tm[\"dst\"].Pure && m[\"src\"].Pure && m[\"dst\"].Text != m[\"src\"].Text",
+						Args: []ir.FilterExpr{
+							{
+								Line: 1104,
+								Op:   ir.FilterAndOp,
+								Src:  "m[\"dst\"].Type.Is(`[]$_`) && m[\"src\"].Type.IdenticalTo(m[\"dst\"]) &&\n\tm[\"dst\"].Pure && m[\"src\"].Pure",
+								Args: []ir.FilterExpr{
+									{
+										Line: 1104,
+										Op:   ir.FilterAndOp,
+										Src:  "m[\"dst\"].Type.Is(`[]$_`) && m[\"src\"].Type.IdenticalTo(m[\"dst\"]) &&\n\tm[\"dst\"].Pure",
+										Args: []ir.FilterExpr{
+											{
+												Line: 1104,
+												Op:   ir.FilterAndOp,
+												Src:  "m[\"dst\"].Type.Is(`[]$_`) && m[\"src\"].Type.IdenticalTo(m[\"dst\"])",
+												Args: []ir.FilterExpr{
+													{
+														Line:  1104,
+														Op:    ir.FilterVarTypeIsOp,
+														Src:   "m[\"dst\"].Type.Is(`[]$_`)",
+														Value: "dst",
+														Args:  []ir.FilterExpr{{Line: 1104, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+													},
+													{
+														Line:  1104,
+														Op:    ir.FilterVarTypeIdenticalToOp,
+														Src:   "m[\"src\"].Type.IdenticalTo(m[\"dst\"])",
+														Value: "src",
+														Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "dst"}},
+													},
+												},
+											},
+											{Line: 1105, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
+										},
+									},
+									{Line: 1105, Op: ir.FilterVarPureOp, Src: "m[\"src\"].Pure", Value: "src"},
+								},
+							},
+							{
+								Line: 1105,
+								Op:   ir.FilterNeqOp,
+								Src:  "m[\"dst\"].Text != m[\"src\"].Text",
+								Args: []ir.FilterExpr{
+									{Line: 1105, Op: ir.FilterVarTextOp, Src: "m[\"dst\"].Text", Value: "dst"},
+									{Line: 1105, Op: ir.FilterVarTextOp, Src: "m[\"src\"].Text", Value: "src"},
+								},
+							},
 						},
 					},
 				},
-			}},
+			},
 		},
 		{
-			Line:        736,
+			Line:        1112,
 			Name:        "sliceSelfCopy",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score4"},
 			DocSummary:  "Detects loops where slice dst=src and they can be replaced with a copy call",
 			Rules: []ir.Rule{{
-				Line:            737,
-				SyntaxPatterns:  []ir.PatternString{{Line: 738, Value: "for $i := 0; i < $n; $i++ { $s[$i] = $s[$offset+$i] }"}},
+				Line:            1113,
+				SyntaxPatterns:  []ir.PatternString{{Line: 1114, Value: "for $i := 0; i < $n; $i++ { $s[$i] = $s[$offset+$i] }"}},
 				ReportTemplate:  "for ... { ... } => copy($s[:$n], $s[$offset:])",
 				SuggestTemplate: "copy($s[:$n], $s[$offset:])",
 				WhereExpr: ir.FilterExpr{
-					Line:  739,
+					Line:  1115,
 					Op:    ir.FilterVarTypeIsOp,
 					Src:   "m[\"s\"].Type.Is(`[]$_`)",
 					Value: "s",
-					Args:  []ir.FilterExpr{{Line: 739, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
+					Args:  []ir.FilterExpr{{Line: 1115, Op: ir.FilterStringOp, Src: "`[]$_`", Value: "[]$_"}},
 				},
 			}},
 		},
 		{
-			Line:        746,
+			Line:        1123,
 			Name:        "rangeRuneSlice",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
 			DocSummary:  "Detects a range over []rune(string) where copying to a new slice is redundant",
 			Rules: []ir.Rule{
 				{
-					Line:            747,
-					SyntaxPatterns:  []ir.PatternString{{Line: 747, Value: "for _, $r := range []rune($s)"}},
+					Line:            1124,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1124, Value: "for _, $r := range []rune($s)"}},
 					ReportTemplate:  "$$ => for _, $r := range $s",
 					SuggestTemplate: "for _, $r := range $s",
 					WhereExpr: ir.FilterExpr{
-						Line:  748,
+						Line:  1125,
 						Op:    ir.FilterVarTypeUnderlyingIsOp,
 						Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
 						Value: "s",
-						Args:  []ir.FilterExpr{{Line: 748, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+						Args:  []ir.FilterExpr{{Line: 1125, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 					},
 				},
 				{
-					Line:            751,
-					SyntaxPatterns:  []ir.PatternString{{Line: 751, Value: "for _, $r = range []rune($s)"}},
+					Line:            1128,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1128, Value: "for _, $r = range []rune($s)"}},
 					ReportTemplate:  "$$ => for _, $r = range $s",
 					SuggestTemplate: "for _, $r = range $s",
 					WhereExpr: ir.FilterExpr{
-						Line:  752,
+						Line:  1129,
 						Op:    ir.FilterVarTypeUnderlyingIsOp,
 						Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
 						Value: "s",
-						Args:  []ir.FilterExpr{{Line: 752, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+						Args:  []ir.FilterExpr{{Line: 1129, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 					},
 				},
 				{
-					Line:            755,
-					SyntaxPatterns:  []ir.PatternString{{Line: 755, Value: "for range []rune($s)"}},
+					Line:            1132,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1132, Value: "for range []rune($s)"}},
 					ReportTemplate:  "$$ => for range $s",
 					SuggestTemplate: "for range $s",
 					WhereExpr: ir.FilterExpr{
-						Line:  756,
+						Line:  1133,
 						Op:    ir.FilterVarTypeUnderlyingIsOp,
 						Src:   "m[\"s\"].Type.Underlying().Is(`string`)",
 						Value: "s",
-						Args:  []ir.FilterExpr{{Line: 756, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+						Args:  []ir.FilterExpr{{Line: 1133, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 					},
 				},
 				{
-					Line:            759,
-					SyntaxPatterns:  []ir.PatternString{{Line: 759, Value: "for _, $r := range string($runes)"}},
+					Line:            1136,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1136, Value: "for _, $r := range string($runes)"}},
 					ReportTemplate:  "$$ => for _, $r := range $runes",
 					SuggestTemplate: "for _, $r := range $runes",
 					WhereExpr: ir.FilterExpr{
-						Line:  760,
+						Line:  1137,
 						Op:    ir.FilterVarTypeUnderlyingIsOp,
 						Src:   "m[\"runes\"].Type.Underlying().Is(`[]rune`)",
 						Value: "runes",
-						Args:  []ir.FilterExpr{{Line: 760, Op: ir.FilterStringOp, Src: "`[]rune`", Value: "[]rune"}},
+						Args:  []ir.FilterExpr{{Line: 1137, Op: ir.FilterStringOp, Src: "`[]rune`", Value: "[]rune"}},
 					},
 				},
 				{
-					Line:            763,
-					SyntaxPatterns:  []ir.PatternString{{Line: 763, Value: "for _, $r = range string($runes)"}},
+					Line:            1140,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1140, Value: "for _, $r = range string($runes)"}},
 					ReportTemplate:  "$$ => for _, $r = range $runes",
 					SuggestTemplate: "for _, $r = range $runes",
 					WhereExpr: ir.FilterExpr{
-						Line:  764,
+						Line:  1141,
 						Op:    ir.FilterVarTypeUnderlyingIsOp,
 						Src:   "m[\"runes\"].Type.Underlying().Is(`[]rune`)",
 						Value: "runes",
-						Args:  []ir.FilterExpr{{Line: 764, Op: ir.FilterStringOp, Src: "`[]rune`", Value: "[]rune"}},
+						Args:  []ir.FilterExpr{{Line: 1141, Op: ir.FilterStringOp, Src: "`[]rune`", Value: "[]rune"}},
 					},
 				},
 			},
 		},
 		{
-			Line:        770,
+			Line:        1148,
 			Name:        "reflectDeepEqual",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
 			DocSummary:  "Detects usages of reflect.DeepEqual that can be rewritten",
 			Rules: []ir.Rule{
 				{
-					Line:            771,
-					SyntaxPatterns:  []ir.PatternString{{Line: 771, Value: "reflect.DeepEqual($x, $y)"}},
+					Line:            1149,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1149, Value: "reflect.DeepEqual($x, $y)"}},
 					ReportTemplate:  "$$ => bytes.Equal($x, $y)",
 					SuggestTemplate: "bytes.Equal($x, $y)",
 					WhereExpr: ir.FilterExpr{
-						Line: 772,
+						Line: 1150,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Type.Is(`[]byte`) && m[\"y\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  772,
+								Line:  1150,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"x\"].Type.Is(`[]byte`)",
 								Value: "x",
-								Args:  []ir.FilterExpr{{Line: 772, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 1150, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 							{
-								Line:  772,
+								Line:  1150,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"y\"].Type.Is(`[]byte`)",
 								Value: "y",
-								Args:  []ir.FilterExpr{{Line: 772, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 1150, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            779,
-					SyntaxPatterns:  []ir.PatternString{{Line: 779, Value: "reflect.DeepEqual($x, $y)"}},
+					Line:            1157,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1157, Value: "reflect.DeepEqual($x, $y)"}},
 					ReportTemplate:  "$$ => ($x == $y)",
 					SuggestTemplate: "($x == $y)",
 					WhereExpr: ir.FilterExpr{
-						Line: 780,
+						Line: 1158,
 						Op:   ir.FilterOrOp,
 						Src:  "(m[\"x\"].Type.Is(`string`) && m[\"y\"].Type.Is(`string`)) ||\n\t(m[\"x\"].Type.OfKind(`numeric`) && m[\"y\"].Type.OfKind(`numeric`))",
 						Args: []ir.FilterExpr{
 							{
-								Line: 780,
+								Line: 1158,
 								Op:   ir.FilterAndOp,
 								Src:  "(m[\"x\"].Type.Is(`string`) && m[\"y\"].Type.Is(`string`))",
 								Args: []ir.FilterExpr{
 									{
-										Line:  780,
+										Line:  1158,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"x\"].Type.Is(`string`)",
 										Value: "x",
-										Args:  []ir.FilterExpr{{Line: 780, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+										Args:  []ir.FilterExpr{{Line: 1158, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 									},
 									{
-										Line:  780,
+										Line:  1158,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"y\"].Type.Is(`string`)",
 										Value: "y",
-										Args:  []ir.FilterExpr{{Line: 780, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+										Args:  []ir.FilterExpr{{Line: 1158, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 									},
 								},
 							},
 							{
-								Line: 781,
+								Line: 1159,
 								Op:   ir.FilterAndOp,
 								Src:  "(m[\"x\"].Type.OfKind(`numeric`) && m[\"y\"].Type.OfKind(`numeric`))",
 								Args: []ir.FilterExpr{
 									{
-										Line:  781,
+										Line:  1159,
 										Op:    ir.FilterVarTypeOfKindOp,
 										Src:   "m[\"x\"].Type.OfKind(`numeric`)",
 										Value: "x",
-										Args:  []ir.FilterExpr{{Line: 781, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+										Args:  []ir.FilterExpr{{Line: 1159, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
 									},
 									{
-										Line:  781,
+										Line:  1159,
 										Op:    ir.FilterVarTypeOfKindOp,
 										Src:   "m[\"y\"].Type.OfKind(`numeric`)",
 										Value: "y",
-										Args:  []ir.FilterExpr{{Line: 781, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
+										Args:  []ir.FilterExpr{{Line: 1159, Op: ir.FilterStringOp, Src: "`numeric`", Value: "numeric"}},
 									},
 								},
 							},
@@ -3947,26 +5660,26 @@ var Universal = &ir.File{
 					},
 				},
 				{
-					Line: 784,
+					Line: 1162,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 784, Value: "reflect.DeepEqual($x, $y{})"},
-						{Line: 784, Value: "reflect.DeepEqual($x{}, $y)"},
+						{Line: 1162, Value: "reflect.DeepEqual($x, $y{})"},
+						{Line: 1162, Value: "reflect.DeepEqual($x{}, $y)"},
 					},
 					ReportTemplate:  "$$ => ($x == $y{})",
 					SuggestTemplate: "($x == $y{})",
 					WhereExpr: ir.FilterExpr{
-						Line: 785,
+						Line: 1163,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"x\"].Comparable && m[\"y\"].Comparable",
 						Args: []ir.FilterExpr{
 							{
-								Line:  785,
+								Line:  1163,
 								Op:    ir.FilterVarComparableOp,
 								Src:   "m[\"x\"].Comparable",
 								Value: "x",
 							},
 							{
-								Line:  785,
+								Line:  1163,
 								Op:    ir.FilterVarComparableOp,
 								Src:   "m[\"y\"].Comparable",
 								Value: "y",
@@ -3977,54 +5690,54 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        791,
+			Line:        1170,
 			Name:        "reflectType",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score1"},
 			DocSummary:  "Detects reflect Type() related patterns that can be optimized",
 			Rules: []ir.Rule{
 				{
-					Line:            792,
-					SyntaxPatterns:  []ir.PatternString{{Line: 792, Value: "reflect.ValueOf($x).Type()"}},
+					Line:            1171,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1171, Value: "reflect.ValueOf($x).Type()"}},
 					ReportTemplate:  "$$ => reflect.TypeOf($x)",
 					SuggestTemplate: "reflect.TypeOf($x)",
 				},
 				{
-					Line:            794,
-					SyntaxPatterns:  []ir.PatternString{{Line: 794, Value: "reflect.TypeOf($x.Interface())"}},
+					Line:            1173,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1173, Value: "reflect.TypeOf($x.Interface())"}},
 					ReportTemplate:  "$$ => $x.Type()",
 					SuggestTemplate: "$x.Type()",
 					WhereExpr: ir.FilterExpr{
-						Line:  795,
+						Line:  1174,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`reflect.Value`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 795, Op: ir.FilterStringOp, Src: "`reflect.Value`", Value: "reflect.Value"}},
+						Args:  []ir.FilterExpr{{Line: 1174, Op: ir.FilterStringOp, Src: "`reflect.Value`", Value: "reflect.Value"}},
 					},
 				},
 				{
-					Line:            798,
-					SyntaxPatterns:  []ir.PatternString{{Line: 798, Value: "fmt.Sprintf(\"%T\", $x.Interface())"}},
+					Line:            1177,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1177, Value: "fmt.Sprintf(\"%T\", $x.Interface())"}},
 					ReportTemplate:  "$$ => $x.Type().String()",
 					SuggestTemplate: "$x.Type().String()",
 					WhereExpr: ir.FilterExpr{
-						Line:  799,
+						Line:  1178,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"x\"].Type.Is(`reflect.Value`)",
 						Value: "x",
-						Args:  []ir.FilterExpr{{Line: 799, Op: ir.FilterStringOp, Src: "`reflect.Value`", Value: "reflect.Value"}},
+						Args:  []ir.FilterExpr{{Line: 1178, Op: ir.FilterStringOp, Src: "`reflect.Value`", Value: "reflect.Value"}},
 					},
 				},
 				{
-					Line:            801,
-					SyntaxPatterns:  []ir.PatternString{{Line: 801, Value: "fmt.Sprintf(\"%T\", $x)"}},
+					Line:            1180,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1180, Value: "fmt.Sprintf(\"%T\", $x)"}},
 					ReportTemplate:  "$$ => reflect.TypeOf($x).String()",
 					SuggestTemplate: "reflect.TypeOf($x).String()",
 				},
 			},
 		},
 		{
-			Line:        809,
+			Line:        1189,
 			Name:        "reflectValueKind",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
@@ -4032,69 +5745,69 @@ var Universal = &ir.File{
 			DocBefore:   "v.Type().Kind()",
 			DocAfter:    "v.Kind()",
 			Rules: []ir.Rule{{
-				Line:            810,
-				SyntaxPatterns:  []ir.PatternString{{Line: 810, Value: "$x.Type().Kind()"}},
+				Line:            1190,
+				SyntaxPatterns:  []ir.PatternString{{Line: 1190, Value: "$x.Type().Kind()"}},
 				ReportTemplate:  "$$ => $x.Kind()",
 				SuggestTemplate: "$x.Kind()",
 				WhereExpr: ir.FilterExpr{
-					Line:  811,
+					Line:  1191,
 					Op:    ir.FilterVarTypeIsOp,
 					Src:   "m[\"x\"].Type.Is(`reflect.Value`)",
 					Value: "x",
-					Args:  []ir.FilterExpr{{Line: 811, Op: ir.FilterStringOp, Src: "`reflect.Value`", Value: "reflect.Value"}},
+					Args:  []ir.FilterExpr{{Line: 1191, Op: ir.FilterStringOp, Src: "`reflect.Value`", Value: "reflect.Value"}},
 				},
 			}},
 		},
 		{
-			Line:        817,
+			Line:        1198,
 			Name:        "arrayCopy",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
 			DocSummary:  "Detects array copies that can be optimized",
 			Rules: []ir.Rule{{
-				Line:            822,
-				SyntaxPatterns:  []ir.PatternString{{Line: 822, Value: "copy($x[:], $y[:])"}},
+				Line:            1203,
+				SyntaxPatterns:  []ir.PatternString{{Line: 1203, Value: "copy($x[:], $y[:])"}},
 				ReportTemplate:  "$$ => $x = $y",
 				SuggestTemplate: "$x = $y",
 				WhereExpr: ir.FilterExpr{
-					Line: 823,
+					Line: 1204,
 					Op:   ir.FilterAndOp,
 					Src:  "m[\"x\"].Type.Is(`[$_]$_`) && m[\"y\"].Type.Is(`[$_]$_`) &&\n\tm[\"x\"].Type.Size == m[\"y\"].Type.Size",
 					Args: []ir.FilterExpr{
 						{
-							Line: 823,
+							Line: 1204,
 							Op:   ir.FilterAndOp,
 							Src:  "m[\"x\"].Type.Is(`[$_]$_`) && m[\"y\"].Type.Is(`[$_]$_`)",
 							Args: []ir.FilterExpr{
 								{
-									Line:  823,
+									Line:  1204,
 									Op:    ir.FilterVarTypeIsOp,
 									Src:   "m[\"x\"].Type.Is(`[$_]$_`)",
 									Value: "x",
-									Args:  []ir.FilterExpr{{Line: 823, Op: ir.FilterStringOp, Src: "`[$_]$_`", Value: "[$_]$_"}},
+									Args:  []ir.FilterExpr{{Line: 1204, Op: ir.FilterStringOp, Src: "`[$_]$_`", Value: "[$_]$_"}},
 								},
 								{
-									Line:  823,
+									Line:  1204,
 									Op:    ir.FilterVarTypeIsOp,
 									Src:   "m[\"y\"].Type.Is(`[$_]$_`)",
 									Value: "y",
-									Args:  []ir.FilterExpr{{Line: 823, Op: ir.FilterStringOp, Src: "`[$_]$_`", Value: "[$_]$_"}},
+									Args:  []ir.FilterExpr{{Line: 1204, Op: ir.FilterStringOp, Src: "`[$_]$_`", Value: "[$_]$_"}},
 								},
 							},
 						},
 						{
-							Line: 824,
+							Line: 1205,
 							Op:   ir.FilterEqOp,
 							Src:  "m[\"x\"].Type.Size == m[\"y\"].Type.Size",
 							Args: []ir.FilterExpr{
 								{
-									Line:  824,
+									Line:  1205,
 									Op:    ir.FilterVarTypeSizeOp,
 									Src:   "m[\"x\"].Type.Size",
 									Value: "x",
 								},
 								{
-									Line:  824,
+									Line:  1205,
 									Op:    ir.FilterVarTypeSizeOp,
 									Src:   "m[\"y\"].Type.Size",
 									Value: "y",
@@ -4106,114 +5819,160 @@ var Universal = &ir.File{
 			}},
 		},
 		{
-			Line:        830,
+			Line:        1215,
+			Name:        "copyReslice",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score1"},
+			DocSummary:  "Detects copy arguments that are resliced to the other argument length",
+			DocBefore:   "copy(dst, src[:len(dst)])",
+			DocAfter:    "copy(dst, src)",
+			DocNote:     "the rewrite is only equivalent if len(src) >= len(dst), so there is no autofix",
+			Rules: []ir.Rule{
+				{
+					Line: 1221,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 1221, Value: "copy($dst, $src[:len($dst)])"},
+						{Line: 1221, Value: "copy($dst, $src[0:len($dst)])"},
+					},
+					ReportTemplate: "$$ can be replaced with copy($dst, $src) if len($src) >= len($dst)",
+					WhereExpr: ir.FilterExpr{
+						Line: 1222,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"dst\"].Pure && m[\"src\"].Pure",
+						Args: []ir.FilterExpr{
+							{Line: 1222, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
+							{Line: 1222, Op: ir.FilterVarPureOp, Src: "m[\"src\"].Pure", Value: "src"},
+						},
+					},
+				},
+				{
+					Line: 1224,
+					SyntaxPatterns: []ir.PatternString{
+						{Line: 1224, Value: "copy($dst[:len($src)], $src)"},
+						{Line: 1224, Value: "copy($dst[0:len($src)], $src)"},
+					},
+					ReportTemplate: "$$ can be replaced with copy($dst, $src) if len($dst) >= len($src)",
+					WhereExpr: ir.FilterExpr{
+						Line: 1225,
+						Op:   ir.FilterAndOp,
+						Src:  "m[\"dst\"].Pure && m[\"src\"].Pure",
+						Args: []ir.FilterExpr{
+							{Line: 1225, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
+							{Line: 1225, Op: ir.FilterVarPureOp, Src: "m[\"src\"].Pure", Value: "src"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        1232,
 			Name:        "binaryWrite",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
 			DocSummary:  "Detects binary.Write uses that can be optimized",
 			Rules: []ir.Rule{
 				{
-					Line:            831,
-					SyntaxPatterns:  []ir.PatternString{{Line: 831, Value: "$err := binary.Write($w, $_, $b)"}},
+					Line:            1233,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1233, Value: "$err := binary.Write($w, $_, $b)"}},
 					ReportTemplate:  "$$ => _, $err := $w.Write($b)",
 					SuggestTemplate: "_, $err := $w.Write($b)",
 					WhereExpr: ir.FilterExpr{
-						Line:  832,
+						Line:  1234,
 						Op:    ir.FilterVarTypeIsOp,
 						Src:   "m[\"b\"].Type.Is(`[]byte`)",
 						Value: "b",
-						Args:  []ir.FilterExpr{{Line: 832, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+						Args:  []ir.FilterExpr{{Line: 1234, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 					},
 				},
 				{
-					Line:            835,
-					SyntaxPatterns:  []ir.PatternString{{Line: 835, Value: "binary.Write($w, $_, $b)"}},
+					Line:            1237,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1237, Value: "binary.Write($w, $_, $b)"}},
 					ReportTemplate:  "$$ => $w.Write($b)",
 					SuggestTemplate: "$w.Write($b)",
 					WhereExpr: ir.FilterExpr{
-						Line: 836,
+						Line: 1238,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"$$\"].Node.Parent().Is(`ExprStmt`) && m[\"b\"].Type.Is(`[]byte`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 836,
+								Line: 1238,
 								Op:   ir.FilterRootNodeParentIsOp,
 								Src:  "m[\"$$\"].Node.Parent().Is(`ExprStmt`)",
-								Args: []ir.FilterExpr{{Line: 836, Op: ir.FilterStringOp, Src: "`ExprStmt`", Value: "ExprStmt"}},
+								Args: []ir.FilterExpr{{Line: 1238, Op: ir.FilterStringOp, Src: "`ExprStmt`", Value: "ExprStmt"}},
 							},
 							{
-								Line:  836,
+								Line:  1238,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"b\"].Type.Is(`[]byte`)",
 								Value: "b",
-								Args:  []ir.FilterExpr{{Line: 836, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
+								Args:  []ir.FilterExpr{{Line: 1238, Op: ir.FilterStringOp, Src: "`[]byte`", Value: "[]byte"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            839,
-					SyntaxPatterns:  []ir.PatternString{{Line: 839, Value: "$err := binary.Write($w, $_, $s)"}},
+					Line:            1241,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1241, Value: "$err := binary.Write($w, $_, $s)"}},
 					ReportTemplate:  "$$ => _, $err := $w.WriteString($s)",
 					SuggestTemplate: "_, $err := $w.WriteString($s)",
 					WhereExpr: ir.FilterExpr{
-						Line: 840,
+						Line: 1242,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"s\"].Type.Is(`string`) && m[\"w\"].Type.HasMethod(`io.StringWriter.WriteString`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  840,
+								Line:  1242,
 								Op:    ir.FilterVarTypeIsOp,
 								Src:   "m[\"s\"].Type.Is(`string`)",
 								Value: "s",
-								Args:  []ir.FilterExpr{{Line: 840, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+								Args:  []ir.FilterExpr{{Line: 1242, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 							},
 							{
-								Line:  840,
+								Line:  1242,
 								Op:    ir.FilterVarTypeHasMethodOp,
 								Src:   "m[\"w\"].Type.HasMethod(`io.StringWriter.WriteString`)",
 								Value: "w",
-								Args:  []ir.FilterExpr{{Line: 840, Op: ir.FilterStringOp, Src: "`io.StringWriter.WriteString`", Value: "io.StringWriter.WriteString"}},
+								Args:  []ir.FilterExpr{{Line: 1242, Op: ir.FilterStringOp, Src: "`io.StringWriter.WriteString`", Value: "io.StringWriter.WriteString"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            843,
-					SyntaxPatterns:  []ir.PatternString{{Line: 843, Value: "binary.Write($w, $_, $s)"}},
+					Line:            1245,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1245, Value: "binary.Write($w, $_, $s)"}},
 					ReportTemplate:  "$$ => $w.WriteString($s)",
 					SuggestTemplate: "$w.WriteString($s)",
 					WhereExpr: ir.FilterExpr{
-						Line: 844,
+						Line: 1246,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"$$\"].Node.Parent().Is(`ExprStmt`) && m[\"s\"].Type.Is(`string`) && m[\"w\"].Type.HasMethod(`io.StringWriter.WriteString`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 844,
+								Line: 1246,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"$$\"].Node.Parent().Is(`ExprStmt`) && m[\"s\"].Type.Is(`string`)",
 								Args: []ir.FilterExpr{
 									{
-										Line: 844,
+										Line: 1246,
 										Op:   ir.FilterRootNodeParentIsOp,
 										Src:  "m[\"$$\"].Node.Parent().Is(`ExprStmt`)",
-										Args: []ir.FilterExpr{{Line: 844, Op: ir.FilterStringOp, Src: "`ExprStmt`", Value: "ExprStmt"}},
+										Args: []ir.FilterExpr{{Line: 1246, Op: ir.FilterStringOp, Src: "`ExprStmt`", Value: "ExprStmt"}},
 									},
 									{
-										Line:  844,
+										Line:  1246,
 										Op:    ir.FilterVarTypeIsOp,
 										Src:   "m[\"s\"].Type.Is(`string`)",
 										Value: "s",
-										Args:  []ir.FilterExpr{{Line: 844, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
+										Args:  []ir.FilterExpr{{Line: 1246, Op: ir.FilterStringOp, Src: "`string`", Value: "string"}},
 									},
 								},
 							},
 							{
-								Line:  844,
+								Line:  1246,
 								Op:    ir.FilterVarTypeHasMethodOp,
 								Src:   "m[\"w\"].Type.HasMethod(`io.StringWriter.WriteString`)",
 								Value: "w",
-								Args:  []ir.FilterExpr{{Line: 844, Op: ir.FilterStringOp, Src: "`io.StringWriter.WriteString`", Value: "io.StringWriter.WriteString"}},
+								Args:  []ir.FilterExpr{{Line: 1246, Op: ir.FilterStringOp, Src: "`io.StringWriter.WriteString`", Value: "io.StringWriter.WriteString"}},
 							},
 						},
 					},
@@ -4221,105 +5980,105 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        850,
+			Line:        1253,
 			Name:        "syncPoolPut",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
 			DocSummary:  "Detects sync.Pool usage on non pointer objects",
 			Rules: []ir.Rule{{
-				Line:           857,
-				SyntaxPatterns: []ir.PatternString{{Line: 857, Value: "$x.Put($y)"}},
+				Line:           1260,
+				SyntaxPatterns: []ir.PatternString{{Line: 1260, Value: "$x.Put($y)"}},
 				ReportTemplate: "non-pointer values in sync.Pool involve extra allocation",
 				WhereExpr: ir.FilterExpr{
-					Line: 858,
+					Line: 1261,
 					Op:   ir.FilterAndOp,
 					Src:  "m[\"x\"].Type.Is(\"sync.Pool\") && !isPtrLike(m[\"y\"])",
 					Args: []ir.FilterExpr{
 						{
-							Line:  858,
+							Line:  1261,
 							Op:    ir.FilterVarTypeIsOp,
 							Src:   "m[\"x\"].Type.Is(\"sync.Pool\")",
 							Value: "x",
-							Args:  []ir.FilterExpr{{Line: 858, Op: ir.FilterStringOp, Src: "\"sync.Pool\"", Value: "sync.Pool"}},
+							Args:  []ir.FilterExpr{{Line: 1261, Op: ir.FilterStringOp, Src: "\"sync.Pool\"", Value: "sync.Pool"}},
 						},
 						{
-							Line: 858,
+							Line: 1261,
 							Op:   ir.FilterNotOp,
 							Src:  "!isPtrLike(m[\"y\"])",
 							Args: []ir.FilterExpr{{
-								Line: 858,
+								Line: 1261,
 								Op:   ir.FilterOrOp,
 								Src:  "isPtrLike(m[\"y\"])",
 								Args: []ir.FilterExpr{
 									{
-										Line: 858,
+										Line: 1261,
 										Op:   ir.FilterOrOp,
 										Src:  "m[\"y\"].Type.Underlying().Is(\"*$_\") ||\n\n\tm[\"y\"].Type.Underlying().Is(\"chan $_\") ||\n\n\tm[\"y\"].Type.Underlying().Is(\"map[$_]$_\") ||\n\n\tm[\"y\"].Type.Underlying().Is(\"interface{$*_}\") ||\n\n\tm[\"y\"].Type.Underlying().Is(`func($*_) $*_`)",
 										Args: []ir.FilterExpr{
 											{
-												Line: 858,
+												Line: 1261,
 												Op:   ir.FilterOrOp,
 												Src:  "m[\"y\"].Type.Underlying().Is(\"*$_\") ||\n\n\tm[\"y\"].Type.Underlying().Is(\"chan $_\") ||\n\n\tm[\"y\"].Type.Underlying().Is(\"map[$_]$_\") ||\n\n\tm[\"y\"].Type.Underlying().Is(\"interface{$*_}\")",
 												Args: []ir.FilterExpr{
 													{
-														Line: 858,
+														Line: 1261,
 														Op:   ir.FilterOrOp,
 														Src:  "m[\"y\"].Type.Underlying().Is(\"*$_\") ||\n\n\tm[\"y\"].Type.Underlying().Is(\"chan $_\") ||\n\n\tm[\"y\"].Type.Underlying().Is(\"map[$_]$_\")",
 														Args: []ir.FilterExpr{
 															{
-																Line: 858,
+																Line: 1261,
 																Op:   ir.FilterOrOp,
 																Src:  "m[\"y\"].Type.Underlying().Is(\"*$_\") ||\n\n\tm[\"y\"].Type.Underlying().Is(\"chan $_\")",
 																Args: []ir.FilterExpr{
 																	{
-																		Line:  858,
+																		Line:  1261,
 																		Op:    ir.FilterVarTypeUnderlyingIsOp,
 																		Src:   "m[\"y\"].Type.Underlying().Is(\"*$_\")",
 																		Value: "y",
-																		Args:  []ir.FilterExpr{{Line: 852, Op: ir.FilterStringOp, Src: "\"*$_\"", Value: "*$_"}},
+																		Args:  []ir.FilterExpr{{Line: 1255, Op: ir.FilterStringOp, Src: "\"*$_\"", Value: "*$_"}},
 																	},
 																	{
-																		Line:  858,
+																		Line:  1261,
 																		Op:    ir.FilterVarTypeUnderlyingIsOp,
 																		Src:   "m[\"y\"].Type.Underlying().Is(\"chan $_\")",
 																		Value: "y",
-																		Args:  []ir.FilterExpr{{Line: 852, Op: ir.FilterStringOp, Src: "\"chan $_\"", Value: "chan $_"}},
+																		Args:  []ir.FilterExpr{{Line: 1255, Op: ir.FilterStringOp, Src: "\"chan $_\"", Value: "chan $_"}},
 																	},
 																},
 															},
 															{
-																Line:  858,
+																Line:  1261,
 																Op:    ir.FilterVarTypeUnderlyingIsOp,
 																Src:   "m[\"y\"].Type.Underlying().Is(\"map[$_]$_\")",
 																Value: "y",
-																Args:  []ir.FilterExpr{{Line: 853, Op: ir.FilterStringOp, Src: "\"map[$_]$_\"", Value: "map[$_]$_"}},
+																Args:  []ir.FilterExpr{{Line: 1256, Op: ir.FilterStringOp, Src: "\"map[$_]$_\"", Value: "map[$_]$_"}},
 															},
 														},
 													},
 													{
-														Line:  858,
+														Line:  1261,
 														Op:    ir.FilterVarTypeUnderlyingIsOp,
 														Src:   "m[\"y\"].Type.Underlying().Is(\"interface{$*_}\")",
 														Value: "y",
-														Args:  []ir.FilterExpr{{Line: 853, Op: ir.FilterStringOp, Src: "\"interface{$*_}\"", Value: "interface{$*_}"}},
+														Args:  []ir.FilterExpr{{Line: 1256, Op: ir.FilterStringOp, Src: "\"interface{$*_}\"", Value: "interface{$*_}"}},
 													},
 												},
 											},
 											{
-												Line:  858,
+												Line:  1261,
 												Op:    ir.FilterVarTypeUnderlyingIsOp,
 												Src:   "m[\"y\"].Type.Underlying().Is(`func($*_) $*_`)",
 												Value: "y",
-												Args:  []ir.FilterExpr{{Line: 854, Op: ir.FilterStringOp, Src: "`func($*_) $*_`", Value: "func($*_) $*_"}},
+												Args:  []ir.FilterExpr{{Line: 1257, Op: ir.FilterStringOp, Src: "`func($*_) $*_`", Value: "func($*_) $*_"}},
 											},
 										},
 									},
 									{
-										Line:  858,
+										Line:  1261,
 										Op:    ir.FilterVarTypeUnderlyingIsOp,
 										Src:   "m[\"y\"].Type.Underlying().Is(`unsafe.Pointer`)",
 										Value: "y",
-										Args:  []ir.FilterExpr{{Line: 854, Op: ir.FilterStringOp, Src: "`unsafe.Pointer`", Value: "unsafe.Pointer"}},
+										Args:  []ir.FilterExpr{{Line: 1257, Op: ir.FilterStringOp, Src: "`unsafe.Pointer`", Value: "unsafe.Pointer"}},
 									},
 								},
 							}},
@@ -4330,7 +6089,7 @@ var Universal = &ir.File{
 			}},
 		},
 		{
-			Line:        867,
+			Line:        1271,
 			Name:        "trim",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
@@ -4339,85 +6098,85 @@ var Universal = &ir.File{
 			DocAfter:    "strings.TrimSpace(s)",
 			Rules: []ir.Rule{
 				{
-					Line: 868,
+					Line: 1272,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 869, Value: "strings.TrimRight(strings.TrimLeft($s, $x), $x)"},
-						{Line: 870, Value: "strings.TrimLeft(strings.TrimRight($s, $x), $x)"},
+						{Line: 1273, Value: "strings.TrimRight(strings.TrimLeft($s, $x), $x)"},
+						{Line: 1274, Value: "strings.TrimLeft(strings.TrimRight($s, $x), $x)"},
 					},
 					ReportTemplate:  "$$ => strings.Trim($s, $x)",
 					SuggestTemplate: "strings.Trim($s, $x)",
-					WhereExpr:       ir.FilterExpr{Line: 871, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+					WhereExpr:       ir.FilterExpr{Line: 1275, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
 				},
 				{
-					Line: 872,
+					Line: 1276,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 873, Value: "bytes.TrimRight(bytes.TrimLeft($s, $x), $x)"},
-						{Line: 874, Value: "bytes.TrimLeft(bytes.TrimRight($s, $x), $x)"},
+						{Line: 1277, Value: "bytes.TrimRight(bytes.TrimLeft($s, $x), $x)"},
+						{Line: 1278, Value: "bytes.TrimLeft(bytes.TrimRight($s, $x), $x)"},
 					},
 					ReportTemplate:  "$$ => bytes.Trim($s, $x)",
 					SuggestTemplate: "bytes.Trim($s, $x)",
-					WhereExpr:       ir.FilterExpr{Line: 875, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
+					WhereExpr:       ir.FilterExpr{Line: 1279, Op: ir.FilterVarPureOp, Src: "m[\"x\"].Pure", Value: "x"},
 				},
 				{
-					Line:            877,
-					SyntaxPatterns:  []ir.PatternString{{Line: 877, Value: "strings.TrimFunc($s, unicode.IsSpace)"}},
+					Line:            1281,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1281, Value: "strings.TrimFunc($s, unicode.IsSpace)"}},
 					ReportTemplate:  "$$ => strings.TrimSpace($s)",
 					SuggestTemplate: "strings.TrimSpace($s)",
 				},
 				{
-					Line:            879,
-					SyntaxPatterns:  []ir.PatternString{{Line: 879, Value: "bytes.TrimFunc($s, unicode.IsSpace)"}},
+					Line:            1283,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1283, Value: "bytes.TrimFunc($s, unicode.IsSpace)"}},
 					ReportTemplate:  "$$ => bytes.TrimSpace($s)",
 					SuggestTemplate: "bytes.TrimSpace($s)",
 				},
 				{
-					Line:            882,
-					SyntaxPatterns:  []ir.PatternString{{Line: 882, Value: "strings.Trim($s, $cutset)"}},
+					Line:            1286,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1286, Value: "strings.Trim($s, $cutset)"}},
 					ReportTemplate:  "$$ => strings.TrimSpace($s)",
 					SuggestTemplate: "strings.TrimSpace($s)",
 					WhereExpr: ir.FilterExpr{
-						Line: 883,
+						Line: 1287,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"cutset\"].Const && m[\"cutset\"].Text.Matches(`^\"(?: |\\\\[fnrtv]){3,}\"$`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  883,
+								Line:  1287,
 								Op:    ir.FilterVarConstOp,
 								Src:   "m[\"cutset\"].Const",
 								Value: "cutset",
 							},
 							{
-								Line:  883,
+								Line:  1287,
 								Op:    ir.FilterVarTextMatchesOp,
 								Src:   "m[\"cutset\"].Text.Matches(`^\"(?: |\\\\[fnrtv]){3,}\"$`)",
 								Value: "cutset",
-								Args:  []ir.FilterExpr{{Line: 883, Op: ir.FilterStringOp, Src: "`^\"(?: |\\\\[fnrtv]){3,}\"$`", Value: "^\"(?: |\\\\[fnrtv]){3,}\"$"}},
+								Args:  []ir.FilterExpr{{Line: 1287, Op: ir.FilterStringOp, Src: "`^\"(?: |\\\\[fnrtv]){3,}\"$`", Value: "^\"(?: |\\\\[fnrtv]){3,}\"$"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            885,
-					SyntaxPatterns:  []ir.PatternString{{Line: 885, Value: "bytes.Trim($s, $cutset)"}},
+					Line:            1289,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1289, Value: "bytes.Trim($s, $cutset)"}},
 					ReportTemplate:  "$$ => bytes.TrimSpace($s)",
 					SuggestTemplate: "bytes.TrimSpace($s)",
 					WhereExpr: ir.FilterExpr{
-						Line: 886,
+						Line: 1290,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"cutset\"].Const && m[\"cutset\"].Text.Matches(`^\"(?: |\\\\[fnrtv]){3,}\"$`)",
 						Args: []ir.FilterExpr{
 							{
-								Line:  886,
+								Line:  1290,
 								Op:    ir.FilterVarConstOp,
 								Src:   "m[\"cutset\"].Const",
 								Value: "cutset",
 							},
 							{
-								Line:  886,
+								Line:  1290,
 								Op:    ir.FilterVarTextMatchesOp,
 								Src:   "m[\"cutset\"].Text.Matches(`^\"(?: |\\\\[fnrtv]){3,}\"$`)",
 								Value: "cutset",
-								Args:  []ir.FilterExpr{{Line: 886, Op: ir.FilterStringOp, Src: "`^\"(?: |\\\\[fnrtv]){3,}\"$`", Value: "^\"(?: |\\\\[fnrtv]){3,}\"$"}},
+								Args:  []ir.FilterExpr{{Line: 1290, Op: ir.FilterStringOp, Src: "`^\"(?: |\\\\[fnrtv]){3,}\"$`", Value: "^\"(?: |\\\\[fnrtv]){3,}\"$"}},
 							},
 						},
 					},
@@ -4425,7 +6184,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        894,
+			Line:        1299,
 			Name:        "redundantNilCheck",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score1"},
@@ -4434,29 +6193,29 @@ var Universal = &ir.File{
 			DocAfter:    "len(b) == 0",
 			Rules: []ir.Rule{
 				{
-					Line: 895,
+					Line: 1300,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 895, Value: "$b == nil || len($b) == 0"},
-						{Line: 895, Value: "len($b) == 0 || $b == nil"},
+						{Line: 1300, Value: "$b == nil || len($b) == 0"},
+						{Line: 1300, Value: "len($b) == 0 || $b == nil"},
 					},
 					ReportTemplate:  "$$ => len($b) == 0",
 					SuggestTemplate: "len($b) == 0",
-					WhereExpr:       ir.FilterExpr{Line: 896, Op: ir.FilterVarPureOp, Src: "m[\"b\"].Pure", Value: "b"},
+					WhereExpr:       ir.FilterExpr{Line: 1301, Op: ir.FilterVarPureOp, Src: "m[\"b\"].Pure", Value: "b"},
 				},
 				{
-					Line: 898,
+					Line: 1303,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 898, Value: "$b == nil || cap($b) == 0"},
-						{Line: 898, Value: "cap($b) == 0 || $b == nil"},
+						{Line: 1303, Value: "$b == nil || cap($b) == 0"},
+						{Line: 1303, Value: "cap($b) == 0 || $b == nil"},
 					},
 					ReportTemplate:  "$$ => cap($b) == 0",
 					SuggestTemplate: "cap($b) == 0",
-					WhereExpr:       ir.FilterExpr{Line: 899, Op: ir.FilterVarPureOp, Src: "m[\"b\"].Pure", Value: "b"},
+					WhereExpr:       ir.FilterExpr{Line: 1304, Op: ir.FilterVarPureOp, Src: "m[\"b\"].Pure", Value: "b"},
 				},
 			},
 		},
 		{
-			Line:        907,
+			Line:        1313,
 			Name:        "stringsCompare",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score1"},
@@ -4465,49 +6224,49 @@ var Universal = &ir.File{
 			DocAfter:    "s1 == s2",
 			Rules: []ir.Rule{
 				{
-					Line:            908,
-					SyntaxPatterns:  []ir.PatternString{{Line: 908, Value: "strings.Compare($a, $b) == 0"}},
+					Line:            1314,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1314, Value: "strings.Compare($a, $b) == 0"}},
 					ReportTemplate:  "$$ => $a == $b",
 					SuggestTemplate: "$a == $b",
 				},
 				{
-					Line:            909,
-					SyntaxPatterns:  []ir.PatternString{{Line: 909, Value: "strings.Compare($a, $b) != 0"}},
+					Line:            1315,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1315, Value: "strings.Compare($a, $b) != 0"}},
 					ReportTemplate:  "$$ => $a != $b",
 					SuggestTemplate: "$a != $b",
 				},
 				{
-					Line: 911,
+					Line: 1317,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 911, Value: "strings.Compare($a, $b) >= 0"},
-						{Line: 911, Value: "strings.Compare($a, $b) != -1"},
+						{Line: 1317, Value: "strings.Compare($a, $b) >= 0"},
+						{Line: 1317, Value: "strings.Compare($a, $b) != -1"},
 					},
 					ReportTemplate:  "$$ => $a >= $b",
 					SuggestTemplate: "$a >= $b",
 				},
 				{
-					Line: 913,
+					Line: 1319,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 913, Value: "strings.Compare($a, $b) <= 0"},
-						{Line: 913, Value: "strings.Compare($a, $b) != 1"},
+						{Line: 1319, Value: "strings.Compare($a, $b) <= 0"},
+						{Line: 1319, Value: "strings.Compare($a, $b) != 1"},
 					},
 					ReportTemplate:  "$$ => $a <= $b",
 					SuggestTemplate: "$a <= $b",
 				},
 				{
-					Line: 916,
+					Line: 1322,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 916, Value: "strings.Compare($a, $b) == -1"},
-						{Line: 916, Value: "strings.Compare($a, $b) < 0"},
+						{Line: 1322, Value: "strings.Compare($a, $b) == -1"},
+						{Line: 1322, Value: "strings.Compare($a, $b) < 0"},
 					},
 					ReportTemplate:  "$$ => $a < $b",
 					SuggestTemplate: "$a < $b",
 				},
 				{
-					Line: 918,
+					Line: 1324,
 					SyntaxPatterns: []ir.PatternString{
-						{Line: 918, Value: "strings.Compare($a, $b) == 1"},
-						{Line: 918, Value: "strings.Compare($a, $b) > 0"},
+						{Line: 1324, Value: "strings.Compare($a, $b) == 1"},
+						{Line: 1324, Value: "strings.Compare($a, $b) > 0"},
 					},
 					ReportTemplate:  "$$ => $a > $b",
 					SuggestTemplate: "$a > $b",
@@ -4515,30 +6274,30 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        926,
+			Line:        1333,
 			Name:        "bytesCompare",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score1"},
 			DocSummary:  "Detects bytes.Compare calls that can be optimized",
-			DocBefore:   "bytes.Compare(b1, b1) == 0",
+			DocBefore:   "bytes.Compare(b1, b2) == 0",
 			DocAfter:    "bytes.Equal(b1, b2)",
 			Rules: []ir.Rule{
 				{
-					Line:            927,
-					SyntaxPatterns:  []ir.PatternString{{Line: 927, Value: "bytes.Compare($a, $b) == 0"}},
+					Line:            1334,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1334, Value: "bytes.Compare($a, $b) == 0"}},
 					ReportTemplate:  "$$ => bytes.Equal($a, $b)",
 					SuggestTemplate: "bytes.Equal($a, $b)",
 				},
 				{
-					Line:            928,
-					SyntaxPatterns:  []ir.PatternString{{Line: 928, Value: "bytes.Compare($a, $b) != 0"}},
+					Line:            1335,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1335, Value: "bytes.Compare($a, $b) != 0"}},
 					ReportTemplate:  "$$ => !bytes.Equal($a, $b)",
 					SuggestTemplate: "!bytes.Equal($a, $b)",
 				},
 			},
 		},
 		{
-			Line:        935,
+			Line:        1343,
 			Name:        "sliceLit",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score2"},
@@ -4547,45 +6306,45 @@ var Universal = &ir.File{
 			DocAfter:    "[]byte{b1, b2}",
 			Rules: []ir.Rule{
 				{
-					Line:            936,
-					SyntaxPatterns:  []ir.PatternString{{Line: 936, Value: "append([]$typ{$x}, $y)"}},
+					Line:            1344,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1344, Value: "append([]$typ{$x}, $y)"}},
 					ReportTemplate:  "$$ => []$typ{$x, $y}",
 					SuggestTemplate: "[]$typ{$x, $y}",
 				},
 				{
-					Line:            937,
-					SyntaxPatterns:  []ir.PatternString{{Line: 937, Value: "append([]$typ{$x}, $y, $*rest)"}},
+					Line:            1345,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1345, Value: "append([]$typ{$x}, $y, $*rest)"}},
 					ReportTemplate:  "$$ => []$typ{$x, $y, $rest}",
 					SuggestTemplate: "[]$typ{$x, $y, $rest}",
 				},
 				{
-					Line:            939,
-					SyntaxPatterns:  []ir.PatternString{{Line: 939, Value: "append([]$typ{}, $x)"}},
+					Line:            1347,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1347, Value: "append([]$typ{}, $x)"}},
 					ReportTemplate:  "$$ => []$typ{$x}",
 					SuggestTemplate: "[]$typ{$x}",
 				},
 				{
-					Line:            940,
-					SyntaxPatterns:  []ir.PatternString{{Line: 940, Value: "append([]$typ{}, $x, $*rest)"}},
+					Line:            1348,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1348, Value: "append([]$typ{}, $x, $*rest)"}},
 					ReportTemplate:  "$$ => []$typ{$x, $rest}",
 					SuggestTemplate: "[]$typ{$x, $rest}",
 				},
 				{
-					Line:            942,
-					SyntaxPatterns:  []ir.PatternString{{Line: 942, Value: "append([]$typ(nil), $x)"}},
+					Line:            1350,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1350, Value: "append([]$typ(nil), $x)"}},
 					ReportTemplate:  "$$ => []$typ{$x}",
 					SuggestTemplate: "[]$typ{$x}",
 				},
 				{
-					Line:            943,
-					SyntaxPatterns:  []ir.PatternString{{Line: 943, Value: "append([]$typ(nil), $x, $*rest)"}},
+					Line:            1351,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1351, Value: "append([]$typ(nil), $x, $*rest)"}},
 					ReportTemplate:  "$$ => []$typ{$x, $rest}",
 					SuggestTemplate: "[]$typ{$x, $rest}",
 				},
 			},
 		},
 		{
-			Line:        950,
+			Line:        1359,
 			Name:        "mathExpr",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score1"},
@@ -4594,21 +6353,56 @@ var Universal = &ir.File{
 			DocAfter:    "math.Abs(x * y)",
 			Rules: []ir.Rule{
 				{
-					Line:            951,
-					SyntaxPatterns:  []ir.PatternString{{Line: 951, Value: "math.Abs($x) * math.Abs($y)"}},
+					Line:            1360,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1360, Value: "math.Abs($x) * math.Abs($y)"}},
 					ReportTemplate:  "$$ => math.Abs(($x) * ($y))",
 					SuggestTemplate: "math.Abs(($x) * ($y))",
 				},
 				{
-					Line:            952,
-					SyntaxPatterns:  []ir.PatternString{{Line: 952, Value: "math.Abs($x) / math.Abs($y)"}},
+					Line:            1361,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1361, Value: "math.Abs($x) / math.Abs($y)"}},
 					ReportTemplate:  "$$ => math.Abs(($x) / ($y))",
 					SuggestTemplate: "math.Abs(($x) / ($y))",
 				},
 			},
 		},
 		{
-			Line:        959,
+			Line:        1369,
+			Name:        "intAbs",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score3"},
+			DocSummary:  "Detects integer abs computed via float64 conversions",
+			DocBefore:   "int(math.Abs(float64(x)))",
+			DocAfter:    "abs(x) // func abs(x int) int { if x < 0 { return -x }; return x }",
+			Rules: []ir.Rule{{
+				Line:           1373,
+				SyntaxPatterns: []ir.PatternString{{Line: 1373, Value: "$typ(math.Abs(float64($x)))"}},
+				ReportTemplate: "use an integer abs helper instead of $$: func abs(x int) int { if x < 0 { return -x }; return x }",
+				WhereExpr: ir.FilterExpr{
+					Line: 1374,
+					Op:   ir.FilterAndOp,
+					Src:  "m[\"x\"].Type.Underlying().OfKind(\"integer\") && m[\"typ\"].Type.Underlying().OfKind(\"integer\")",
+					Args: []ir.FilterExpr{
+						{
+							Line:  1374,
+							Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+							Src:   "m[\"x\"].Type.Underlying().OfKind(\"integer\")",
+							Value: "x",
+							Args:  []ir.FilterExpr{{Line: 1374, Op: ir.FilterStringOp, Src: "\"integer\"", Value: "integer"}},
+						},
+						{
+							Line:  1374,
+							Op:    ir.FilterVarTypeUnderlyingOfKindOp,
+							Src:   "m[\"typ\"].Type.Underlying().OfKind(\"integer\")",
+							Value: "typ",
+							Args:  []ir.FilterExpr{{Line: 1374, Op: ir.FilterStringOp, Src: "\"integer\"", Value: "integer"}},
+						},
+					},
+				},
+			}},
+		},
+		{
+			Line:        1383,
 			Name:        "ioCopy",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score3"},
@@ -4617,97 +6411,97 @@ var Universal = &ir.File{
 			DocAfter:    "src.WriteTo(dst)",
 			Rules: []ir.Rule{
 				{
-					Line:            963,
-					SyntaxPatterns:  []ir.PatternString{{Line: 963, Value: "io.Copy($dst, bytes.NewReader($data))"}},
+					Line:            1387,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1387, Value: "io.Copy($dst, bytes.NewReader($data))"}},
 					ReportTemplate:  "$$ => $dst.Write($data)",
 					SuggestTemplate: "$dst.Write($data)",
 					WhereExpr: ir.FilterExpr{
-						Line: 964,
+						Line: 1388,
 						Op:   ir.FilterRootNodeParentIsOp,
 						Src:  "m[\"$$\"].Node.Parent().Is(`ExprStmt`)",
-						Args: []ir.FilterExpr{{Line: 964, Op: ir.FilterStringOp, Src: "`ExprStmt`", Value: "ExprStmt"}},
+						Args: []ir.FilterExpr{{Line: 1388, Op: ir.FilterStringOp, Src: "`ExprStmt`", Value: "ExprStmt"}},
 					},
 				},
 				{
-					Line:            966,
-					SyntaxPatterns:  []ir.PatternString{{Line: 966, Value: "io.Copy($dst, strings.NewReader($data))"}},
+					Line:            1390,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1390, Value: "io.Copy($dst, strings.NewReader($data))"}},
 					ReportTemplate:  "$$ => $dst.WriteString($data)",
 					SuggestTemplate: "$dst.WriteString($data)",
 					WhereExpr: ir.FilterExpr{
-						Line: 967,
+						Line: 1391,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"$$\"].Node.Parent().Is(`ExprStmt`) && m[\"dst\"].Type.HasMethod(`io.StringWriter.WriteString`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 967,
+								Line: 1391,
 								Op:   ir.FilterRootNodeParentIsOp,
 								Src:  "m[\"$$\"].Node.Parent().Is(`ExprStmt`)",
-								Args: []ir.FilterExpr{{Line: 967, Op: ir.FilterStringOp, Src: "`ExprStmt`", Value: "ExprStmt"}},
+								Args: []ir.FilterExpr{{Line: 1391, Op: ir.FilterStringOp, Src: "`ExprStmt`", Value: "ExprStmt"}},
 							},
 							{
-								Line:  967,
+								Line:  1391,
 								Op:    ir.FilterVarTypeHasMethodOp,
 								Src:   "m[\"dst\"].Type.HasMethod(`io.StringWriter.WriteString`)",
 								Value: "dst",
-								Args:  []ir.FilterExpr{{Line: 967, Op: ir.FilterStringOp, Src: "`io.StringWriter.WriteString`", Value: "io.StringWriter.WriteString"}},
+								Args:  []ir.FilterExpr{{Line: 1391, Op: ir.FilterStringOp, Src: "`io.StringWriter.WriteString`", Value: "io.StringWriter.WriteString"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            970,
-					SyntaxPatterns:  []ir.PatternString{{Line: 970, Value: "io.Copy($dst, $src)"}},
+					Line:            1394,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1394, Value: "io.Copy($dst, $src)"}},
 					ReportTemplate:  "$$ => $src.WriteTo($dst)",
 					SuggestTemplate: "$src.WriteTo($dst)",
 					WhereExpr: ir.FilterExpr{
-						Line: 971,
+						Line: 1395,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"dst\"].Pure && m[\"dst\"].Pure && m[\"src\"].Type.HasMethod(`io.WriterTo.WriteTo`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 971,
+								Line: 1395,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"dst\"].Pure && m[\"dst\"].Pure",
 								Args: []ir.FilterExpr{
-									{Line: 971, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
-									{Line: 971, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
+									{Line: 1395, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
+									{Line: 1395, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
 								},
 							},
 							{
-								Line:  971,
+								Line:  1395,
 								Op:    ir.FilterVarTypeHasMethodOp,
 								Src:   "m[\"src\"].Type.HasMethod(`io.WriterTo.WriteTo`)",
 								Value: "src",
-								Args:  []ir.FilterExpr{{Line: 971, Op: ir.FilterStringOp, Src: "`io.WriterTo.WriteTo`", Value: "io.WriterTo.WriteTo"}},
+								Args:  []ir.FilterExpr{{Line: 1395, Op: ir.FilterStringOp, Src: "`io.WriterTo.WriteTo`", Value: "io.WriterTo.WriteTo"}},
 							},
 						},
 					},
 				},
 				{
-					Line:            974,
-					SyntaxPatterns:  []ir.PatternString{{Line: 974, Value: "io.Copy($dst, $src)"}},
+					Line:            1398,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1398, Value: "io.Copy($dst, $src)"}},
 					ReportTemplate:  "$$ => $dst.ReadFrom($src)",
 					SuggestTemplate: "$dst.ReadFrom($src)",
 					WhereExpr: ir.FilterExpr{
-						Line: 975,
+						Line: 1399,
 						Op:   ir.FilterAndOp,
 						Src:  "m[\"dst\"].Pure && m[\"dst\"].Pure && m[\"dst\"].Type.HasMethod(`io.ReaderFrom.ReadFrom`)",
 						Args: []ir.FilterExpr{
 							{
-								Line: 975,
+								Line: 1399,
 								Op:   ir.FilterAndOp,
 								Src:  "m[\"dst\"].Pure && m[\"dst\"].Pure",
 								Args: []ir.FilterExpr{
-									{Line: 975, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
-									{Line: 975, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
+									{Line: 1399, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
+									{Line: 1399, Op: ir.FilterVarPureOp, Src: "m[\"dst\"].Pure", Value: "dst"},
 								},
 							},
 							{
-								Line:  975,
+								Line:  1399,
 								Op:    ir.FilterVarTypeHasMethodOp,
 								Src:   "m[\"dst\"].Type.HasMethod(`io.ReaderFrom.ReadFrom`)",
 								Value: "dst",
-								Args:  []ir.FilterExpr{{Line: 975, Op: ir.FilterStringOp, Src: "`io.ReaderFrom.ReadFrom`", Value: "io.ReaderFrom.ReadFrom"}},
+								Args:  []ir.FilterExpr{{Line: 1399, Op: ir.FilterStringOp, Src: "`io.ReaderFrom.ReadFrom`", Value: "io.ReaderFrom.ReadFrom"}},
 							},
 						},
 					},
@@ -4715,7 +6509,7 @@ var Universal = &ir.File{
 			},
 		},
 		{
-			Line:        983,
+			Line:        1408,
 			Name:        "bufio",
 			MatcherName: "m",
 			DocTags:     []string{"o1", "score4"},
@@ -4723,61 +6517,360 @@ var Universal = &ir.File{
 			DocBefore:   "bufio.Reader(bytes.NewReader(data))",
 			DocAfter:    "bytes.NewReader(data)",
 			Rules: []ir.Rule{{
-				Line:            991,
-				SyntaxPatterns:  []ir.PatternString{{Line: 991, Value: "bufio.NewReader($r)"}},
+				Line:            1416,
+				SyntaxPatterns:  []ir.PatternString{{Line: 1416, Value: "bufio.NewReader($r)"}},
 				ReportTemplate:  "$$ => $r",
 				SuggestTemplate: "$r",
 				WhereExpr: ir.FilterExpr{
-					Line: 992,
+					Line: 1417,
 					Op:   ir.FilterAndOp,
 					Src:  "isInmemoryReader(m[\"r\"]) && m[\"$$\"].SinkType.Is(`io.Reader`)",
 					Args: []ir.FilterExpr{
 						{
-							Line: 992,
+							Line: 1417,
 							Op:   ir.FilterOrOp,
 							Src:  "isInmemoryReader(m[\"r\"])",
 							Args: []ir.FilterExpr{
 								{
-									Line: 992,
+									Line: 1417,
 									Op:   ir.FilterOrOp,
 									Src:  "m[\"r\"].Type.Is(`*bytes.Reader`) ||\n\n\tm[\"r\"].Type.Is(`*bytes.Buffer`)",
 									Args: []ir.FilterExpr{
 										{
-											Line:  992,
+											Line:  1417,
 											Op:    ir.FilterVarTypeIsOp,
 											Src:   "m[\"r\"].Type.Is(`*bytes.Reader`)",
 											Value: "r",
-											Args:  []ir.FilterExpr{{Line: 985, Op: ir.FilterStringOp, Src: "`*bytes.Reader`", Value: "*bytes.Reader"}},
+											Args:  []ir.FilterExpr{{Line: 1410, Op: ir.FilterStringOp, Src: "`*bytes.Reader`", Value: "*bytes.Reader"}},
 										},
 										{
-											Line:  992,
+											Line:  1417,
 											Op:    ir.FilterVarTypeIsOp,
 											Src:   "m[\"r\"].Type.Is(`*bytes.Buffer`)",
 											Value: "r",
-											Args:  []ir.FilterExpr{{Line: 986, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
+											Args:  []ir.FilterExpr{{Line: 1411, Op: ir.FilterStringOp, Src: "`*bytes.Buffer`", Value: "*bytes.Buffer"}},
 										},
 									},
 								},
 								{
-									Line:  992,
+									Line:  1417,
 									Op:    ir.FilterVarTypeIsOp,
 									Src:   "m[\"r\"].Type.Is(`*strings.Reader`)",
 									Value: "r",
-									Args:  []ir.FilterExpr{{Line: 987, Op: ir.FilterStringOp, Src: "`*strings.Reader`", Value: "*strings.Reader"}},
+									Args:  []ir.FilterExpr{{Line: 1412, Op: ir.FilterStringOp, Src: "`*strings.Reader`", Value: "*strings.Reader"}},
 								},
 							},
 						},
 						{
-							Line:  992,
+							Line:  1417,
 							Op:    ir.FilterRootSinkTypeIsOp,
 							Src:   "m[\"$$\"].SinkType.Is(`io.Reader`)",
 							Value: "$$",
-							Args:  []ir.FilterExpr{{Line: 992, Op: ir.FilterStringOp, Src: "`io.Reader`", Value: "io.Reader"}},
+							Args:  []ir.FilterExpr{{Line: 1417, Op: ir.FilterStringOp, Src: "`io.Reader`", Value: "io.Reader"}},
 						},
 					},
 				},
 			}},
 		},
+		{
+			Line:        1427,
+			Name:        "derefStore",
+			MatcherName: "m",
+			DocTags:     []string{"o2", "score2"},
+			DocSummary:  "Detects struct load-modify-store sequences that can modify the value in place",
+			DocBefore:   "v := *p; v.Field = x; *p = v",
+			DocAfter:    "p.Field = x",
+			DocNote:     "only structs that are bigger than 64 bytes are reported",
+			Rules: []ir.Rule{
+				{
+					Line:           1437,
+					SyntaxPatterns: []ir.PatternString{{Line: 1437, Value: "$v := *$p; $v.$f = $x; *$p = $v"}},
+					ReportTemplate: "$v is a copy of *$p that is stored back, use $p.$f = $x to modify it in place",
+					WhereExpr: ir.FilterExpr{
+						Line: 1438,
+						Op:   ir.FilterAndOp,
+						Src:  "isBigStruct(m[\"v\"]) && m[\"p\"].Pure && !m[\"x\"].Contains(`$v`)",
+						Args: []ir.FilterExpr{
+							{
+								Line: 1438,
+								Op:   ir.FilterAndOp,
+								Src:  "isBigStruct(m[\"v\"]) && m[\"p\"].Pure",
+								Args: []ir.FilterExpr{
+									{
+										Line: 1438,
+										Op:   ir.FilterAndOp,
+										Src:  "isBigStruct(m[\"v\"])",
+										Args: []ir.FilterExpr{
+											{
+												Line:  1438,
+												Op:    ir.FilterVarTypeUnderlyingIsOp,
+												Src:   "m[\"v\"].Type.Underlying().Is(`struct{$*_}`)",
+												Value: "v",
+												Args:  []ir.FilterExpr{{Line: 1434, Op: ir.FilterStringOp, Src: "`struct{$*_}`", Value: "struct{$*_}"}},
+											},
+											{
+												Line: 1438,
+												Op:   ir.FilterGtOp,
+												Src:  "m[\"v\"].Type.Size > 64",
+												Args: []ir.FilterExpr{
+													{
+														Line:  1438,
+														Op:    ir.FilterVarTypeSizeOp,
+														Src:   "m[\"v\"].Type.Size",
+														Value: "v",
+													},
+													{
+														Line:  1434,
+														Op:    ir.FilterIntOp,
+														Src:   "64",
+														Value: int64(64),
+													},
+												},
+											},
+										},
+									},
+									{Line: 1438, Op: ir.FilterVarPureOp, Src: "m[\"p\"].Pure", Value: "p"},
+								},
+							},
+							{
+								Line: 1438,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"x\"].Contains(`$v`)",
+								Args: []ir.FilterExpr{{
+									Line:  1438,
+									Op:    ir.FilterVarContainsOp,
+									Src:   "m[\"x\"].Contains(`$v`)",
+									Value: "x",
+									Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "$v"}},
+								}},
+							},
+						},
+					},
+				},
+				{
+					Line:           1441,
+					SyntaxPatterns: []ir.PatternString{{Line: 1441, Value: "$v := *$p; $v.$f1 = $x1; $v.$f2 = $x2; *$p = $v"}},
+					ReportTemplate: "$v is a copy of *$p that is stored back, use $p.$f1 = $x1; $p.$f2 = $x2 to modify it in place",
+					WhereExpr: ir.FilterExpr{
+						Line: 1442,
+						Op:   ir.FilterAndOp,
+						Src:  "isBigStruct(m[\"v\"]) && m[\"p\"].Pure && !m[\"x1\"].Contains(`$v`) && !m[\"x2\"].Contains(`$v`)",
+						Args: []ir.FilterExpr{
+							{
+								Line: 1442,
+								Op:   ir.FilterAndOp,
+								Src:  "isBigStruct(m[\"v\"]) && m[\"p\"].Pure && !m[\"x1\"].Contains(`$v`)",
+								Args: []ir.FilterExpr{
+									{
+										Line: 1442,
+										Op:   ir.FilterAndOp,
+										Src:  "isBigStruct(m[\"v\"]) && m[\"p\"].Pure",
+										Args: []ir.FilterExpr{
+											{
+												Line: 1442,
+												Op:   ir.FilterAndOp,
+												Src:  "isBigStruct(m[\"v\"])",
+												Args: []ir.FilterExpr{
+													{
+														Line:  1442,
+														Op:    ir.FilterVarTypeUnderlyingIsOp,
+														Src:   "m[\"v\"].Type.Underlying().Is(`struct{$*_}`)",
+														Value: "v",
+														Args:  []ir.FilterExpr{{Line: 1434, Op: ir.FilterStringOp, Src: "`struct{$*_}`", Value: "struct{$*_}"}},
+													},
+													{
+														Line: 1442,
+														Op:   ir.FilterGtOp,
+														Src:  "m[\"v\"].Type.Size > 64",
+														Args: []ir.FilterExpr{
+															{
+																Line:  1442,
+																Op:    ir.FilterVarTypeSizeOp,
+																Src:   "m[\"v\"].Type.Size",
+																Value: "v",
+															},
+															{
+																Line:  1434,
+																Op:    ir.FilterIntOp,
+																Src:   "64",
+																Value: int64(64),
+															},
+														},
+													},
+												},
+											},
+											{Line: 1442, Op: ir.FilterVarPureOp, Src: "m[\"p\"].Pure", Value: "p"},
+										},
+									},
+									{
+										Line: 1442,
+										Op:   ir.FilterNotOp,
+										Src:  "!m[\"x1\"].Contains(`$v`)",
+										Args: []ir.FilterExpr{{
+											Line:  1442,
+											Op:    ir.FilterVarContainsOp,
+											Src:   "m[\"x1\"].Contains(`$v`)",
+											Value: "x1",
+											Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "$v"}},
+										}},
+									},
+								},
+							},
+							{
+								Line: 1442,
+								Op:   ir.FilterNotOp,
+								Src:  "!m[\"x2\"].Contains(`$v`)",
+								Args: []ir.FilterExpr{{
+									Line:  1442,
+									Op:    ir.FilterVarContainsOp,
+									Src:   "m[\"x2\"].Contains(`$v`)",
+									Value: "x2",
+									Args:  []ir.FilterExpr{{Line: 0, Op: ir.FilterStringOp, Src: "", Value: "$v"}},
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Line:        1452,
+			Name:        "replaceAll",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score1"},
+			DocSummary:  "Detects strings.Replace and bytes.Replace calls that can use ReplaceAll",
+			DocBefore:   "strings.Replace(s, old, new, -1)",
+			DocAfter:    "strings.ReplaceAll(s, old, new)",
+			DocNote:     "only a literal -1 is matched, a variable n may have another value",
+			Rules: []ir.Rule{
+				{
+					Line:            1455,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1455, Value: "strings.Replace($s, $old, $new, -1)"}},
+					ReportTemplate:  "$$ => strings.ReplaceAll($s, $old, $new)",
+					SuggestTemplate: "strings.ReplaceAll($s, $old, $new)",
+					WhereExpr: ir.FilterExpr{
+						Line:  1456,
+						Op:    ir.FilterGoVersionGreaterEqThanOp,
+						Src:   "m.GoVersion().GreaterEqThan(\"1.12\")",
+						Value: "1.12",
+					},
+				},
+				{
+					Line:            1458,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1458, Value: "bytes.Replace($b, $old, $new, -1)"}},
+					ReportTemplate:  "$$ => bytes.ReplaceAll($b, $old, $new)",
+					SuggestTemplate: "bytes.ReplaceAll($b, $old, $new)",
+					WhereExpr: ir.FilterExpr{
+						Line:  1459,
+						Op:    ir.FilterGoVersionGreaterEqThanOp,
+						Src:   "m.GoVersion().GreaterEqThan(\"1.12\")",
+						Value: "1.12",
+					},
+				},
+			},
+		},
+		{
+			Line:        1468,
+			Name:        "timeSince",
+			MatcherName: "m",
+			DocTags:     []string{"o1", "score1"},
+			DocSummary:  "Detects time.Now().Sub() calls that can use time.Since or time.Until",
+			DocBefore:   "elapsed := time.Now().Sub(start)",
+			DocAfter:    "elapsed := time.Since(start)",
+			Rules: []ir.Rule{
+				{
+					Line:            1472,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1472, Value: "time.Now().Sub($x)"}},
+					ReportTemplate:  "$$ => time.Since($x)",
+					SuggestTemplate: "time.Since($x)",
+					WhereExpr: ir.FilterExpr{
+						Line:  1473,
+						Op:    ir.FilterVarTypeIsOp,
+						Src:   "m[\"x\"].Type.Is(`time.Time`)",
+						Value: "x",
+						Args:  []ir.FilterExpr{{Line: 1473, Op: ir.FilterStringOp, Src: "`time.Time`", Value: "time.Time"}},
+					},
+				},
+				{
+					Line:            1475,
+					SyntaxPatterns:  []ir.PatternString{{Line: 1475, Value: "$x.Sub(time.Now())"}},
+					ReportTemplate:  "$$ => time.Until($x)",
+					SuggestTemplate: "time.Until($x)",
+					WhereExpr: ir.FilterExpr{
+						Line:  1476,
+						Op:    ir.FilterVarTypeIsOp,
+						Src:   "m[\"x\"].Type.Is(`time.Time`)",
+						Value: "x",
+						Args:  []ir.FilterExpr{{Line: 1476, Op: ir.FilterStringOp, Src: "`time.Time`", Value: "time.Time"}},
+					},
+				},
+			},
+		},
 	},
 }
 
+// UniversalImpact maps a rule group name to its doc:impact value.
+var UniversalImpact = map[string]string{
+	"appendAPI": "alloc",
+	"appendConcat": "alloc",
+	"arrayCopy": "cpu",
+	"binaryWrite": "alloc",
+	"bufferFprintf": "alloc",
+	"bufferString": "alloc",
+	"bufio": "cpu",
+	"bytesCompare": "cpu",
+	"bytesCut": "alloc",
+	"convReorder": "alloc",
+	"copyReslice": "readability",
+	"derefStore": "cpu",
+	"equalFold": "alloc",
+	"formatIntToItoa": "readability",
+	"fprint": "alloc",
+	"indexAlloc": "alloc",
+	"intAbs": "cpu",
+	"ioCopy": "alloc",
+	"makeOverwrite": "cpu",
+	"makeZeroCap": "alloc",
+	"mapAssignOp": "cpu",
+	"mapClear": "cpu",
+	"mathExpr": "cpu",
+	"nestedSprintfFormat": "alloc",
+	"rangeExprCopy": "cpu",
+	"rangeRuneSlice": "alloc",
+	"rangeToAppend": "cpu",
+	"rangeToCopy": "cpu",
+	"readAllReader": "alloc",
+	"redundantFprint": "alloc",
+	"redundantNilCheck": "cpu",
+	"redundantSprint": "alloc",
+	"reflectDeepEqual": "cpu",
+	"reflectType": "cpu",
+	"reflectValueKind": "cpu",
+	"regexpFindMatch": "cpu",
+	"regexpGuardedFind": "cpu",
+	"regexpStringCopyElim": "alloc",
+	"replaceAll": "readability",
+	"sliceClear": "cpu",
+	"sliceClone": "alloc",
+	"sliceLit": "alloc",
+	"sliceSelfCopy": "cpu",
+	"slicedConv": "alloc",
+	"slicesSort": "cpu",
+	"sprintfConcat": "alloc",
+	"sprintfError": "cpu",
+	"strconv": "alloc",
+	"stringByteIndex": "alloc",
+	"stringCopyElim": "alloc",
+	"stringsClone": "alloc",
+	"stringsCompare": "cpu",
+	"stringsCount": "alloc",
+	"stringsCut": "alloc",
+	"stringsJoinConcat": "alloc",
+	"syncPoolPut": "alloc",
+	"timeSince": "readability",
+	"trim": "cpu",
+	"utf8DecodeRune": "alloc",
+	"writeByte": "cpu",
+	"writeBytes": "alloc",
+	"writeString": "alloc",
+}